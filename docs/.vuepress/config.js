@@ -81,6 +81,21 @@ module.exports = {
               directory: true,
               path: "/ibc/upgrades"
             },
+            {
+              title: "Channel Upgrades",
+              directory: false,
+              path: "/ibc/channel-upgrades.html"
+            },
+            {
+              title: "Multi-hop Channels",
+              directory: false,
+              path: "/ibc/multihop-channels.html"
+            },
+            {
+              title: "Packet Pruning",
+              directory: false,
+              path: "/ibc/packet-pruning.html"
+            },
             {
               title: "Governance Proposals",
               directory: false,
@@ -143,6 +158,42 @@ module.exports = {
                 },
             ]
             },
+            {
+              title: "Packet Forward Middleware",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/packet-forward/overview.html"
+                },
+              ]
+            },
+            {
+              title: "Rate Limit Middleware",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/rate-limit/overview.html"
+                },
+              ]
+            },
+            {
+              title: "Fee Middleware",
+              directory: true,
+              path: "/apps",
+              children: [
+                {
+                  title: "Overview",
+                  directory: false,
+                  path: "/apps/fee/overview.html"
+                },
+              ]
+            },
           ]
         },
         {
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
 
 	controllercli "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/client/cli"
@@ -24,3 +25,21 @@ func GetQueryCmd() *cobra.Command {
 
 	return icaQueryCmd
 }
+
+// GetTxCmd returns the transaction commands for the interchain-accounts submodule
+func GetTxCmd() *cobra.Command {
+	icaTxCmd := &cobra.Command{
+		Use:                        "interchain-accounts",
+		Aliases:                    []string{"ica"},
+		Short:                      "interchain-accounts transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	icaTxCmd.AddCommand(
+		controllercli.NewTxCmd(),
+	)
+
+	return icaTxCmd
+}
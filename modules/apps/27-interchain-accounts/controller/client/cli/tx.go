@@ -0,0 +1,280 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	flagVersion               = "version"
+	flagRelativePacketTimeout = "relative-packet-timeout"
+	flagMemo                  = "memo"
+	flagEncoding              = "encoding"
+)
+
+// NewRegisterInterchainAccountCmd returns the command to create a MsgRegisterInterchainAccount transaction
+func NewRegisterInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register [connection-id]",
+		Short: "Register an interchain account on the provided connection",
+		Long: strings.TrimSpace(`Register an interchain account on the provided connection. The sender of the
+transaction is the owner of the interchain account. The channel version can be specified using the "version"
+flag, the default interchain accounts metadata of the connection is used if it is left empty.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller register connection-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			connectionID := args[0]
+
+			channelVersion, err := cmd.Flags().GetString(flagVersion)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRegisterInterchainAccount(connectionID, owner, channelVersion)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagVersion, "", "Interchain accounts channel version (JSON encoded metadata)")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewSendTxCmd returns the command to create a MsgSendTx transaction
+func NewSendTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-tx [connection-id] [path/to/packet_data.json]",
+		Short: "Send an interchain account tx on the provided connection",
+		Long: strings.TrimSpace(`Send an interchain account tx on the provided connection. The packet data is
+provided as a path to a file or as a string containing the JSON encoded interchain account packet data. The
+sender of the transaction must be the owner of the interchain account. The packet timeout is relative to the
+block time of the controller chain and can be set using the "relative-packet-timeout" flag. The packet data
+can be generated from JSON encoded messages using the "generate-packet-data" command.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller send-tx connection-0 packet_data.json", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			connectionID := args[0]
+
+			packetDataBz, err := readPacketData(args[1])
+			if err != nil {
+				return err
+			}
+
+			var icaPacketData icatypes.InterchainAccountPacketData
+			if err := clientCtx.Codec.UnmarshalJSON(packetDataBz, &icaPacketData); err != nil {
+				return fmt.Errorf("failed to unmarshal interchain account packet data: %w", err)
+			}
+
+			relativeTimeout, err := cmd.Flags().GetUint64(flagRelativePacketTimeout)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendTx(owner, connectionID, relativeTimeout, icaPacketData)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagRelativePacketTimeout, icatypes.DefaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds relative to the block time of the controller chain")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// NewGeneratePacketDataCmd returns the command to generate the interchain account packet data of JSON encoded messages
+func NewGeneratePacketDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "generate-packet-data [path/to/msgs.json]",
+		Short: "Generate interchain account packet data from JSON encoded messages",
+		Long: strings.TrimSpace(`Generate the JSON encoded interchain account packet data of a transaction executing the
+provided messages on the host chain. The messages are provided as a path to a file or as a string containing a
+single JSON encoded message or a JSON array of messages. The type of each message is resolved by its "@type" field
+using the interface registry of the application. The messages are serialized using the encoding set with the
+"encoding" flag, which must match the encoding of the interchain account channel. The output can be used as the
+packet data of the "send-tx" command.`),
+		Example: fmt.Sprintf(`%s tx interchain-accounts controller generate-packet-data '{
+    "@type":"/cosmos.bank.v1beta1.MsgSend",
+    "from_address":"cosmos15ccshhmp0gsx29qpqq6g4zmltnnvgmyu9ueuadh9y2nc5zj0szls5gtddz",
+    "to_address":"cosmos10h9stc5v6ntgeygf5xf945njqq5h32r53uquvw",
+    "amount": [{"denom": "stake", "amount": "1000"}]
+}' --memo memo`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			msgsBz, err := readPacketData(args[0])
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			encoding, err := cmd.Flags().GetString(flagEncoding)
+			if err != nil {
+				return err
+			}
+
+			packetDataBz, err := generatePacketData(clientCtx.Codec, msgsBz, memo, encoding)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", packetDataBz))
+		},
+	}
+
+	cmd.Flags().String(flagMemo, "", "An optional memo to be included in the interchain account packet data")
+	cmd.Flags().String(flagEncoding, icatypes.EncodingProtobuf, fmt.Sprintf("The encoding of the messages, either %s or %s", icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON))
+
+	return cmd
+}
+
+// generatePacketData parses the provided JSON encoded message or array of messages and returns the JSON encoded
+// interchain account packet data executing the messages, serialized using the provided encoding
+func generatePacketData(cdc codec.Codec, msgsBz []byte, memo, encoding string) ([]byte, error) {
+	msgs, err := parseMsgs(cdc, msgsBz)
+	if err != nil {
+		return nil, err
+	}
+
+	var data []byte
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		data, err = icatypes.SerializeCosmosTx(cdc, msgs)
+	case icatypes.EncodingProto3JSON:
+		data, err = icatypes.SerializeCosmosTxJSON(cdc, msgs)
+	default:
+		return nil, fmt.Errorf("unsupported encoding format %s", encoding)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	icaPacketData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+		Memo: memo,
+	}
+
+	if err := icaPacketData.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return cdc.MarshalJSON(&icaPacketData)
+}
+
+// parseMsgs unmarshals a single JSON encoded message or a JSON array of messages, resolving the type of each
+// message by its "@type" field
+func parseMsgs(cdc codec.Codec, msgsBz []byte) ([]sdk.Msg, error) {
+	if !bytes.HasPrefix(bytes.TrimSpace(msgsBz), []byte("[")) {
+		var msg sdk.Msg
+		if err := cdc.UnmarshalInterfaceJSON(msgsBz, &msg); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal message: %w", err)
+		}
+
+		return []sdk.Msg{msg}, nil
+	}
+
+	var rawMsgs []json.RawMessage
+	if err := json.Unmarshal(msgsBz, &rawMsgs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal JSON array of messages: %w", err)
+	}
+
+	if len(rawMsgs) == 0 {
+		return nil, fmt.Errorf("no messages provided")
+	}
+
+	msgs := make([]sdk.Msg, len(rawMsgs))
+	for i, rawMsg := range rawMsgs {
+		if err := cdc.UnmarshalInterfaceJSON(rawMsg, &msgs[i]); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal message %d: %w", i, err)
+		}
+	}
+
+	return msgs, nil
+}
+
+// readPacketData returns the contents of the file at the provided path, or the provided
+// argument itself if it is not the path of a regular file. Inline JSON is commonly longer
+// than the maximum file name length, any error returned while looking up the file is
+// therefore treated as the argument being inline content.
+func readPacketData(arg string) ([]byte, error) {
+	info, err := os.Stat(arg)
+	if err != nil || !info.Mode().IsRegular() {
+		return []byte(arg), nil
+	}
+
+	return ioutil.ReadFile(arg)
+}
+
+// NewReopenInterchainAccountCmd returns the command to create a MsgReopenInterchainAccount transaction
+func NewReopenInterchainAccountCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reopen [connection-id]",
+		Short: "Reopen the closed channel of an interchain account on the provided connection",
+		Long: strings.TrimSpace(`Reopen the closed channel of an interchain account on the provided connection. The sender
+of the transaction must be the owner of the interchain account. The new channel uses the metadata of the previous
+channel, the interchain account address is therefore unchanged.`),
+		Example: fmt.Sprintf("%s tx interchain-accounts controller reopen connection-0", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			owner := clientCtx.GetFromAddress().String()
+			connectionID := args[0]
+
+			msg := types.NewMsgReopenInterchainAccount(connectionID, owner)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
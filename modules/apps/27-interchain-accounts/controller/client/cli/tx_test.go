@@ -0,0 +1,278 @@
+package cli
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/require"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+const (
+	testAddress      = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+	testValAddress   = "cosmosvaloper1qnk2n4nlkpw9xfqntladh74w6ujtulwnmxnh3k"
+	msgSendJSON      = `{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"%[1]s","to_address":"%[1]s","amount":[{"denom":"stake","amount":"1000"}]}`
+	msgDelegateJSON  = `{"@type":"/cosmos.staking.v1beta1.MsgDelegate","delegator_address":"%s","validator_address":"%s","amount":{"denom":"stake","amount":"1000"}}`
+	unknownTypeJSON  = `{"@type":"/cosmos.invalid.v1beta1.MsgInvalid","from_address":"%s"}`
+	testPacketMemo   = "memo"
+	invalidEncoding  = "invalid-encoding"
+	invalidMsgsInput = "not json"
+)
+
+// newTestCodec returns a codec with the bank and staking messages registered, the simapp
+// cannot be imported as it depends on this package
+func newTestCodec() codec.Codec {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	stakingtypes.RegisterInterfaces(registry)
+
+	return codec.NewProtoCodec(registry)
+}
+
+func TestParseMsgs(t *testing.T) {
+	cdc := newTestCodec()
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: testAddress,
+		ToAddress:   testAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))),
+	}
+	msgDelegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: testAddress,
+		ValidatorAddress: testValAddress,
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+	}
+
+	sendJSON := fmt.Sprintf(msgSendJSON, testAddress)
+	delegateJSON := fmt.Sprintf(msgDelegateJSON, testAddress, testValAddress)
+
+	testCases := []struct {
+		name    string
+		msgsBz  string
+		expMsgs []sdk.Msg
+		expPass bool
+	}{
+		{
+			"single message",
+			sendJSON,
+			[]sdk.Msg{msgSend},
+			true,
+		},
+		{
+			"single message with surrounding whitespace",
+			"\n  " + sendJSON + "\n",
+			[]sdk.Msg{msgSend},
+			true,
+		},
+		{
+			"JSON array of messages",
+			fmt.Sprintf("[%s, %s]", sendJSON, delegateJSON),
+			[]sdk.Msg{msgSend, msgDelegate},
+			true,
+		},
+		{
+			"JSON array with leading whitespace",
+			fmt.Sprintf("  [%s]", sendJSON),
+			[]sdk.Msg{msgSend},
+			true,
+		},
+		{
+			"empty JSON array",
+			"[]",
+			nil,
+			false,
+		},
+		{
+			"unknown @type in single message",
+			fmt.Sprintf(unknownTypeJSON, testAddress),
+			nil,
+			false,
+		},
+		{
+			"unknown @type in JSON array",
+			fmt.Sprintf("[%s, %s]", sendJSON, fmt.Sprintf(unknownTypeJSON, testAddress)),
+			nil,
+			false,
+		},
+		{
+			"invalid JSON array",
+			"[" + sendJSON,
+			nil,
+			false,
+		},
+		{
+			"invalid JSON",
+			invalidMsgsInput,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		msgs, err := parseMsgs(cdc, []byte(tc.msgsBz))
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expMsgs, msgs, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestGeneratePacketData(t *testing.T) {
+	cdc := newTestCodec()
+
+	msgSend := &banktypes.MsgSend{
+		FromAddress: testAddress,
+		ToAddress:   testAddress,
+		Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))),
+	}
+	msgDelegate := &stakingtypes.MsgDelegate{
+		DelegatorAddress: testAddress,
+		ValidatorAddress: testValAddress,
+		Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000)),
+	}
+
+	sendJSON := fmt.Sprintf(msgSendJSON, testAddress)
+	delegateJSON := fmt.Sprintf(msgDelegateJSON, testAddress, testValAddress)
+
+	testCases := []struct {
+		name     string
+		msgsBz   string
+		encoding string
+		expMsgs  []sdk.Msg
+		expPass  bool
+	}{
+		{
+			"single message with proto3 encoding",
+			sendJSON,
+			icatypes.EncodingProtobuf,
+			[]sdk.Msg{msgSend},
+			true,
+		},
+		{
+			"JSON array of messages with proto3 encoding",
+			fmt.Sprintf("[%s, %s]", sendJSON, delegateJSON),
+			icatypes.EncodingProtobuf,
+			[]sdk.Msg{msgSend, msgDelegate},
+			true,
+		},
+		{
+			"single message with proto3json encoding",
+			sendJSON,
+			icatypes.EncodingProto3JSON,
+			[]sdk.Msg{msgSend},
+			true,
+		},
+		{
+			"JSON array of messages with proto3json encoding",
+			fmt.Sprintf("[%s, %s]", sendJSON, delegateJSON),
+			icatypes.EncodingProto3JSON,
+			[]sdk.Msg{msgSend, msgDelegate},
+			true,
+		},
+		{
+			"invalid encoding",
+			sendJSON,
+			invalidEncoding,
+			nil,
+			false,
+		},
+		{
+			"empty encoding",
+			sendJSON,
+			"",
+			nil,
+			false,
+		},
+		{
+			"empty JSON array",
+			"[]",
+			icatypes.EncodingProtobuf,
+			nil,
+			false,
+		},
+		{
+			"unknown @type",
+			fmt.Sprintf(unknownTypeJSON, testAddress),
+			icatypes.EncodingProtobuf,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bz, err := generatePacketData(cdc, []byte(tc.msgsBz), testPacketMemo, tc.encoding)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+
+		var packetData icatypes.InterchainAccountPacketData
+		require.NoError(t, cdc.UnmarshalJSON(bz, &packetData), tc.name)
+		require.Equal(t, icatypes.EXECUTE_TX, packetData.Type, tc.name)
+		require.Equal(t, testPacketMemo, packetData.Memo, tc.name)
+
+		var msgs []sdk.Msg
+		switch tc.encoding {
+		case icatypes.EncodingProtobuf:
+			msgs, err = icatypes.DeserializeCosmosTx(cdc, packetData.Data)
+		case icatypes.EncodingProto3JSON:
+			msgs, err = icatypes.DeserializeCosmosTxJSON(cdc, packetData.Data)
+		}
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expMsgs, msgs, tc.name)
+	}
+
+	// the encodings produce different packet data for the same messages
+	protoBz, err := generatePacketData(cdc, []byte(sendJSON), testPacketMemo, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+
+	protoJSONBz, err := generatePacketData(cdc, []byte(sendJSON), testPacketMemo, icatypes.EncodingProto3JSON)
+	require.NoError(t, err)
+	require.NotEqual(t, protoBz, protoJSONBz)
+
+	// messages encoded with proto3json cannot be decoded as proto3
+	var packetData icatypes.InterchainAccountPacketData
+	require.NoError(t, cdc.UnmarshalJSON(protoJSONBz, &packetData))
+	_, err = icatypes.DeserializeCosmosTx(cdc, packetData.Data)
+	require.Error(t, err)
+}
+
+func TestReadPacketData(t *testing.T) {
+	// the JSON printed by generate-packet-data has a long base64 data field, longer than the maximum file name length
+	longPacketData := fmt.Sprintf(`{"type":"TYPE_EXECUTE_TX","data":"%s","memo":""}`, strings.Repeat("A", 1024))
+
+	dir := t.TempDir()
+	filePath := filepath.Join(dir, "packet_data.json")
+	require.NoError(t, ioutil.WriteFile(filePath, []byte(longPacketData), os.ModePerm))
+
+	testCases := []struct {
+		name   string
+		arg    string
+		expOut string
+	}{
+		{"inline packet data", `{"type":"TYPE_EXECUTE_TX"}`, `{"type":"TYPE_EXECUTE_TX"}`},
+		{"long inline packet data", longPacketData, longPacketData},
+		{"path to a file", filePath, longPacketData},
+		{"path to a directory", dir, dir},
+	}
+
+	for _, tc := range testCases {
+		bz, err := readPacketData(tc.arg)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expOut, string(bz), tc.name)
+	}
+}
@@ -3,13 +3,20 @@ package controller_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
+	abci "github.com/tendermint/tendermint/abci/types"
 	"github.com/tendermint/tendermint/crypto"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -134,7 +141,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, false))
 			}, false,
 		},
 		{
@@ -169,6 +176,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenInit() {
 
 			portCap := suite.chainA.GetSimApp().IBCKeeper.PortKeeper.BindPort(suite.chainA.GetContext(), portID)
 			suite.chainA.GetSimApp().ICAControllerKeeper.ClaimCapability(suite.chainA.GetContext(), portCap, host.PortPath(portID))
+			suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), portID, path.EndpointA.ConnectionID)
 
 			path.EndpointA.ChannelConfig.PortID = portID
 			path.EndpointA.ChannelID = ibctesting.FirstChannelID
@@ -274,7 +282,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenAck() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, false))
 			}, false,
 		},
 		{
@@ -513,7 +521,7 @@ func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, false))
 			}, false,
 		},
 		{
@@ -584,7 +592,7 @@ func (suite *InterchainAccountsTestSuite) TestOnTimeoutPacket() {
 		},
 		{
 			"controller submodule disabled", func() {
-				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, false))
 			}, false,
 		},
 		{
@@ -673,6 +681,7 @@ func (suite *InterchainAccountsTestSuite) TestSingleHostMultipleControllers() {
 
 			// NOTE: Here the version metadata is overridden to include to the next host connection sequence (i.e. chainB's connection to chainC)
 			// SetupICAPath() will set endpoint.ChannelConfig.Version to TestVersion
+			defer func(version string) { TestVersion = version }(TestVersion)
 			TestVersion = string(icatypes.ModuleCdc.MustMarshalJSON(&icatypes.Metadata{
 				Version:                icatypes.Version,
 				ControllerConnectionId: pathCToB.EndpointA.ConnectionID,
@@ -704,3 +713,366 @@ func (suite *InterchainAccountsTestSuite) TestSingleHostMultipleControllers() {
 		})
 	}
 }
+
+// Test the controller stack for an interchain account controlled through the controller Msg service.
+// The callbacks of the underlying application must not be called.
+func (suite *InterchainAccountsTestSuite) TestMsgServiceControlledInterchainAccount() {
+	suite.SetupTest()
+
+	suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnChanOpenInit = func(ctx sdk.Context, order channeltypes.Order, connectionHops []string,
+		portID, channelID string, chanCap *capabilitytypes.Capability,
+		counterparty channeltypes.Counterparty, version string,
+	) error {
+		return fmt.Errorf("mock ica auth fails")
+	}
+	suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnChanOpenAck = func(
+		ctx sdk.Context, portID, channelID string, counterpartyChannelID string, counterpartyVersion string,
+	) error {
+		return fmt.Errorf("mock ica auth fails")
+	}
+	suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnAcknowledgementPacket = func(
+		ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress,
+	) error {
+		return fmt.Errorf("mock ica auth fails")
+	}
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	registerRes, err := msgServer.RegisterInterchainAccount(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, ""),
+	)
+	suite.Require().NoError(err)
+
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = registerRes.ChannelId
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + icatypes.DefaultRelativePacketTimeoutTimestamp
+
+	sendRes, err := msgServer.SendTx(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, packetData),
+	)
+	suite.Require().NoError(err)
+
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sendRes.Sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+	)
+
+	// the host returns an error acknowledgement which is relayed back to the controller
+	suite.Require().NoError(path.RelayPacket(packet))
+}
+
+// Test the automatic reopening of the channel of an interchain account after a packet timeout
+func (suite *InterchainAccountsTestSuite) TestReopenOnTimeout() {
+	suite.SetupTest()
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true))
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+
+	registerRes, err := msgServer.RegisterInterchainAccount(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, ""),
+	)
+	suite.Require().NoError(err)
+
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	path.EndpointA.ChannelID = registerRes.ChannelId
+	path.EndpointA.ChannelConfig.PortID = TestPortID
+
+	suite.Require().NoError(path.EndpointB.ChanOpenTry())
+	suite.Require().NoError(path.EndpointA.ChanOpenAck())
+	suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+	previousChannel := path.EndpointA.GetChannel()
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+	timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + icatypes.DefaultRelativePacketTimeoutTimestamp
+
+	sendRes, err := msgServer.SendTx(
+		sdk.WrapSDKContext(suite.chainA.GetContext()),
+		types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, packetData),
+	)
+	suite.Require().NoError(err)
+
+	suite.chainA.App.Commit()
+	suite.chainA.NextBlock()
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sendRes.Sequence,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+	)
+
+	nextChannelSequence := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext())
+
+	// time out the packet, which closes the ORDERED channel
+	suite.coordinator.IncrementTimeBy(time.Duration(icatypes.DefaultRelativePacketTimeoutTimestamp))
+	suite.coordinator.CommitBlock(suite.chainB)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+
+	// the channel is reopened at the end of the block in which the packet timed out
+	suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.HasPendingReopen(suite.chainA.GetContext(), TestPortID, path.EndpointA.ConnectionID))
+
+	channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), TestPortID, channeltypes.FormatChannelIdentifier(nextChannelSequence))
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.INIT, channel.State)
+	suite.Require().Equal(previousChannel.Version, channel.Version)
+}
+
+// Test upgrading an ORDERED interchain account channel to an UNORDERED channel
+func (suite *InterchainAccountsTestSuite) TestUpgradeToUnorderedChannel() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	var metadata icatypes.Metadata
+	err = icatypes.ModuleCdc.UnmarshalJSON([]byte(path.EndpointA.GetChannel().Version), &metadata)
+	suite.Require().NoError(err)
+
+	metadata.Ordering = channeltypes.UNORDERED
+	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+	suite.Require().NoError(err)
+
+	upgradeTimeout := channeltypes.NewUpgradeTimeout(clienttypes.NewHeight(0, 10000), 0)
+	suite.Require().NoError(path.EndpointA.ChanUpgradeInit(channeltypes.UNORDERED, string(versionBytes), upgradeTimeout))
+	suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+	suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+	suite.Require().NoError(path.EndpointB.ChanUpgradeConfirm())
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		suite.Require().Equal(channeltypes.OPEN, channel.State)
+		suite.Require().Equal(channeltypes.UNORDERED, channel.Ordering)
+		suite.Require().Equal(string(versionBytes), channel.Version)
+	}
+
+	// the interchain account address is unchanged
+	interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(found)
+	suite.Require().Equal(metadata.Address, interchainAccountAddr)
+}
+
+// Test the controller stack without an underlying application
+func (suite *InterchainAccountsTestSuite) TestControllerStackWithoutUnderlyingApp() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	icaControllerStack := controller.NewIBCModule(suite.chainA.GetSimApp().ICAControllerKeeper, nil)
+
+	packet := channeltypes.NewPacket(
+		[]byte("empty packet data"),
+		1,
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		path.EndpointB.ChannelConfig.PortID,
+		path.EndpointB.ChannelID,
+		clienttypes.NewHeight(0, 100),
+		0,
+	)
+
+	err = icaControllerStack.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, []byte("ack"), nil)
+	suite.Require().NoError(err)
+
+	err = icaControllerStack.OnTimeoutPacket(suite.chainA.GetContext(), packet, nil)
+	suite.Require().NoError(err)
+
+	// the controller submodule claims the channel capability if there is no underlying application
+	portID, err := icatypes.NewControllerPortID(suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	portCap := suite.chainA.GetSimApp().IBCKeeper.PortKeeper.BindPort(suite.chainA.GetContext(), portID)
+	suite.Require().NoError(suite.chainA.GetSimApp().ICAControllerKeeper.ClaimCapability(suite.chainA.GetContext(), portCap, host.PortPath(portID)))
+
+	channelID := ibctesting.FirstChannelID
+	chanCap, err := suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, channelID))
+	suite.Require().NoError(err)
+
+	err = icaControllerStack.OnChanOpenInit(suite.chainA.GetContext(), channeltypes.ORDERED, []string{path.EndpointA.ConnectionID},
+		portID, channelID, chanCap, channeltypes.NewCounterparty(icatypes.PortID, ""), TestVersion,
+	)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, channelID))
+	suite.Require().True(found)
+}
+
+func (suite *InterchainAccountsTestSuite) TestQueryPacketAcknowledgement() {
+	var ackVersion string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"success", func() {},
+		},
+		{
+			"success with ics27-ack-1 acknowledgement version", func() {
+				ackVersion = icatypes.AckVersion1
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			ackVersion = ""
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// update the acknowledgement version of the host channel end
+			metadata, err := suite.chainB.GetSimApp().ICAHostKeeper.GetAppMetadata(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().NoError(err)
+
+			metadata.AckVersion = ackVersion
+			channel := path.EndpointB.GetChannel()
+			channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			path.EndpointB.SetChannel(channel)
+
+			params := hosttypes.NewParams(true, nil, nil, []string{"/cosmos.bank.v1beta1.Query/Balance"}, 0, nil)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			// the authentication module decodes the query responses in its acknowledgement callback
+			var responses []proto.Message
+			suite.chainA.GetSimApp().ICAAuthModule.IBCApp.OnAcknowledgementPacket = func(
+				ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress,
+			) error {
+				var ack channeltypes.Acknowledgement
+				if err := channeltypes.SubModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+					return err
+				}
+
+				if !ack.Success() {
+					return fmt.Errorf("unexpected error acknowledgement: %s", ack.GetError())
+				}
+
+				result := ack.GetResult()
+				if ackVersion == icatypes.AckVersion1 {
+					resultAck, err := icatypes.ParseResultAcknowledgement(ack)
+					if err != nil {
+						return err
+					}
+
+					result = resultAck.Result
+				}
+
+				var packetData icatypes.InterchainAccountPacketData
+				if err := icatypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &packetData); err != nil {
+					return err
+				}
+
+				reqs, err := icatypes.DeserializeCosmosQuery(packetData.Data)
+				if err != nil {
+					return err
+				}
+
+				services := types.NewQueryServiceRegistry()
+				banktypes.RegisterQueryServer(services, nil)
+
+				responses, err = types.DecodeCosmosQueryResponse(services, reqs, result)
+				return err
+			}
+
+			sender := suite.chainB.SenderAccount.GetAddress()
+			balanceReq := banktypes.QueryBalanceRequest{
+				Address: sender.String(),
+				Denom:   sdk.DefaultBondDenom,
+			}
+
+			data, err := icatypes.SerializeCosmosQuery([]abci.RequestQuery{
+				{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: suite.chainA.GetSimApp().AppCodec().MustMarshal(&balanceReq)},
+			})
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.QUERY,
+				Data: data,
+			}
+
+			chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.Require().True(ok)
+
+			timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + icatypes.DefaultRelativePacketTimeoutTimestamp
+			sequence, err := suite.chainA.GetSimApp().ICAControllerKeeper.SendTx(suite.chainA.GetContext(), chanCap, path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, packetData, timeoutTimestamp)
+			suite.Require().NoError(err)
+
+			suite.chainA.App.Commit()
+			suite.chainA.NextBlock()
+
+			packet := channeltypes.NewPacket(
+				packetData.GetBytes(),
+				sequence,
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.ZeroHeight(),
+				timeoutTimestamp,
+			)
+
+			suite.Require().NoError(path.RelayPacket(packet))
+
+			expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), sender, sdk.DefaultBondDenom)
+			suite.Require().Equal([]proto.Message{&banktypes.QueryBalanceResponse{Balance: &expBalance}}, responses)
+		})
+	}
+}
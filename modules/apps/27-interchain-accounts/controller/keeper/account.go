@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -15,58 +17,167 @@ import (
 // call 04-channel 'ChanOpenInit'. An error is returned if the port identifier is
 // already in use. Gaining access to interchain accounts whose channels have closed
 // cannot be done with this function. A regular MsgChanOpenInit must be used.
+//
+// The interchain account is controlled by the calling authentication module, i.e. the
+// controller middleware is enabled for the port and connection identifiers.
 func (k Keeper) RegisterInterchainAccount(ctx sdk.Context, connectionID, owner string) error {
 	portID, err := icatypes.NewControllerPortID(owner)
 	if err != nil {
 		return err
 	}
 
+	k.SetMiddlewareEnabled(ctx, portID, connectionID)
+
+	if _, err := k.registerInterchainAccount(ctx, connectionID, portID, ""); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// registerInterchainAccount binds to the provided controller port identifier if necessary and
+// initiates the channel handshake with the provided version. The default metadata of the connection
+// is used if the version is empty. The channel is opened with the ordering of the metadata. The
+// identifier of the new channel is returned.
+func (k Keeper) registerInterchainAccount(ctx sdk.Context, connectionID, portID, version string) (string, error) {
 	// if there is an active channel for this portID / connectionID return an error
 	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
 	if found {
-		return sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s for owner %s", activeChannelID, portID, connectionID, owner)
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s on connection %s", activeChannelID, portID, connectionID)
 	}
 
 	switch {
 	case k.portKeeper.IsBound(ctx, portID) && !k.IsBound(ctx, portID):
-		return sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
+		return "", sdkerrors.Wrapf(icatypes.ErrPortAlreadyBound, "another module has claimed capability for and bound port with portID: %s", portID)
 	case !k.portKeeper.IsBound(ctx, portID):
 		cap := k.BindPort(ctx, portID)
 		if err := k.ClaimCapability(ctx, cap, host.PortPath(portID)); err != nil {
-			return sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
+			return "", sdkerrors.Wrapf(err, "unable to bind to newly generated portID: %s", portID)
 		}
 	}
 
-	connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
-	if err != nil {
-		return err
-	}
+	if version == "" {
+		connectionEnd, err := k.channelKeeper.GetConnection(ctx, connectionID)
+		if err != nil {
+			return "", err
+		}
 
-	// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
-	metadata := icatypes.NewMetadata(
-		icatypes.Version,
-		connectionID,
-		connectionEnd.GetCounterparty().GetConnectionID(),
-		"",
-		icatypes.EncodingProtobuf,
-		icatypes.TxTypeSDKMultiMsg,
-	)
+		// NOTE: An empty string is provided for accAddress, to be fulfilled upon OnChanOpenTry handshake step
+		metadata := icatypes.NewMetadata(
+			icatypes.Version,
+			connectionID,
+			connectionEnd.GetCounterparty().GetConnectionID(),
+			"",
+			icatypes.EncodingProtobuf,
+			icatypes.TxTypeSDKMultiMsg,
+		)
 
-	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
-	if err != nil {
-		return err
+		versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+		if err != nil {
+			return "", err
+		}
+
+		version = string(versionBytes)
 	}
 
-	msg := channeltypes.NewMsgChannelOpenInit(portID, string(versionBytes), channeltypes.ORDERED, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
+	// the channel ordering is negotiated in the metadata, an invalid version is rejected in the OnChanOpenInit callback
+	order := channeltypes.ORDERED
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err == nil {
+		order = metadata.ChannelOrdering()
+	}
+
+	msg := channeltypes.NewMsgChannelOpenInit(portID, version, order, []string{connectionID}, icatypes.PortID, icatypes.ModuleName)
 	handler := k.msgRouter.Handler(msg)
 
 	res, err := handler(ctx, msg)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
 	ctx.EventManager().EmitEvents(res.GetEvents())
 
-	return nil
+	var openInitResponse channeltypes.MsgChannelOpenInitResponse
+	if err := openInitResponse.Unmarshal(res.Data); err != nil {
+		return "", err
+	}
+
+	return openInitResponse.ChannelId, nil
+}
+
+// ReopenInterchainAccount initiates a new channel handshake for the interchain account associated with the provided
+// connectionID and portID, whose active channel has been closed. The new channel uses the metadata of the previous
+// active channel with the stored interchain account address, the controller port and the interchain account are
+// therefore unchanged. The identifier of the new channel is returned.
+func (k Keeper) ReopenInterchainAccount(ctx sdk.Context, connectionID, portID string) (string, error) {
+	activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel for portID %s on connection %s", portID, connectionID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", activeChannelID, portID)
+	}
+
+	if channel.State != channeltypes.CLOSED {
+		return "", sdkerrors.Wrapf(icatypes.ErrActiveChannelAlreadySet, "existing active channel %s for portID %s is not CLOSED", activeChannelID, portID)
+	}
+
+	address, found := k.GetInterchainAccountAddress(ctx, connectionID, portID)
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account for portID %s on connection %s", portID, connectionID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(channel.Version), &metadata); err != nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	if metadata.Address != address {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "previous active channel address %s does not match interchain account %s", metadata.Address, address)
+	}
+
+	versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+	if err != nil {
+		return "", err
+	}
+
+	return k.registerInterchainAccount(ctx, connectionID, portID, string(versionBytes))
+}
+
+// ReopenPendingInterchainAccounts reopens the channels of the interchain accounts flagged for reopening during the
+// block, i.e. whose ORDERED channel was closed by a packet timeout. A channel which cannot be reopened is logged and
+// does not prevent the other channels from being reopened. All the flags are removed.
+func (k Keeper) ReopenPendingInterchainAccounts(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(icatypes.PendingReopenKeyPrefix))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+
+		keySplit := strings.Split(string(key), "/")
+		portID, connectionID := keySplit[1], keySplit[2]
+
+		// the channel handshake is initiated on a branched context such that a failure does not leave partial state
+		cacheCtx, writeCache := ctx.CacheContext()
+		channelID, err := k.ReopenInterchainAccount(cacheCtx, connectionID, portID)
+		if err != nil {
+			k.Logger(ctx).Error("failed to reopen interchain account channel", "port-id", portID, "connection-id", connectionID, "error", err.Error())
+			continue
+		}
+
+		// NOTE: The context returned by CacheContext() creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+		writeCache()
+
+		k.Logger(ctx).Info("interchain account channel reopening initiated", "port-id", portID, "connection-id", connectionID, "channel-id", channelID)
+	}
 }
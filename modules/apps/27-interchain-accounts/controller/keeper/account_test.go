@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
@@ -100,3 +101,140 @@ func (suite *KeeperTestSuite) TestRegisterSameOwnerMultipleConnections() {
 	err = suite.chainA.GetSimApp().ICAControllerKeeper.RegisterInterchainAccount(suite.chainA.GetContext(), path2.EndpointA.ConnectionID, owner)
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) TestReopenInterchainAccount() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"active channel not found", func() {
+				path.EndpointA.ChannelConfig.PortID = "icacontroller-other"
+			}, false,
+		},
+		{
+			"active channel is OPEN", func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				path.EndpointA.SetChannel(channel)
+			}, false,
+		},
+		{
+			"interchain account not found", func() {
+				store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+				store.Delete(icatypes.KeyOwnerAccount(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID))
+			}, false,
+		},
+		{
+			"previous channel address does not match the interchain account", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID, TestOwnerAddress)
+			}, false,
+		},
+		{
+			"previous channel metadata cannot be unmarshaled", func() {
+				channel := path.EndpointA.GetChannel()
+				channel.Version = "invalid-metadata"
+				path.EndpointA.SetChannel(channel)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			previousChannel := path.EndpointA.GetChannel()
+
+			tc.malleate() // malleate mutates test data
+
+			channelID, err := suite.chainA.GetSimApp().ICAControllerKeeper.ReopenInterchainAccount(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotEqual(path.EndpointA.ChannelID, channelID)
+
+				channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, channelID)
+				suite.Require().True(found)
+				suite.Require().Equal(channeltypes.INIT, channel.State)
+				suite.Require().Equal(previousChannel.Version, channel.Version)
+
+				// commit state changes for proof verification
+				suite.chainA.App.Commit()
+				suite.chainA.NextBlock()
+
+				// complete the channel handshake on the reopened channel
+				path.EndpointA.ChannelID = channelID
+				path.EndpointA.ChannelConfig.Version = channel.Version
+				path.EndpointB.ChannelID = ""
+				suite.Require().NoError(path.EndpointB.ChanOpenTry())
+				suite.Require().NoError(path.EndpointA.ChanOpenAck())
+				suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+				activeChannelID, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetOpenActiveChannel(suite.chainA.GetContext(), path.EndpointA.ConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+				suite.Require().Equal(channelID, activeChannelID)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReopenPendingInterchainAccounts() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	err = path.EndpointA.SetChannelClosed()
+	suite.Require().NoError(err)
+
+	// the interchain account on the second controller port cannot be reopened as its channel is not closed
+	otherPath := NewICAPath(suite.chainA, suite.chainB)
+	otherPath.EndpointA.ConnectionID, otherPath.EndpointB.ConnectionID = path.EndpointA.ConnectionID, path.EndpointB.ConnectionID
+	otherPath.EndpointA.ClientID, otherPath.EndpointB.ClientID = path.EndpointA.ClientID, path.EndpointB.ClientID
+
+	err = SetupICAPath(otherPath, suite.chainA.SenderAccount.GetAddress().String())
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingReopen(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetPendingReopen(ctx, otherPath.EndpointA.ChannelConfig.PortID, otherPath.EndpointA.ConnectionID)
+
+	nextChannelSequence := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx)
+
+	suite.chainA.GetSimApp().ICAControllerKeeper.ReopenPendingInterchainAccounts(ctx)
+
+	// a single channel is opened for the closed interchain account channel
+	suite.Require().Equal(nextChannelSequence+1, suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextChannelSequence(ctx))
+
+	channel, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetChannel(ctx, path.EndpointA.ChannelConfig.PortID, channeltypes.FormatChannelIdentifier(nextChannelSequence))
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.INIT, channel.State)
+
+	suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.HasPendingReopen(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ConnectionID))
+	suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.HasPendingReopen(ctx, otherPath.EndpointA.ChannelConfig.PortID, otherPath.EndpointA.ConnectionID))
+}
@@ -2,10 +2,167 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
+func (suite *KeeperTestSuite) TestQueryInterchainAccount() {
+	var req *types.QueryInterchainAccountRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid owner address",
+			func() {
+				req.Owner = " "
+			},
+			false,
+		},
+		{
+			"interchain account not found",
+			func() {
+				req.ConnectionId = ibctesting.InvalidID
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryInterchainAccountRequest{
+				Owner:        TestOwnerAddress,
+				ConnectionId: ibctesting.FirstConnectionID,
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+			res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccount(ctx, req)
+
+			if tc.expPass {
+				expAddress, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+				suite.Require().True(found)
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(expAddress, res.Address)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	address, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+	suite.Require().True(found)
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{})
+	suite.Require().NoError(err)
+
+	expAccounts := []types.RegisteredInterchainAccount{
+		{
+			ConnectionId:   ibctesting.FirstConnectionID,
+			PortId:         TestPortID,
+			AccountAddress: address,
+		},
+	}
+	suite.Require().Equal(expAccounts, res.InterchainAccounts)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannels() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// set a second active channel controlled through the Msg service on the same connection
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, "icacontroller-test", ibctesting.InvalidID)
+	suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareDisabled(suite.chainA.GetContext(), "icacontroller-test", ibctesting.FirstConnectionID)
+
+	expChannels := []types.ActiveChannel{
+		{
+			ConnectionId:        ibctesting.FirstConnectionID,
+			PortId:              TestPortID,
+			ChannelId:           path.EndpointA.ChannelID,
+			IsMiddlewareEnabled: true,
+		},
+		{
+			ConnectionId:        ibctesting.FirstConnectionID,
+			PortId:              "icacontroller-test",
+			ChannelId:           ibctesting.InvalidID,
+			IsMiddlewareEnabled: false,
+		},
+	}
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ActiveChannels(ctx, &types.QueryActiveChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().ElementsMatch(expChannels, res.ActiveChannels)
+
+	// page through the active channels one at a time
+	var channels []types.ActiveChannel
+	pagination := &query.PageRequest{Limit: 1}
+	for {
+		res, err := suite.chainA.GetSimApp().ICAControllerKeeper.ActiveChannels(ctx, &types.QueryActiveChannelsRequest{Pagination: pagination})
+		suite.Require().NoError(err)
+		suite.Require().Len(res.ActiveChannels, 1)
+
+		channels = append(channels, res.ActiveChannels...)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+
+		pagination = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+
+	suite.Require().ElementsMatch(expChannels, channels)
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
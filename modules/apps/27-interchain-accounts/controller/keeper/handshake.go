@@ -9,11 +9,12 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 // OnChanOpenInit performs basic validation of channel initialization.
-// The channel order must be ORDERED, the counterparty port identifier
+// The channel order must match the ordering negotiated in the metadata, the counterparty port identifier
 // must be the host chain representation as defined in the types package,
 // the channel version must be equal to the version in the types package,
 // there must not be an active channel for the specfied port identifier,
@@ -29,10 +30,6 @@ func (k Keeper) OnChanOpenInit(
 	counterparty channeltypes.Counterparty,
 	version string,
 ) error {
-	if order != channeltypes.ORDERED {
-		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
-	}
-
 	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
 		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.PortPrefix, portID)
 	}
@@ -50,6 +47,10 @@ func (k Keeper) OnChanOpenInit(
 		return err
 	}
 
+	if order != metadata.ChannelOrdering() {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", metadata.ChannelOrdering(), order)
+	}
+
 	activeChannelID, found := k.GetActiveChannelID(ctx, connectionHops[0], portID)
 	if found {
 		channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
@@ -113,6 +114,83 @@ func (k Keeper) OnChanOpenAck(
 	return nil
 }
 
+// OnChanUpgradeInit performs basic validation of a channel upgrade initialized by the controller chain.
+// The channel must remain on the same connection and the proposed metadata may not change the
+// interchain account address. The channel order must match the ordering negotiated in the metadata.
+func (k Keeper) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	if !strings.HasPrefix(portID, icatypes.PortPrefix) {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "expected %s{owner-account-address}, got %s", icatypes.PortPrefix, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	if connectionHops[0] != channel.ConnectionHops[0] {
+		return "", sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "interchain account channels cannot be upgraded to a different connection: expected %s, got %s", channel.ConnectionHops[0], connectionHops[0])
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+		return "", err
+	}
+
+	if err := icatypes.ValidateUpgradeMetadata(channel.Version, metadata); err != nil {
+		return "", err
+	}
+
+	if order != metadata.ChannelOrdering() {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", metadata.ChannelOrdering(), order)
+	}
+
+	return version, nil
+}
+
+// OnChanUpgradeAck validates the metadata selected by the host chain for a channel upgrade.
+// The interchain account address must match the address registered for the active channel.
+func (k Keeper) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(counterpartyVersion), &metadata); err != nil {
+		return sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	if err := icatypes.ValidateControllerMetadata(ctx, k.channelKeeper, channel.ConnectionHops, metadata); err != nil {
+		return err
+	}
+
+	if address, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], portID); !found || address != metadata.Address {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidAccountAddress, "expected %s, got %s", address, metadata.Address)
+	}
+
+	if channel.Ordering != metadata.ChannelOrdering() {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", metadata.ChannelOrdering(), channel.Ordering)
+	}
+
+	return nil
+}
+
 // OnChanCloseConfirm removes the active channel stored in state
 func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
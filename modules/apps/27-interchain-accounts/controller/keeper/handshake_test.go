@@ -75,6 +75,20 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			false,
 		},
+		{
+			"success - UNORDERED channel negotiated in metadata",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				channel.Ordering = channeltypes.UNORDERED
+				channel.Version = string(versionBytes)
+				path.EndpointA.SetChannel(*channel)
+			},
+			true,
+		},
 		{
 			"invalid order - UNORDERED",
 			func() {
@@ -82,6 +96,32 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			false,
 		},
+		{
+			"invalid order - ORDERED channel with UNORDERED metadata",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				channel.Version = string(versionBytes)
+				path.EndpointA.SetChannel(*channel)
+			},
+			false,
+		},
+		{
+			"unsupported channel ordering",
+			func() {
+				metadata.Ordering = channeltypes.Order(10)
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				channel.Version = string(versionBytes)
+				path.EndpointA.SetChannel(*channel)
+			},
+			false,
+		},
 		{
 			"invalid port ID",
 			func() {
@@ -105,6 +145,19 @@ func (suite *KeeperTestSuite) TestOnChanOpenInit() {
 			},
 			false,
 		},
+		{
+			"success - proto3 JSON encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				channel.Version = string(versionBytes)
+				path.EndpointA.SetChannel(*channel)
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -401,6 +454,167 @@ func (suite *KeeperTestSuite) TestOnChanOpenAck() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnChanUpgradeInit() {
+	var (
+		path           *ibctesting.Path
+		metadata       icatypes.Metadata
+		order          channeltypes.Order
+		connectionHops []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: ORDERED to UNORDERED", func() {
+				metadata.Ordering = channeltypes.UNORDERED
+				order = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"invalid port ID", func() {
+				path.EndpointA.ChannelConfig.PortID = "invalid-port-id"
+			}, false,
+		},
+		{
+			"channel not found", func() {
+				path.EndpointA.ChannelID = "channel-100"
+			}, false,
+		},
+		{
+			"connection cannot be changed", func() {
+				connectionHops = []string{"connection-100"}
+			}, false,
+		},
+		{
+			"unsupported encoding format", func() {
+				metadata.Encoding = "invalid-encoding-format"
+			}, false,
+		},
+		{
+			"interchain account address cannot be changed", func() {
+				metadata.Address = TestOwnerAddress
+			}, false,
+		},
+		{
+			"channel order does not match the metadata", func() {
+				order = channeltypes.UNORDERED
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = icatypes.ModuleCdc.UnmarshalJSON([]byte(path.EndpointA.GetChannel().Version), &metadata)
+			suite.Require().NoError(err)
+
+			metadata.Encoding = icatypes.EncodingProto3JSON
+			order = channeltypes.ORDERED
+			connectionHops = []string{path.EndpointA.ConnectionID}
+
+			tc.malleate() // malleate mutates test data
+
+			versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+			suite.Require().NoError(err)
+
+			version, err := suite.chainA.GetSimApp().ICAControllerKeeper.OnChanUpgradeInit(suite.chainA.GetContext(),
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, order, connectionHops, string(versionBytes),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(string(versionBytes), version)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnChanUpgradeAck() {
+	var (
+		path     *ibctesting.Path
+		metadata icatypes.Metadata
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"channel not found", func() {
+				path.EndpointA.ChannelID = "channel-100"
+			}, false,
+		},
+		{
+			"unsupported transaction type", func() {
+				metadata.TxType = "invalid-tx-types"
+			}, false,
+		},
+		{
+			"interchain account address does not match", func() {
+				metadata.Address = TestOwnerAddress
+			}, false,
+		},
+		{
+			"channel order does not match the metadata", func() {
+				metadata.Ordering = channeltypes.UNORDERED
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = icatypes.ModuleCdc.UnmarshalJSON([]byte(path.EndpointB.GetChannel().Version), &metadata)
+			suite.Require().NoError(err)
+
+			tc.malleate() // malleate mutates test data
+
+			versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+			suite.Require().NoError(err)
+
+			err = suite.chainA.GetSimApp().ICAControllerKeeper.OnChanUpgradeAck(suite.chainA.GetContext(),
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, string(versionBytes),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
 	var (
 		path *ibctesting.Path
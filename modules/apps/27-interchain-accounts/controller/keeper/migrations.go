@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator. The keeper may be nil if the controller submodule is not enabled
+// by the application.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateMiddlewareEnabled migrates from version 1 to 2.
+// This migration flags all existing active channels as controlled by an authentication module
+// through the controller middleware, as the controller Msg service did not exist before.
+func (m Migrator) MigrateMiddlewareEnabled(ctx sdk.Context) error {
+	if m.keeper == nil {
+		return nil
+	}
+
+	for _, ch := range m.keeper.GetAllActiveChannels(ctx) {
+		m.keeper.SetMiddlewareEnabled(ctx, ch.PortId, ch.ConnectionId)
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestMigrateMiddlewareEnabled() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	// remove the flag as it is not set before the migration
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Delete(icatypes.KeyIsMiddlewareEnabled(TestPortID, ibctesting.FirstConnectionID))
+	suite.Require().False(suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID))
+
+	migrator := keeper.NewMigrator(&suite.chainA.GetSimApp().ICAControllerKeeper)
+	suite.Require().NoError(migrator.MigrateMiddlewareEnabled(suite.chainA.GetContext()))
+
+	suite.Require().True(suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID))
+
+	// the migration is a no-op if the controller submodule is not enabled
+	suite.Require().NoError(keeper.NewMigrator(nil).MigrateMiddlewareEnabled(suite.chainA.GetContext()))
+}
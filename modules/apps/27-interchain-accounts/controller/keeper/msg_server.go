@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"context"
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the interchain accounts controller MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// RegisterInterchainAccount defines a rpc handler for MsgRegisterInterchainAccount. The interchain account
+// is controlled by the owner through the Msg service, i.e. the controller middleware is disabled for the
+// port and connection identifiers.
+func (s msgServer) RegisterInterchainAccount(goCtx context.Context, msg *types.MsgRegisterInterchainAccount) (*types.MsgRegisterInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.IsMiddlewareEnabled(ctx, portID, msg.ConnectionId) && !s.isActiveChannelClosed(ctx, msg.ConnectionId, portID) {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidChannelFlow, "interchain account for portID %s on connection %s is controlled by an authentication module", portID, msg.ConnectionId)
+	}
+
+	s.SetMiddlewareDisabled(ctx, portID, msg.ConnectionId)
+
+	channelID, err := s.registerInterchainAccount(ctx, msg.ConnectionId, portID, msg.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("interchain account registration initiated", "port-id", portID, "connection-id", msg.ConnectionId, "channel-id", channelID)
+
+	return &types.MsgRegisterInterchainAccountResponse{
+		ChannelId: channelID,
+	}, nil
+}
+
+// SendTx defines a rpc handler for MsgSendTx. The packet timeout is computed relative to the current block time.
+func (s msgServer) SendTx(goCtx context.Context, msg *types.MsgSendTx) (*types.MsgSendTxResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.IsMiddlewareEnabled(ctx, portID, msg.ConnectionId) {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidChannelFlow, "interchain account for portID %s on connection %s is controlled by an authentication module", portID, msg.ConnectionId)
+	}
+
+	blockTime := uint64(ctx.BlockTime().UnixNano())
+	if msg.RelativeTimeout > math.MaxUint64-blockTime {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout %d overflows the absolute timeout timestamp at block time %d", msg.RelativeTimeout, blockTime)
+	}
+
+	absoluteTimeout := blockTime + msg.RelativeTimeout
+
+	sequence, err := s.sendTx(ctx, msg.ConnectionId, portID, msg.PacketData, absoluteTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSendTxResponse{
+		Sequence: sequence,
+	}, nil
+}
+
+// ReopenInterchainAccount defines a rpc handler for MsgReopenInterchainAccount. Whether the interchain account is
+// controlled by an authentication module or through the Msg service is unchanged.
+func (s msgServer) ReopenInterchainAccount(goCtx context.Context, msg *types.MsgReopenInterchainAccount) (*types.MsgReopenInterchainAccountResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	portID, err := icatypes.NewControllerPortID(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	channelID, err := s.Keeper.ReopenInterchainAccount(ctx, msg.ConnectionId, portID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("interchain account channel reopening initiated", "port-id", portID, "connection-id", msg.ConnectionId, "channel-id", channelID)
+
+	return &types.MsgReopenInterchainAccountResponse{
+		ChannelId: channelID,
+	}, nil
+}
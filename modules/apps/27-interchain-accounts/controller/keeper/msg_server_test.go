@@ -0,0 +1,311 @@
+package keeper_test
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// SetupICAPathWithMsgServer registers an interchain account using the controller Msg service and
+// completes the subsequent channel handshake
+func SetupICAPathWithMsgServer(path *ibctesting.Path, owner string) error {
+	endpoint := path.EndpointA
+	msgServer := keeper.NewMsgServerImpl(&endpoint.Chain.GetSimApp().ICAControllerKeeper)
+
+	msg := types.NewMsgRegisterInterchainAccount(endpoint.ConnectionID, owner, "")
+	res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(endpoint.Chain.GetContext()), msg)
+	if err != nil {
+		return err
+	}
+
+	// commit state changes for proof verification
+	endpoint.Chain.App.Commit()
+	endpoint.Chain.NextBlock()
+
+	// update port/channel ids
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID = res.ChannelId
+	endpoint.ChannelConfig.PortID = portID
+
+	if err := path.EndpointB.ChanOpenTry(); err != nil {
+		return err
+	}
+
+	if err := path.EndpointA.ChanOpenAck(); err != nil {
+		return err
+	}
+
+	return path.EndpointB.ChanOpenConfirm()
+}
+
+func (suite *KeeperTestSuite) TestRegisterInterchainAccountMsgServer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgRegisterInterchainAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success with version", func() {
+				msg.Version = TestVersion
+			}, true,
+		},
+		{
+			"success with UNORDERED channel version", func() {
+				metadata := icatypes.NewMetadata(icatypes.Version, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, "", icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+				metadata.Ordering = channeltypes.UNORDERED
+
+				msg.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			}, true,
+		},
+		{
+			"success: interchain account previously controlled by an authentication module on a closed channel", func() {
+				err := SetupICAPath(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+
+				err = path.EndpointA.SetChannelClosed()
+				suite.Require().NoError(err)
+
+				msg.Version = TestVersion
+			}, true,
+		},
+		{
+			"invalid version", func() {
+				msg.Version = "invalid-version"
+			}, false,
+		},
+		{
+			"connection not found", func() {
+				msg.ConnectionId = "connection-100"
+			}, false,
+		},
+		{
+			"interchain account is controlled by an authentication module", func() {
+				err := RegisterInterchainAccount(path.EndpointA, TestOwnerAddress)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"active channel already open", func() {
+				err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+				suite.Require().NoError(err)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			msg = types.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, "")
+
+			tc.malleate()
+
+			expChannelID := channeltypes.FormatChannelIdentifier(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextChannelSequence(suite.chainA.GetContext()))
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expChannelID, res.ChannelId)
+
+				suite.Require().True(suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareDisabled(suite.chainA.GetContext(), TestPortID, msg.ConnectionId))
+
+				// the controller submodule owns the channel capability
+				_, found := suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().True(found)
+
+				_, found = suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(TestPortID, res.ChannelId))
+				suite.Require().False(found)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendTxMsgServer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgSendTx
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"interchain account is controlled by an authentication module", func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetMiddlewareEnabled(suite.chainA.GetContext(), TestPortID, path.EndpointA.ConnectionID)
+			}, false,
+		},
+		{
+			"active channel not found", func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			}, false,
+		},
+		{
+			"channel capability not owned by the controller submodule", func() {
+				chanCap, found := suite.chainA.GetSimApp().ScopedICAControllerKeeper.GetCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(TestPortID, path.EndpointA.ChannelID))
+				suite.Require().True(found)
+
+				err := suite.chainA.GetSimApp().ScopedICAControllerKeeper.ReleaseCapability(suite.chainA.GetContext(), chanCap)
+				suite.Require().NoError(err)
+			}, false,
+		},
+		{
+			"invalid packet data", func() {
+				msg.PacketData.Data = nil
+			}, false,
+		},
+		{
+			"relative timeout overflows the absolute timeout timestamp", func() {
+				msg.RelativeTimeout = math.MaxUint64
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainA.GetSimApp().ICAControllerKeeper.GetInterchainAccountAddress(suite.chainA.GetContext(), path.EndpointA.ConnectionID, TestPortID)
+			suite.Require().True(found)
+
+			sendMsg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainB.GetSimApp().AppCodec(), []sdk.Msg{sendMsg})
+			suite.Require().NoError(err)
+
+			packetData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			msg = types.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, packetData)
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.SendTx(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), res.Sequence)
+
+				nextSeq, found := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), TestPortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(2), nextSeq)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestReopenInterchainAccountMsgServer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgReopenInterchainAccount
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"active channel not found", func() {
+				msg.Owner = suite.chainA.SenderAccount.GetAddress().String()
+			}, false,
+		},
+		{
+			"active channel is OPEN", func() {
+				channel := path.EndpointA.GetChannel()
+				channel.State = channeltypes.OPEN
+				path.EndpointA.SetChannel(channel)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPathWithMsgServer(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.SetChannelClosed()
+			suite.Require().NoError(err)
+
+			msg = types.NewMsgReopenInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress)
+
+			tc.malleate()
+
+			msgServer := keeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.ReopenInterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(channeltypes.FormatChannelIdentifier(1), res.ChannelId)
+
+				// the interchain account remains controlled through the Msg service
+				suite.Require().True(suite.chainA.GetSimApp().ICAControllerKeeper.IsMiddlewareDisabled(suite.chainA.GetContext(), TestPortID, path.EndpointA.ConnectionID))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
@@ -14,9 +14,17 @@ func (k Keeper) IsControllerEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// IsReopenOnTimeoutEnabled retrieves the reopen on timeout boolean from the paramstore.
+// True is returned if the channel of an interchain account is reopened automatically after a packet timeout.
+func (k Keeper) IsReopenOnTimeoutEnabled(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyReopenOnTimeout, &res)
+	return res
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsControllerEnabled(ctx))
+	return types.NewParams(k.IsControllerEnabled(ctx), k.IsReopenOnTimeoutEnabled(ctx))
 }
 
 // SetParams sets the total set of the host submodule parameters.
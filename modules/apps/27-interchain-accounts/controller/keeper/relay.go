@@ -8,6 +8,7 @@ import (
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // SendTx takes pre-built packet data containing messages to be executed on the host chain from an authentication module and attempts to send the packet.
@@ -36,6 +37,22 @@ func (k Keeper) SendTx(ctx sdk.Context, chanCap *capabilitytypes.Capability, con
 	return k.createOutgoingPacket(ctx, portID, activeChannelID, destinationPort, destinationChannel, chanCap, icaPacketData, timeoutTimestamp)
 }
 
+// sendTx retrieves the channel capability owned by the controller submodule for the active channel
+// associated with the provided connectionID and portID and attempts to send the packet.
+func (k Keeper) sendTx(ctx sdk.Context, connectionID, portID string, icaPacketData icatypes.InterchainAccountPacketData, timeoutTimestamp uint64) (uint64, error) {
+	activeChannelID, found := k.GetOpenActiveChannel(ctx, connectionID, portID)
+	if !found {
+		return 0, sdkerrors.Wrapf(icatypes.ErrActiveChannelNotFound, "failed to retrieve active channel on connection %s for port %s", connectionID, portID)
+	}
+
+	chanCap, found := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(portID, activeChannelID))
+	if !found {
+		return 0, sdkerrors.Wrapf(channeltypes.ErrChannelCapabilityNotFound, "controller submodule does not own the capability of channel %s on port %s", activeChannelID, portID)
+	}
+
+	return k.SendTx(ctx, chanCap, connectionID, portID, icaPacketData, timeoutTimestamp)
+}
+
 func (k Keeper) createOutgoingPacket(
 	ctx sdk.Context,
 	sourcePort,
@@ -74,8 +91,32 @@ func (k Keeper) createOutgoingPacket(
 	return packet.Sequence, nil
 }
 
-// OnTimeoutPacket removes the active channel associated with the provided packet, the underlying channel end is closed
-// due to the semantics of ORDERED channels
+// OnTimeoutPacket flags the interchain account associated with the provided packet for reopening at the end of the
+// block if the reopen on timeout param is enabled and the channel is ORDERED. The underlying channel end is closed after
+// this callback due to the semantics of ORDERED channels, the new channel can therefore not be opened immediately.
+// UNORDERED channels remain open after a packet timeout.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	if !k.IsReopenOnTimeoutEnabled(ctx) {
+		return nil
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", packet.GetSourceChannel(), packet.GetSourcePort())
+	}
+
+	if channel.Ordering != channeltypes.ORDERED {
+		return nil
+	}
+
+	connectionID, err := k.GetConnectionID(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if err != nil {
+		return err
+	}
+
+	if activeChannelID, found := k.GetActiveChannelID(ctx, connectionID, packet.GetSourcePort()); found && activeChannelID == packet.GetSourceChannel() {
+		k.SetPendingReopen(ctx, packet.GetSourcePort(), connectionID)
+	}
+
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
@@ -184,7 +185,8 @@ func (suite *KeeperTestSuite) TestSendTx() {
 
 func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 	var (
-		path *ibctesting.Path
+		path      *ibctesting.Path
+		expReopen bool
 	)
 
 	testCases := []struct {
@@ -197,6 +199,41 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			func() {},
 			true,
 		},
+		{
+			"success: interchain account flagged for reopening",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true))
+				expReopen = true
+			},
+			true,
+		},
+		{
+			"success: packet channel is not the active channel",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true))
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetActiveChannelID(suite.chainA.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID, "channel-100")
+			},
+			true,
+		},
+		{
+			"success: UNORDERED channel is not flagged for reopening",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true))
+
+				channel := path.EndpointA.GetChannel()
+				channel.Ordering = channeltypes.UNORDERED
+				path.EndpointA.SetChannel(channel)
+			},
+			true,
+		},
+		{
+			"channel not found",
+			func() {
+				suite.chainA.GetSimApp().ICAControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true))
+				path.EndpointA.ChannelID = "channel-100"
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -209,6 +246,8 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			err := SetupICAPath(path, TestOwnerAddress)
 			suite.Require().NoError(err)
 
+			expReopen = false
+
 			tc.malleate() // malleate mutates test data
 
 			packet := channeltypes.NewPacket(
@@ -226,6 +265,9 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+
+				hasPendingReopen := suite.chainA.GetSimApp().ICAControllerKeeper.HasPendingReopen(suite.chainA.GetContext(), TestPortID, ibctesting.FirstConnectionID)
+				suite.Require().Equal(expReopen, hasPendingReopen)
 			} else {
 				suite.Require().Error(err)
 			}
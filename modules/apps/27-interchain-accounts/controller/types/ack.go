@@ -0,0 +1,179 @@
+package types
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io/ioutil"
+	"reflect"
+	"strings"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogogrpc "github.com/gogo/protobuf/grpc"
+	"github.com/gogo/protobuf/proto"
+	"github.com/gogo/protobuf/protoc-gen-gogo/descriptor"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+)
+
+// DecodeTxMsgData unmarshals the result of a successful acknowledgement of an EXECUTE_TX packet into sdk.TxMsgData
+// and decodes the response of each executed message, in the order of the messages. The message types are resolved
+// through the provided interface registry, and the response of a message is expected to be the registered protobuf
+// message named after the message with a Response suffix (e.g. cosmos.bank.v1beta1.MsgSendResponse).
+func DecodeTxMsgData(registry codectypes.InterfaceRegistry, bz []byte) ([]proto.Message, error) {
+	var txMsgData sdk.TxMsgData
+	if err := proto.Unmarshal(bz, &txMsgData); err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidAcknowledgement, "failed to unmarshal tx msg data: %s", err)
+	}
+
+	responses := make([]proto.Message, len(txMsgData.Data))
+	for i, msgData := range txMsgData.Data {
+		msg, err := registry.Resolve(msgData.MsgType)
+		if err != nil {
+			return nil, err
+		}
+
+		responseName := proto.MessageName(msg) + "Response"
+		responseType := proto.MessageType(responseName)
+		if responseType == nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "response type %s is not registered", responseName)
+		}
+
+		response, ok := reflect.New(responseType.Elem()).Interface().(proto.Message)
+		if !ok {
+			return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "response type %s is not a protobuf message", responseName)
+		}
+
+		if err := proto.Unmarshal(msgData.Data, response); err != nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidAcknowledgement, "failed to unmarshal %s: %s", responseName, err)
+		}
+
+		responses[i] = response
+	}
+
+	return responses, nil
+}
+
+var _ gogogrpc.Server = &QueryServiceRegistry{}
+
+// QueryServiceRegistry records the response types of the methods of gRPC query services. It implements the
+// gogoproto gRPC Server interface so that services are registered with their generated registration function,
+// e.g. banktypes.RegisterQueryServer(registry, nil). The response type of each method is resolved from the
+// registered file descriptor of the service.
+type QueryServiceRegistry struct {
+	responseNames map[string]string
+}
+
+// NewQueryServiceRegistry creates a new, empty QueryServiceRegistry
+func NewQueryServiceRegistry() *QueryServiceRegistry {
+	return &QueryServiceRegistry{
+		responseNames: make(map[string]string),
+	}
+}
+
+// RegisterService implements the gogoproto gRPC Server interface. The handler is ignored. It panics if the
+// file descriptor of the service is not registered, as the generated registration functions are called on
+// application startup.
+func (qsr *QueryServiceRegistry) RegisterService(sd *grpc.ServiceDesc, _ interface{}) {
+	service, err := serviceDescriptor(sd)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, method := range service.GetMethod() {
+		path := fmt.Sprintf("/%s/%s", sd.ServiceName, method.GetName())
+		qsr.responseNames[path] = strings.TrimPrefix(method.GetOutputType(), ".")
+	}
+}
+
+// ResponseName returns the full name of the response message of the provided gRPC query path
+func (qsr *QueryServiceRegistry) ResponseName(path string) (string, error) {
+	responseName, found := qsr.responseNames[path]
+	if !found {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "query path %s is not registered", path)
+	}
+
+	return responseName, nil
+}
+
+// serviceDescriptor returns the descriptor of the provided gRPC service from the file descriptor registered
+// for the file the service is declared in.
+func serviceDescriptor(sd *grpc.ServiceDesc) (*descriptor.ServiceDescriptorProto, error) {
+	fileName, ok := sd.Metadata.(string)
+	if !ok {
+		return nil, fmt.Errorf("file name of service %s is unknown", sd.ServiceName)
+	}
+
+	gz := proto.FileDescriptor(fileName)
+	if gz == nil {
+		return nil, fmt.Errorf("file descriptor of %s is not registered", fileName)
+	}
+
+	r, err := gzip.NewReader(bytes.NewReader(gz))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file descriptor of %s: %w", fileName, err)
+	}
+
+	bz, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("failed to uncompress file descriptor of %s: %w", fileName, err)
+	}
+
+	var file descriptor.FileDescriptorProto
+	if err := proto.Unmarshal(bz, &file); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal file descriptor of %s: %w", fileName, err)
+	}
+
+	for _, service := range file.GetService() {
+		if file.GetPackage()+"."+service.GetName() == sd.ServiceName {
+			return service, nil
+		}
+	}
+
+	return nil, fmt.Errorf("service %s is not declared in %s", sd.ServiceName, fileName)
+}
+
+// DecodeCosmosQueryResponse unmarshals the result of a successful acknowledgement of a QUERY packet into the
+// ABCI query responses of the host chain and decodes the value of each response, in the order of the provided
+// requests of the packet. The response type of each query is resolved from the method descriptor of the query
+// path in the provided registry, in which the query services of the requests must be registered.
+func DecodeCosmosQueryResponse(services *QueryServiceRegistry, reqs []abci.RequestQuery, bz []byte) ([]proto.Message, error) {
+	queryResponses, err := icatypes.DeserializeCosmosQueryResponse(bz)
+	if err != nil {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidAcknowledgement, "failed to unmarshal cosmos query response: %s", err)
+	}
+
+	if len(queryResponses) != len(reqs) {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidAcknowledgement, "expected %d query responses, got %d", len(reqs), len(queryResponses))
+	}
+
+	responses := make([]proto.Message, len(reqs))
+	for i, req := range reqs {
+		responseName, err := services.ResponseName(req.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		responseType := proto.MessageType(responseName)
+		if responseType == nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "response type %s is not registered", responseName)
+		}
+
+		response, ok := reflect.New(responseType.Elem()).Interface().(proto.Message)
+		if !ok {
+			return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "response type %s is not a protobuf message", responseName)
+		}
+
+		if err := proto.Unmarshal(queryResponses[i].Value, response); err != nil {
+			return nil, sdkerrors.Wrapf(icatypes.ErrInvalidAcknowledgement, "failed to unmarshal %s: %s", responseName, err)
+		}
+
+		responses[i] = response
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,215 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/client/grpc/tmservice"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmp2p "github.com/tendermint/tendermint/proto/tendermint/p2p"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func TestDecodeTxMsgData(t *testing.T) {
+	registry := simapp.MakeTestEncodingConfig().InterfaceRegistry
+
+	sendResponse := &banktypes.MsgSendResponse{}
+	delegateResponse := &stakingtypes.MsgDelegateResponse{}
+
+	newMsgData := func(msg sdk.Msg, response proto.Message) *sdk.MsgData {
+		bz, err := proto.Marshal(response)
+		require.NoError(t, err)
+
+		return &sdk.MsgData{MsgType: sdk.MsgTypeURL(msg), Data: bz}
+	}
+
+	testCases := []struct {
+		name         string
+		txMsgData    *sdk.TxMsgData
+		expResponses []proto.Message
+		expPass      bool
+	}{
+		{
+			"success",
+			&sdk.TxMsgData{Data: []*sdk.MsgData{
+				newMsgData(&banktypes.MsgSend{}, sendResponse),
+				newMsgData(&stakingtypes.MsgDelegate{}, delegateResponse),
+			}},
+			[]proto.Message{sendResponse, delegateResponse},
+			true,
+		},
+		{
+			"success with no messages",
+			&sdk.TxMsgData{},
+			[]proto.Message{},
+			true,
+		},
+		{
+			"unregistered message type",
+			&sdk.TxMsgData{Data: []*sdk.MsgData{
+				{MsgType: "/cosmos.invalid.v1beta1.MsgInvalid", Data: []byte{}},
+			}},
+			nil,
+			false,
+		},
+		{
+			"invalid response bytes",
+			&sdk.TxMsgData{Data: []*sdk.MsgData{
+				{MsgType: sdk.MsgTypeURL(&banktypes.MsgSend{}), Data: []byte("invalid response")},
+			}},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bz, err := proto.Marshal(tc.txMsgData)
+		require.NoError(t, err, tc.name)
+
+		responses, err := types.DecodeTxMsgData(registry, bz)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expResponses, responses, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	_, err := types.DecodeTxMsgData(registry, []byte("invalid tx msg data"))
+	require.Error(t, err)
+}
+
+func TestQueryServiceRegistry(t *testing.T) {
+	services := types.NewQueryServiceRegistry()
+	banktypes.RegisterQueryServer(services, nil)
+	tmservice.RegisterServiceServer(services, nil)
+
+	testCases := []struct {
+		name            string
+		path            string
+		expResponseName string
+		expPass         bool
+	}{
+		{"response named after the service and method", "/cosmos.bank.v1beta1.Query/Balance", "cosmos.bank.v1beta1.QueryBalanceResponse", true},
+		{"response named after the method", "/cosmos.base.tendermint.v1beta1.Service/GetNodeInfo", "cosmos.base.tendermint.v1beta1.GetNodeInfoResponse", true},
+		{"service is not registered", "/cosmos.staking.v1beta1.Query/Validators", "", false},
+		{"method does not exist", "/cosmos.bank.v1beta1.Query/Invalid", "", false},
+		{"invalid query path", "/cosmos.bank.v1beta1.Query", "", false},
+	}
+
+	for _, tc := range testCases {
+		responseName, err := services.ResponseName(tc.path)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expResponseName, responseName, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	// services of which the file descriptor is not registered cannot be registered
+	require.Panics(t, func() {
+		services.RegisterService(&grpc.ServiceDesc{ServiceName: "cosmos.invalid.v1beta1.Query", Metadata: "cosmos/invalid/v1beta1/query.proto"}, nil)
+	})
+	require.Panics(t, func() {
+		services.RegisterService(&grpc.ServiceDesc{ServiceName: "cosmos.invalid.v1beta1.Query"}, nil)
+	})
+}
+
+func TestDecodeCosmosQueryResponse(t *testing.T) {
+	services := types.NewQueryServiceRegistry()
+	banktypes.RegisterQueryServer(services, nil)
+	stakingtypes.RegisterQueryServer(services, nil)
+	tmservice.RegisterServiceServer(services, nil)
+
+	balanceResponse := &banktypes.QueryBalanceResponse{Balance: &sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	validatorsResponse := &stakingtypes.QueryValidatorsResponse{}
+	// the response of the query does not follow the <service><method>Response naming of the other services
+	nodeInfoResponse := &tmservice.GetNodeInfoResponse{DefaultNodeInfo: &tmp2p.DefaultNodeInfo{Moniker: "host"}}
+
+	balanceReq := abci.RequestQuery{Path: "/cosmos.bank.v1beta1.Query/Balance"}
+	validatorsReq := abci.RequestQuery{Path: "/cosmos.staking.v1beta1.Query/Validators"}
+	nodeInfoReq := abci.RequestQuery{Path: "/cosmos.base.tendermint.v1beta1.Service/GetNodeInfo"}
+
+	newResponseQuery := func(response proto.Message) abci.ResponseQuery {
+		bz, err := proto.Marshal(response)
+		require.NoError(t, err)
+
+		return abci.ResponseQuery{Value: bz}
+	}
+
+	testCases := []struct {
+		name           string
+		reqs           []abci.RequestQuery
+		queryResponses []abci.ResponseQuery
+		expResponses   []proto.Message
+		expPass        bool
+	}{
+		{
+			"success",
+			[]abci.RequestQuery{balanceReq, validatorsReq},
+			[]abci.ResponseQuery{newResponseQuery(balanceResponse), newResponseQuery(validatorsResponse)},
+			[]proto.Message{balanceResponse, validatorsResponse},
+			true,
+		},
+		{
+			"success with a response not named after the service",
+			[]abci.RequestQuery{nodeInfoReq, balanceReq},
+			[]abci.ResponseQuery{newResponseQuery(nodeInfoResponse), newResponseQuery(balanceResponse)},
+			[]proto.Message{nodeInfoResponse, balanceResponse},
+			true,
+		},
+		{
+			"number of responses does not match the number of requests",
+			[]abci.RequestQuery{balanceReq, validatorsReq},
+			[]abci.ResponseQuery{newResponseQuery(balanceResponse)},
+			nil,
+			false,
+		},
+		{
+			"invalid query path",
+			[]abci.RequestQuery{{Path: "/cosmos.bank.v1beta1.Query"}},
+			[]abci.ResponseQuery{newResponseQuery(balanceResponse)},
+			nil,
+			false,
+		},
+		{
+			"unregistered query service",
+			[]abci.RequestQuery{{Path: "/cosmos.invalid.v1beta1.Query/Invalid"}},
+			[]abci.ResponseQuery{newResponseQuery(balanceResponse)},
+			nil,
+			false,
+		},
+		{
+			"invalid response bytes",
+			[]abci.RequestQuery{balanceReq},
+			[]abci.ResponseQuery{{Value: []byte("invalid response")}},
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bz, err := icatypes.SerializeCosmosQueryResponse(tc.queryResponses)
+		require.NoError(t, err, tc.name)
+
+		responses, err := types.DecodeCosmosQueryResponse(services, tc.reqs, bz)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expResponses, responses, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+
+	_, err := types.DecodeCosmosQueryResponse(services, []abci.RequestQuery{balanceReq}, []byte("invalid cosmos query response"))
+	require.Error(t, err)
+}
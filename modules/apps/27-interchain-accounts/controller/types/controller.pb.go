@@ -28,6 +28,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// controller_enabled enables or disables the controller submodule.
 	ControllerEnabled bool `protobuf:"varint,1,opt,name=controller_enabled,json=controllerEnabled,proto3" json:"controller_enabled,omitempty" yaml:"controller_enabled"`
+	// reopen_on_timeout enables the automatic reopening of the channel of an interchain account when a packet times
+	// out, which closes the ORDERED channel. The new channel is opened at the end of the block.
+	ReopenOnTimeout bool `protobuf:"varint,2,opt,name=reopen_on_timeout,json=reopenOnTimeout,proto3" json:"reopen_on_timeout,omitempty" yaml:"reopen_on_timeout"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +73,13 @@ func (m *Params) GetControllerEnabled() bool {
 	return false
 }
 
+func (m *Params) GetReopenOnTimeout() bool {
+	if m != nil {
+		return m.ReopenOnTimeout
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.controller.v1.Params")
 }
@@ -79,23 +89,26 @@ func init() {
 }
 
 var fileDescriptor_177fd0fec5eb3400 = []byte{
-	// 254 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x72, 0xce, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0x4f, 0xce, 0xcf, 0x2b, 0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0xd2, 0x2f, 0x33, 0x44, 0xe2,
-	0xe9, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x19, 0x65, 0x26, 0x25, 0xeb, 0x21, 0x1b, 0xa2, 0x87,
-	0xc5, 0x10, 0x3d, 0x24, 0x6d, 0x65, 0x86, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xed, 0xfa,
-	0x20, 0x16, 0xc4, 0x24, 0xa5, 0x30, 0x2e, 0xb6, 0x80, 0xc4, 0xa2, 0xc4, 0xdc, 0x62, 0x21, 0x1f,
-	0x2e, 0x21, 0x84, 0x86, 0xf8, 0xd4, 0xbc, 0xc4, 0xa4, 0x9c, 0xd4, 0x14, 0x09, 0x46, 0x05, 0x46,
-	0x0d, 0x0e, 0x27, 0xd9, 0x4f, 0xf7, 0xe4, 0x25, 0x2b, 0x13, 0x73, 0x73, 0xac, 0x94, 0x30, 0xd5,
-	0x28, 0x05, 0x09, 0x22, 0x04, 0x5d, 0x21, 0x62, 0x4e, 0x59, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78,
-	0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc,
-	0x78, 0x2c, 0xc7, 0x10, 0x15, 0x90, 0x9e, 0x59, 0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab,
-	0x9f, 0x9c, 0x5f, 0x9c, 0x9b, 0x5f, 0xac, 0x9f, 0x99, 0x94, 0xac, 0x9b, 0x9e, 0xaf, 0x5f, 0x66,
-	0xac, 0x9f, 0x9b, 0x9f, 0x52, 0x9a, 0x93, 0x5a, 0x0c, 0x0a, 0xa0, 0x62, 0x7d, 0x23, 0x73, 0x5d,
-	0x84, 0xb7, 0x74, 0xb1, 0x85, 0x4d, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x2b, 0xc6,
-	0x80, 0x00, 0x00, 0x00, 0xff, 0xff, 0xe4, 0x79, 0xfc, 0x19, 0x5b, 0x01, 0x00, 0x00,
+	// 290 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0xb1, 0x4a, 0x03, 0x31,
+	0x1c, 0x87, 0x1b, 0x87, 0x22, 0xb7, 0x48, 0x0f, 0x87, 0x2a, 0x9a, 0xca, 0x4d, 0x2e, 0xbd, 0xd0,
+	0x76, 0x10, 0x1c, 0x2b, 0x82, 0x83, 0x60, 0x29, 0x4e, 0x2e, 0x47, 0x92, 0x86, 0x6b, 0x24, 0xc9,
+	0xff, 0x48, 0x72, 0x07, 0x7d, 0x0b, 0x1f, 0xc1, 0xc7, 0x71, 0xec, 0xe8, 0x54, 0xe4, 0xee, 0x0d,
+	0xfa, 0x04, 0xd2, 0xbb, 0xe1, 0x0e, 0xda, 0x2d, 0xf9, 0xf8, 0xff, 0xbe, 0xe1, 0x0b, 0x9e, 0x24,
+	0xe3, 0x84, 0x66, 0x99, 0x92, 0x9c, 0x7a, 0x09, 0xc6, 0x11, 0x69, 0xbc, 0xb0, 0x7c, 0x4d, 0xa5,
+	0x49, 0x28, 0xe7, 0x90, 0x1b, 0xef, 0x08, 0x07, 0xe3, 0x2d, 0x28, 0x25, 0x2c, 0x29, 0x26, 0x9d,
+	0x5f, 0x9c, 0x59, 0xf0, 0x10, 0x4e, 0x25, 0xe3, 0x71, 0x57, 0x12, 0x9f, 0x90, 0xc4, 0x9d, 0x59,
+	0x31, 0xb9, 0xbe, 0x4c, 0x21, 0x85, 0x7a, 0x4e, 0x0e, 0xaf, 0xc6, 0x14, 0x7d, 0xa3, 0xa0, 0xbf,
+	0xa0, 0x96, 0x6a, 0x17, 0xbe, 0x06, 0x61, 0xbb, 0x48, 0x84, 0xa1, 0x4c, 0x89, 0xd5, 0x10, 0xdd,
+	0xa1, 0xfb, 0xf3, 0xf9, 0xed, 0x7e, 0x37, 0xba, 0xda, 0x50, 0xad, 0x1e, 0xa3, 0xe3, 0x9b, 0x68,
+	0x39, 0x68, 0xe1, 0x73, 0xc3, 0xc2, 0x97, 0x60, 0x60, 0x05, 0x64, 0xc2, 0x24, 0x60, 0x12, 0x2f,
+	0xb5, 0x80, 0xdc, 0x0f, 0xcf, 0x6a, 0xd9, 0xcd, 0x7e, 0x37, 0x1a, 0x36, 0xb2, 0xa3, 0x93, 0x68,
+	0x79, 0xd1, 0xb0, 0x37, 0xf3, 0xde, 0x90, 0xf9, 0xe7, 0x4f, 0x89, 0xd1, 0xb6, 0xc4, 0xe8, 0xaf,
+	0xc4, 0xe8, 0xab, 0xc2, 0xbd, 0x6d, 0x85, 0x7b, 0xbf, 0x15, 0xee, 0x7d, 0x2c, 0x52, 0xe9, 0xd7,
+	0x39, 0x8b, 0x39, 0x68, 0xc2, 0xc1, 0x69, 0x70, 0x44, 0x32, 0x3e, 0x4e, 0x81, 0x14, 0x33, 0xa2,
+	0x61, 0x95, 0x2b, 0xe1, 0x0e, 0xad, 0x1d, 0x99, 0x3e, 0x8c, 0xdb, 0x42, 0xe3, 0x53, 0x99, 0xfd,
+	0x26, 0x13, 0x8e, 0xf5, 0xeb, 0x2a, 0xb3, 0xff, 0x01, 0x00, 0x6c, 0xd6, 0xff, 0x50, 0xa6, 0x01,
+	0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -118,6 +131,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReopenOnTimeout {
+		i--
+		if m.ReopenOnTimeout {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.ControllerEnabled {
 		i--
 		if m.ControllerEnabled {
@@ -151,6 +174,9 @@ func (m *Params) Size() (n int) {
 	if m.ControllerEnabled {
 		n += 2
 	}
+	if m.ReopenOnTimeout {
+		n += 2
+	}
 	return n
 }
 
@@ -209,6 +235,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ControllerEnabled = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReopenOnTimeout", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowController
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ReopenOnTimeout = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipController(dAtA[iNdEx:])
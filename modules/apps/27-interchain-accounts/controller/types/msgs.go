@@ -0,0 +1,194 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// msg types
+const (
+	TypeMsgRegisterInterchainAccount = "register_interchain_account"
+	TypeMsgSendTx                    = "send_tx"
+	TypeMsgReopenInterchainAccount   = "reopen_interchain_account"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterInterchainAccount{}
+	_ sdk.Msg = &MsgSendTx{}
+	_ sdk.Msg = &MsgReopenInterchainAccount{}
+)
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount instance
+//nolint:interfacer
+func NewMsgRegisterInterchainAccount(connectionID, owner, version string) *MsgRegisterInterchainAccount {
+	return &MsgRegisterInterchainAccount{
+		ConnectionId: connectionID,
+		Owner:        owner,
+		Version:      version,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgRegisterInterchainAccount) Route() string {
+	return icatypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgRegisterInterchainAccount) Type() string {
+	return TypeMsgRegisterInterchainAccount
+}
+
+// ValidateBasic performs a basic check of the MsgRegisterInterchainAccount fields.
+// NOTE: the version is validated during the channel handshake.
+func (msg MsgRegisterInterchainAccount) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateControllerPortID(msg.Owner)
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgRegisterInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgRegisterInterchainAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance
+//nolint:interfacer
+func NewMsgSendTx(owner, connectionID string, relativeTimeout uint64, packetData icatypes.InterchainAccountPacketData) *MsgSendTx {
+	return &MsgSendTx{
+		ConnectionId:    connectionID,
+		Owner:           owner,
+		RelativeTimeout: relativeTimeout,
+		PacketData:      packetData,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgSendTx) Route() string {
+	return icatypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgSendTx) Type() string {
+	return TypeMsgSendTx
+}
+
+// ValidateBasic performs a basic check of the MsgSendTx fields.
+func (msg MsgSendTx) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := validateControllerPortID(msg.Owner); err != nil {
+		return err
+	}
+
+	if err := msg.PacketData.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid interchain account packet data")
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return sdkerrors.Wrap(icatypes.ErrInvalidTimeoutTimestamp, "relative timeout cannot be zero")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgSendTx) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgSendTx) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// NewMsgReopenInterchainAccount creates a new MsgReopenInterchainAccount instance
+//nolint:interfacer
+func NewMsgReopenInterchainAccount(connectionID, owner string) *MsgReopenInterchainAccount {
+	return &MsgReopenInterchainAccount{
+		ConnectionId: connectionID,
+		Owner:        owner,
+	}
+}
+
+// Route implements sdk.Msg
+func (MsgReopenInterchainAccount) Route() string {
+	return icatypes.RouterKey
+}
+
+// Type implements sdk.Msg
+func (MsgReopenInterchainAccount) Type() string {
+	return TypeMsgReopenInterchainAccount
+}
+
+// ValidateBasic performs a basic check of the MsgReopenInterchainAccount fields.
+func (msg MsgReopenInterchainAccount) ValidateBasic() error {
+	if err := host.ConnectionIdentifierValidator(msg.ConnectionId); err != nil {
+		return sdkerrors.Wrap(err, "invalid connection ID")
+	}
+
+	// NOTE: owner format must be validated as it is required by the GetSigners function.
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return validateControllerPortID(msg.Owner)
+}
+
+// GetSignBytes implements sdk.Msg.
+func (msg MsgReopenInterchainAccount) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&msg))
+}
+
+// GetSigners implements sdk.Msg
+func (msg MsgReopenInterchainAccount) GetSigners() []sdk.AccAddress {
+	signer, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{signer}
+}
+
+// validateControllerPortID returns an error if the controller port identifier generated for the
+// provided owner is not a valid port identifier.
+func validateControllerPortID(owner string) error {
+	portID, err := icatypes.NewControllerPortID(owner)
+	if err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return sdkerrors.Wrapf(icatypes.ErrInvalidControllerPort, "owner %s generates an invalid controller port ID: %v", owner, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,114 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+const testOwnerAddress = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+func TestMsgRegisterInterchainAccountValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgRegisterInterchainAccount
+		expPass bool
+	}{
+		{"success", types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, testOwnerAddress, ""), true},
+		{"success with version", types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, testOwnerAddress, icatypes.Version), true},
+		{"invalid connection ID", types.NewMsgRegisterInterchainAccount("", testOwnerAddress, ""), false},
+		{"empty owner", types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, "", ""), false},
+		{"invalid owner address", types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, "invalid-address", ""), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterInterchainAccountGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(testOwnerAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgRegisterInterchainAccount(ibctesting.FirstConnectionID, testOwnerAddress, "")
+	require.Equal(t, []sdk.AccAddress{expSigner}, msg.GetSigners())
+}
+
+func TestMsgSendTxValidateBasic(t *testing.T) {
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: []byte("data"),
+	}
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgSendTx
+		expPass bool
+	}{
+		{"success", types.NewMsgSendTx(testOwnerAddress, ibctesting.FirstConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, packetData), true},
+		{"invalid connection ID", types.NewMsgSendTx(testOwnerAddress, "", icatypes.DefaultRelativePacketTimeoutTimestamp, packetData), false},
+		{"invalid owner address", types.NewMsgSendTx("invalid-address", ibctesting.FirstConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, packetData), false},
+		{"relative timeout is zero", types.NewMsgSendTx(testOwnerAddress, ibctesting.FirstConnectionID, 0, packetData), false},
+		{"packet data type unspecified", types.NewMsgSendTx(testOwnerAddress, ibctesting.FirstConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, icatypes.InterchainAccountPacketData{Data: []byte("data")}), false},
+		{"packet data is empty", types.NewMsgSendTx(testOwnerAddress, ibctesting.FirstConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX}), false},
+		{"packet data memo too long", types.NewMsgSendTx(testOwnerAddress, ibctesting.FirstConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: []byte("data"), Memo: strings.Repeat("a", icatypes.MaxMemoCharLength+1)}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSendTxGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(testOwnerAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgSendTx(testOwnerAddress, ibctesting.FirstConnectionID, icatypes.DefaultRelativePacketTimeoutTimestamp, icatypes.InterchainAccountPacketData{})
+	require.Equal(t, []sdk.AccAddress{expSigner}, msg.GetSigners())
+}
+
+func TestMsgReopenInterchainAccountValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgReopenInterchainAccount
+		expPass bool
+	}{
+		{"success", types.NewMsgReopenInterchainAccount(ibctesting.FirstConnectionID, testOwnerAddress), true},
+		{"invalid connection ID", types.NewMsgReopenInterchainAccount("", testOwnerAddress), false},
+		{"empty owner", types.NewMsgReopenInterchainAccount(ibctesting.FirstConnectionID, ""), false},
+		{"invalid owner address", types.NewMsgReopenInterchainAccount(ibctesting.FirstConnectionID, "invalid-address"), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgReopenInterchainAccountGetSigners(t *testing.T) {
+	expSigner, err := sdk.AccAddressFromBech32(testOwnerAddress)
+	require.NoError(t, err)
+
+	msg := types.NewMsgReopenInterchainAccount(ibctesting.FirstConnectionID, testOwnerAddress)
+	require.Equal(t, []sdk.AccAddress{expSigner}, msg.GetSigners())
+}
@@ -9,11 +9,15 @@ import (
 const (
 	// DefaultControllerEnabled is the default value for the controller param (set to true)
 	DefaultControllerEnabled = true
+	// DefaultReopenOnTimeout is the default value for the reopen on timeout param (set to false)
+	DefaultReopenOnTimeout = false
 )
 
 var (
 	// KeyControllerEnabled is the store key for ControllerEnabled Params
 	KeyControllerEnabled = []byte("ControllerEnabled")
+	// KeyReopenOnTimeout is the store key for ReopenOnTimeout Params
+	KeyReopenOnTimeout = []byte("ReopenOnTimeout")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -22,15 +26,16 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the controller submodule
-func NewParams(enableController bool) Params {
+func NewParams(enableController, reopenOnTimeout bool) Params {
 	return Params{
 		ControllerEnabled: enableController,
+		ReopenOnTimeout:   reopenOnTimeout,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the controller submodule
 func DefaultParams() Params {
-	return NewParams(DefaultControllerEnabled)
+	return NewParams(DefaultControllerEnabled, DefaultReopenOnTimeout)
 }
 
 // Validate validates all controller submodule parameters
@@ -39,6 +44,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateEnabled(p.ReopenOnTimeout); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -46,6 +55,7 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyControllerEnabled, p.ControllerEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyReopenOnTimeout, p.ReopenOnTimeout, validateEnabled),
 	}
 }
 
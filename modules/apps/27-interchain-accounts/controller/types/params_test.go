@@ -10,5 +10,5 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false).Validate())
+	require.NoError(t, types.NewParams(false, false).Validate())
 }
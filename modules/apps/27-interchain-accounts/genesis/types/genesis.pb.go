@@ -1,5 +1,5 @@
 // Code generated by protoc-gen-gogo. DO NOT EDIT.
-// source: ibc/applications/interchain_accounts/v1/genesis.proto
+// source: ibc/applications/interchain_accounts/genesis/v1/genesis.proto
 
 package types
 
@@ -35,7 +35,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{0}
+	return fileDescriptor_d4aa48c8e29a1947, []int{0}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,7 +90,7 @@ func (m *ControllerGenesisState) Reset()         { *m = ControllerGenesisState{}
 func (m *ControllerGenesisState) String() string { return proto.CompactTextString(m) }
 func (*ControllerGenesisState) ProtoMessage()    {}
 func (*ControllerGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{1}
+	return fileDescriptor_d4aa48c8e29a1947, []int{1}
 }
 func (m *ControllerGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -159,7 +159,7 @@ func (m *HostGenesisState) Reset()         { *m = HostGenesisState{} }
 func (m *HostGenesisState) String() string { return proto.CompactTextString(m) }
 func (*HostGenesisState) ProtoMessage()    {}
 func (*HostGenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{2}
+	return fileDescriptor_d4aa48c8e29a1947, []int{2}
 }
 func (m *HostGenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -216,18 +216,20 @@ func (m *HostGenesisState) GetParams() types1.Params {
 	return types1.Params{}
 }
 
-// ActiveChannel contains a connection ID, port ID and associated active channel ID
+// ActiveChannel contains a connection ID, port ID and associated active channel ID, as well as a boolean flag to
+// indicate if the channel is exclusively controlled by an authentication module through the controller middleware
 type ActiveChannel struct {
-	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
-	PortId       string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
-	ChannelId    string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	ConnectionId        string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	PortId              string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	ChannelId           string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	IsMiddlewareEnabled bool   `protobuf:"varint,4,opt,name=is_middleware_enabled,json=isMiddlewareEnabled,proto3" json:"is_middleware_enabled,omitempty" yaml:"is_middleware_enabled"`
 }
 
 func (m *ActiveChannel) Reset()         { *m = ActiveChannel{} }
 func (m *ActiveChannel) String() string { return proto.CompactTextString(m) }
 func (*ActiveChannel) ProtoMessage()    {}
 func (*ActiveChannel) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{3}
+	return fileDescriptor_d4aa48c8e29a1947, []int{3}
 }
 func (m *ActiveChannel) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -277,6 +279,13 @@ func (m *ActiveChannel) GetChannelId() string {
 	return ""
 }
 
+func (m *ActiveChannel) GetIsMiddlewareEnabled() bool {
+	if m != nil {
+		return m.IsMiddlewareEnabled
+	}
+	return false
+}
+
 // RegisteredInterchainAccount contains a connection ID, port ID and associated interchain account address
 type RegisteredInterchainAccount struct {
 	ConnectionId   string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
@@ -288,7 +297,7 @@ func (m *RegisteredInterchainAccount) Reset()         { *m = RegisteredInterchai
 func (m *RegisteredInterchainAccount) String() string { return proto.CompactTextString(m) }
 func (*RegisteredInterchainAccount) ProtoMessage()    {}
 func (*RegisteredInterchainAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_629b3ced0911516b, []int{4}
+	return fileDescriptor_d4aa48c8e29a1947, []int{4}
 }
 func (m *RegisteredInterchainAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -339,60 +348,63 @@ func (m *RegisteredInterchainAccount) GetAccountAddress() string {
 }
 
 func init() {
-	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.v1.GenesisState")
-	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.v1.ControllerGenesisState")
-	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.v1.HostGenesisState")
-	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.v1.ActiveChannel")
-	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.v1.RegisteredInterchainAccount")
+	proto.RegisterType((*GenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.GenesisState")
+	proto.RegisterType((*ControllerGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.ControllerGenesisState")
+	proto.RegisterType((*HostGenesisState)(nil), "ibc.applications.interchain_accounts.genesis.v1.HostGenesisState")
+	proto.RegisterType((*ActiveChannel)(nil), "ibc.applications.interchain_accounts.genesis.v1.ActiveChannel")
+	proto.RegisterType((*RegisteredInterchainAccount)(nil), "ibc.applications.interchain_accounts.genesis.v1.RegisteredInterchainAccount")
 }
 
 func init() {
-	proto.RegisterFile("ibc/applications/interchain_accounts/v1/genesis.proto", fileDescriptor_629b3ced0911516b)
-}
-
-var fileDescriptor_629b3ced0911516b = []byte{
-	// 645 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0x3f, 0x6f, 0x13, 0x3f,
-	0x18, 0xc7, 0xe3, 0xa4, 0xbf, 0xfe, 0x14, 0xf7, 0x0f, 0xc5, 0x94, 0xea, 0x08, 0xd2, 0x25, 0x78,
-	0x69, 0x24, 0xd4, 0x3b, 0xf5, 0x0f, 0x54, 0x54, 0x42, 0xa8, 0x17, 0x10, 0x64, 0x43, 0x66, 0x41,
-	0x2c, 0x27, 0xc7, 0x67, 0x25, 0x96, 0x92, 0x73, 0x74, 0x76, 0x23, 0x75, 0x62, 0x67, 0x62, 0x43,
-	0xac, 0x48, 0xbc, 0x00, 0xde, 0x01, 0x63, 0x27, 0xd4, 0x91, 0x29, 0x42, 0xed, 0x3b, 0xc8, 0x2b,
-	0x40, 0xf6, 0x9d, 0x92, 0xf4, 0x48, 0xab, 0x9b, 0x98, 0x98, 0x62, 0xdf, 0xe3, 0xef, 0xf7, 0xf9,
-	0x3c, 0x7e, 0x1c, 0x1b, 0x3e, 0x12, 0x1d, 0xe6, 0xd3, 0xe1, 0xb0, 0x2f, 0x18, 0xd5, 0x42, 0xc6,
-	0xca, 0x17, 0xb1, 0xe6, 0x09, 0xeb, 0x51, 0x11, 0x87, 0x94, 0x31, 0x79, 0x12, 0x6b, 0xe5, 0x8f,
-	0x76, 0xfd, 0x2e, 0x8f, 0xb9, 0x12, 0xca, 0x1b, 0x26, 0x52, 0x4b, 0xb4, 0x2d, 0x3a, 0xcc, 0x9b,
-	0x97, 0x79, 0x0b, 0x64, 0xde, 0x68, 0xb7, 0xb6, 0xd9, 0x95, 0x5d, 0x69, 0x35, 0xbe, 0x19, 0xa5,
-	0xf2, 0x5a, 0xab, 0x50, 0x56, 0x26, 0x63, 0x9d, 0xc8, 0x7e, 0x9f, 0x27, 0x06, 0x60, 0x36, 0xcb,
-	0x4c, 0x0e, 0x0b, 0x99, 0xf4, 0xa4, 0xd2, 0x46, 0x6e, 0x7e, 0x53, 0x21, 0xfe, 0x5e, 0x86, 0xab,
-	0x2f, 0xd3, 0x72, 0xde, 0x68, 0xaa, 0x39, 0xfa, 0x02, 0xa0, 0x33, 0xb3, 0x0f, 0xb3, 0x52, 0x43,
-	0x65, 0x82, 0x0e, 0x68, 0x80, 0xe6, 0xca, 0xde, 0x33, 0xaf, 0x60, 0xc5, 0x5e, 0x6b, 0x6a, 0x34,
-	0x9f, 0x23, 0xd8, 0x3e, 0x1b, 0xd7, 0x4b, 0x93, 0x71, 0xbd, 0x7e, 0x4a, 0x07, 0xfd, 0x23, 0x7c,
-	0x5d, 0x3a, 0x4c, 0xb6, 0xd8, 0x42, 0x03, 0xf4, 0x01, 0x40, 0x64, 0x8a, 0xc8, 0xe1, 0x95, 0x2d,
-	0xde, 0x93, 0xc2, 0x78, 0xaf, 0xa4, 0xd2, 0x57, 0xc0, 0x1e, 0x64, 0x60, 0xf7, 0x52, 0xb0, 0x3f,
-	0x53, 0x60, 0xb2, 0xd1, 0xcb, 0x89, 0xf0, 0xd7, 0x0a, 0xdc, 0x5a, 0x5c, 0x28, 0x7a, 0x0f, 0x6f,
-	0x51, 0xa6, 0xc5, 0x88, 0x87, 0xac, 0x47, 0xe3, 0x98, 0xf7, 0x95, 0x03, 0x1a, 0x95, 0xe6, 0xca,
-	0xde, 0xe3, 0xc2, 0x8c, 0xc7, 0x56, 0xdf, 0x4a, 0xe5, 0x81, 0x9b, 0x01, 0x6e, 0xa5, 0x80, 0x39,
-	0x73, 0x4c, 0xd6, 0xe9, 0xfc, 0x72, 0x85, 0x3e, 0x03, 0x78, 0x67, 0x81, 0xb1, 0x53, 0xb6, 0x14,
-	0xcf, 0x0b, 0x53, 0x10, 0xde, 0x15, 0x4a, 0xf3, 0x84, 0x47, 0xed, 0xe9, 0x82, 0xe3, 0x34, 0x1e,
-	0xe0, 0x8c, 0xa9, 0x96, 0x32, 0x2d, 0x70, 0xc0, 0x04, 0x89, 0xbc, 0x4c, 0xa1, 0x4d, 0xf8, 0xdf,
-	0x50, 0x26, 0x5a, 0x39, 0x95, 0x46, 0xa5, 0x59, 0x25, 0xe9, 0x04, 0xbd, 0x85, 0xcb, 0x43, 0x9a,
-	0xd0, 0x81, 0x72, 0x96, 0x6c, 0x37, 0x8f, 0x8a, 0x31, 0xce, 0xfd, 0x23, 0x46, 0xbb, 0xde, 0x6b,
-	0xeb, 0x10, 0x2c, 0x19, 0x32, 0x92, 0xf9, 0xe1, 0x4f, 0x15, 0xb8, 0x91, 0xef, 0xf8, 0xbf, 0x0e,
-	0xdd, 0xd4, 0x21, 0x04, 0x97, 0x4c, 0x53, 0x9c, 0x4a, 0x03, 0x34, 0xab, 0xc4, 0x8e, 0x11, 0xc9,
-	0xf5, 0xe7, 0xa0, 0x18, 0xa1, 0xbd, 0x72, 0xae, 0xeb, 0xcc, 0x37, 0x00, 0xd7, 0xae, 0xec, 0x22,
-	0x7a, 0x0a, 0xd7, 0x98, 0x8c, 0x63, 0xce, 0x8c, 0x63, 0x28, 0x22, 0x7b, 0xf3, 0x54, 0x03, 0x67,
-	0x32, 0xae, 0x6f, 0x4e, 0x2f, 0x8d, 0x59, 0x18, 0x93, 0xd5, 0xd9, 0xbc, 0x1d, 0xa1, 0x87, 0xf0,
-	0x7f, 0x03, 0x6b, 0x84, 0x65, 0x2b, 0x44, 0x93, 0x71, 0x7d, 0x3d, 0x15, 0x66, 0x01, 0x4c, 0x96,
-	0xcd, 0xa8, 0x1d, 0xa1, 0x03, 0x08, 0xb3, 0xf6, 0x98, 0xf5, 0xb6, 0xd6, 0xe0, 0xee, 0x64, 0x5c,
-	0xbf, 0x9d, 0x25, 0x9a, 0xc6, 0x30, 0xa9, 0x66, 0x93, 0x76, 0x84, 0x7f, 0x00, 0x78, 0xff, 0x86,
-	0x3d, 0xff, 0xab, 0x15, 0xb4, 0xcc, 0x21, 0xb6, 0x69, 0x43, 0x1a, 0x45, 0x09, 0x57, 0x2a, 0x2b,
-	0xa3, 0x36, 0x7f, 0x10, 0xaf, 0x2c, 0xb0, 0x07, 0xd1, 0x7e, 0x39, 0x4e, 0x3f, 0x04, 0xe1, 0xd9,
-	0x85, 0x0b, 0xce, 0x2f, 0x5c, 0xf0, 0xeb, 0xc2, 0x05, 0x1f, 0x2f, 0xdd, 0xd2, 0xf9, 0xa5, 0x5b,
-	0xfa, 0x79, 0xe9, 0x96, 0xde, 0xbd, 0xe8, 0x0a, 0xdd, 0x3b, 0xe9, 0x78, 0x4c, 0x0e, 0x7c, 0x26,
-	0xd5, 0x40, 0x2a, 0x5f, 0x74, 0xd8, 0x4e, 0x57, 0xfa, 0xa3, 0x7d, 0x7f, 0x20, 0xa3, 0x93, 0x3e,
-	0x57, 0xe6, 0xf1, 0x51, 0xfe, 0xde, 0xe1, 0xce, 0xac, 0xf9, 0x3b, 0xd3, 0x77, 0x47, 0x9f, 0x0e,
-	0xb9, 0xea, 0x2c, 0xdb, 0x17, 0x67, 0xff, 0x77, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x08, 0x8f,
-	0x1f, 0x67, 0x07, 0x00, 0x00,
+	proto.RegisterFile("ibc/applications/interchain_accounts/genesis/v1/genesis.proto", fileDescriptor_d4aa48c8e29a1947)
+}
+
+var fileDescriptor_d4aa48c8e29a1947 = []byte{
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x95, 0xcb, 0x6a, 0xdb, 0x4a,
+	0x18, 0xc7, 0x2d, 0x3b, 0x27, 0xe7, 0x78, 0x72, 0x39, 0xe9, 0xe4, 0x82, 0xea, 0x16, 0xc9, 0xd5,
+	0xa6, 0x86, 0x12, 0x89, 0x5c, 0x20, 0x10, 0x48, 0xc1, 0x32, 0x25, 0x35, 0x34, 0x50, 0xd4, 0x2e,
+	0x4a, 0x37, 0x62, 0x3c, 0x1a, 0xe4, 0x01, 0x59, 0x63, 0x34, 0x13, 0x97, 0x3c, 0x41, 0xb6, 0xa5,
+	0x6f, 0xd0, 0x5d, 0xe9, 0x03, 0xf4, 0x19, 0xb2, 0x2a, 0x59, 0x76, 0x65, 0x4a, 0xf2, 0x06, 0x7e,
+	0x82, 0x32, 0xa3, 0x89, 0xed, 0x38, 0x4e, 0xb1, 0x37, 0x5d, 0x75, 0xa5, 0xb9, 0x7c, 0xff, 0xff,
+	0xf7, 0x9b, 0xf9, 0x46, 0x33, 0xe0, 0x88, 0xb6, 0xb0, 0x87, 0xba, 0xdd, 0x84, 0x62, 0x24, 0x28,
+	0x4b, 0xb9, 0x47, 0x53, 0x41, 0x32, 0xdc, 0x46, 0x34, 0x0d, 0x11, 0xc6, 0xec, 0x34, 0x15, 0xdc,
+	0x8b, 0x49, 0x4a, 0x38, 0xe5, 0x5e, 0x6f, 0xe7, 0xa6, 0xe9, 0x76, 0x33, 0x26, 0x18, 0xf4, 0x68,
+	0x0b, 0xbb, 0xe3, 0x72, 0x77, 0x8a, 0xdc, 0xbd, 0xd1, 0xf4, 0x76, 0x2a, 0x1b, 0x31, 0x8b, 0x99,
+	0xd2, 0x7a, 0xb2, 0x95, 0xdb, 0x54, 0x1a, 0x33, 0x51, 0x60, 0x96, 0x8a, 0x8c, 0x25, 0x09, 0xc9,
+	0x24, 0xc8, 0xa8, 0xa7, 0x4d, 0x0e, 0x66, 0x32, 0x69, 0x33, 0x2e, 0xa4, 0x5c, 0x7e, 0x73, 0xa1,
+	0x73, 0x59, 0x04, 0xcb, 0xc7, 0x39, 0xe2, 0x1b, 0x81, 0x04, 0x81, 0x5f, 0x0d, 0x60, 0x8e, 0xec,
+	0x43, 0x8d, 0x1f, 0x72, 0x39, 0x69, 0x1a, 0x55, 0xa3, 0xb6, 0xb4, 0x7b, 0xec, 0xce, 0xb9, 0x72,
+	0xb7, 0x31, 0x34, 0x1c, 0xcf, 0xe5, 0x3f, 0xbd, 0xe8, 0xdb, 0x85, 0x41, 0xdf, 0xb6, 0xcf, 0x50,
+	0x27, 0x39, 0x74, 0xee, 0x4b, 0xeb, 0x04, 0x5b, 0x78, 0xaa, 0x01, 0xfc, 0x64, 0x00, 0x28, 0x17,
+	0x33, 0x81, 0x59, 0x54, 0x98, 0xf5, 0xb9, 0x31, 0x5f, 0x32, 0x2e, 0x6e, 0x01, 0x3e, 0xd1, 0x80,
+	0x0f, 0x73, 0xc0, 0xbb, 0xa9, 0x9c, 0x60, 0xad, 0x3d, 0x21, 0x72, 0xbe, 0x95, 0xc0, 0xd6, 0xf4,
+	0x05, 0xc3, 0x73, 0x03, 0xfc, 0x8f, 0xb0, 0xa0, 0x3d, 0x12, 0xe2, 0x36, 0x4a, 0x53, 0x92, 0x70,
+	0xd3, 0xa8, 0x96, 0x6a, 0x4b, 0xbb, 0xcf, 0xe7, 0x86, 0xad, 0x2b, 0x9f, 0x46, 0x6e, 0xe3, 0x5b,
+	0x9a, 0x74, 0x2b, 0x27, 0x9d, 0x48, 0xe2, 0x04, 0xab, 0x68, 0x3c, 0x9c, 0xc3, 0xcf, 0x06, 0x58,
+	0x9f, 0x92, 0xc0, 0x2c, 0x2a, 0x9a, 0x57, 0x73, 0xd3, 0x04, 0x24, 0xa6, 0x5c, 0x90, 0x8c, 0x44,
+	0xcd, 0x61, 0x60, 0x3d, 0x8f, 0xf3, 0x1d, 0xcd, 0x56, 0xc9, 0xd9, 0xa6, 0x38, 0x39, 0x01, 0xa4,
+	0x93, 0x32, 0x0e, 0x37, 0xc0, 0x3f, 0x5d, 0x96, 0x09, 0x6e, 0x96, 0xaa, 0xa5, 0x5a, 0x39, 0xc8,
+	0x3b, 0xf0, 0x1d, 0x58, 0xec, 0xa2, 0x0c, 0x75, 0xb8, 0xb9, 0xa0, 0xca, 0x7c, 0x38, 0x1b, 0xeb,
+	0xd8, 0x2f, 0xd3, 0xdb, 0x71, 0x5f, 0x2b, 0x07, 0x7f, 0x41, 0x92, 0x05, 0xda, 0xcf, 0xf9, 0x52,
+	0x02, 0x6b, 0x93, 0x47, 0xe0, 0x6f, 0xc9, 0xe6, 0x2a, 0x19, 0x04, 0x0b, 0xb2, 0x4a, 0x66, 0xa9,
+	0x6a, 0xd4, 0xca, 0x81, 0x6a, 0xc3, 0x60, 0xa2, 0x60, 0xfb, 0xb3, 0x91, 0xaa, 0x4b, 0xea, 0xbe,
+	0x52, 0x9d, 0x17, 0xc1, 0xca, 0xad, 0xdd, 0x84, 0x47, 0x60, 0x05, 0xb3, 0x34, 0x25, 0x58, 0x3a,
+	0x86, 0x34, 0x52, 0x77, 0x55, 0xd9, 0x37, 0x07, 0x7d, 0x7b, 0x63, 0x78, 0xbd, 0x8c, 0xa6, 0x9d,
+	0x60, 0x79, 0xd4, 0x6f, 0x46, 0xf0, 0x19, 0xf8, 0x57, 0xc2, 0x4a, 0x61, 0x51, 0x09, 0xe1, 0xa0,
+	0x6f, 0xaf, 0xe6, 0x42, 0x3d, 0xe1, 0x04, 0x8b, 0xb2, 0xd5, 0x8c, 0xe0, 0x3e, 0x00, 0xba, 0x4c,
+	0x32, 0x5e, 0xad, 0xd5, 0xdf, 0x1c, 0xf4, 0xed, 0x07, 0x3a, 0xd1, 0x70, 0xce, 0x09, 0xca, 0xba,
+	0xd3, 0x8c, 0xe0, 0x5b, 0xb0, 0x49, 0x79, 0xd8, 0xa1, 0x51, 0x94, 0x90, 0x0f, 0x28, 0x23, 0x21,
+	0x49, 0x51, 0x2b, 0x21, 0x91, 0xda, 0x96, 0xff, 0xfc, 0xea, 0xa0, 0x6f, 0x3f, 0xd6, 0xdb, 0x3d,
+	0x2d, 0xcc, 0x09, 0xd6, 0x29, 0x3f, 0x19, 0x0e, 0xbf, 0xd0, 0xa3, 0xdf, 0x0d, 0xf0, 0xe8, 0x37,
+	0x95, 0xfc, 0xa3, 0xfb, 0xd2, 0x90, 0xbf, 0x8a, 0x4a, 0x1b, 0xa2, 0x28, 0xca, 0x08, 0xe7, 0x7a,
+	0x73, 0x2a, 0xe3, 0xc7, 0xfc, 0x56, 0x80, 0x3a, 0xe6, 0x6a, 0xa4, 0x9e, 0x0f, 0xf8, 0xf1, 0xc5,
+	0x95, 0x65, 0x5c, 0x5e, 0x59, 0xc6, 0xcf, 0x2b, 0xcb, 0xf8, 0x78, 0x6d, 0x15, 0x2e, 0xaf, 0xad,
+	0xc2, 0x8f, 0x6b, 0xab, 0xf0, 0xfe, 0x24, 0xa6, 0xa2, 0x7d, 0xda, 0x72, 0x31, 0xeb, 0x78, 0x98,
+	0xf1, 0x0e, 0xe3, 0xf2, 0x09, 0xde, 0x8e, 0x99, 0xd7, 0xdb, 0xf3, 0x3a, 0x2c, 0x3a, 0x4d, 0x08,
+	0x97, 0x8f, 0x20, 0xf7, 0x76, 0x0f, 0xb6, 0x47, 0x47, 0x6a, 0xfb, 0xce, 0x53, 0x2e, 0xce, 0xba,
+	0x84, 0xb7, 0x16, 0xd5, 0x0b, 0xb8, 0xf7, 0x6b, 0x00, 0xb6, 0x6e, 0xa3, 0xbe, 0x07, 0x08, 0x00,
+	0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -596,6 +608,16 @@ func (m *ActiveChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.IsMiddlewareEnabled {
+		i--
+		if m.IsMiddlewareEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
 		copy(dAtA[i:], m.ChannelId)
@@ -762,6 +784,9 @@ func (m *ActiveChannel) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.IsMiddlewareEnabled {
+		n += 2
+	}
 	return n
 }
 
@@ -1399,6 +1424,26 @@ func (m *ActiveChannel) Unmarshal(dAtA []byte) error {
 			}
 			m.ChannelId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsMiddlewareEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsMiddlewareEnabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -16,6 +16,10 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdParams(),
 		GetCmdPacketEvents(),
+		GetCmdControllerPortID(),
+		GetCmdEffectiveAllowMessages(),
+		GetCmdInterchainAccounts(),
+		GetCmdActiveChannels(),
 	)
 
 	return queryCmd
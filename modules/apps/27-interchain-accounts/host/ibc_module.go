@@ -99,6 +99,55 @@ func (im IBCModule) OnChanCloseConfirm(
 	return im.keeper.OnChanCloseConfirm(ctx, portID, channelID)
 }
 
+// OnChanUpgradeInit implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) (string, error) {
+	return "", sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrade handshake must be initiated by controller chain")
+}
+
+// OnChanUpgradeTry implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	if !im.keeper.IsHostEnabled(ctx) {
+		return "", types.ErrHostSubModuleDisabled
+	}
+
+	return im.keeper.OnChanUpgradeTry(ctx, portID, channelID, order, connectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) error {
+	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "channel upgrade handshake must be initiated by controller chain")
+}
+
+// OnChanUpgradeOpen implements the IBCModule interface
+func (im IBCModule) OnChanUpgradeOpen(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	version string,
+) {
+}
+
 // OnRecvPacket implements the IBCModule interface
 func (im IBCModule) OnRecvPacket(
 	ctx sdk.Context,
@@ -106,19 +155,24 @@ func (im IBCModule) OnRecvPacket(
 	_ sdk.AccAddress,
 ) ibcexported.Acknowledgement {
 	if !im.keeper.IsHostEnabled(ctx) {
-		return types.NewErrorAcknowledgement(types.ErrHostSubModuleDisabled)
+		return im.newErrorAcknowledgement(ctx, packet, types.ErrHostSubModuleDisabled, 0)
 	}
 
+	gasBefore := ctx.GasMeter().GasConsumed()
 	txResponse, err := im.keeper.OnRecvPacket(ctx, packet)
+	gasUsed := ctx.GasMeter().GasConsumed() - gasBefore
+
 	if err != nil {
 		// Emit an event including the error msg
-		keeper.EmitWriteErrorAcknowledgementEvent(ctx, packet, err)
+		keeper.EmitWriteErrorAcknowledgementEvent(ctx, packet, err, gasUsed)
 
-		return types.NewErrorAcknowledgement(err)
+		return im.newErrorAcknowledgement(ctx, packet, err, gasUsed)
 	}
 
+	keeper.EmitWriteAcknowledgementEvent(ctx, packet, gasUsed)
+
 	// NOTE: acknowledgement will be written synchronously during IBC handler execution.
-	return channeltypes.NewResultAcknowledgement(txResponse)
+	return im.newResultAcknowledgement(ctx, packet, txResponse, gasUsed)
 }
 
 // OnAcknowledgementPacket implements the IBCModule interface
@@ -139,3 +193,25 @@ func (im IBCModule) OnTimeoutPacket(
 ) error {
 	return sdkerrors.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on a host channel end, a host chain does not send a packet over the channel")
 }
+
+// newErrorAcknowledgement returns an error acknowledgement in the format of the acknowledgement version
+// negotiated in the metadata of the channel the packet was received on
+func (im IBCModule) newErrorAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, err error, gasUsed uint64) channeltypes.Acknowledgement {
+	metadata, mErr := im.keeper.GetAppMetadata(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if mErr == nil && metadata.AckVersion == icatypes.AckVersion1 {
+		return types.NewStructuredErrorAcknowledgement(err, gasUsed)
+	}
+
+	return types.NewErrorAcknowledgement(err)
+}
+
+// newResultAcknowledgement returns a successful acknowledgement in the format of the acknowledgement version
+// negotiated in the metadata of the channel the packet was received on
+func (im IBCModule) newResultAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, result []byte, gasUsed uint64) channeltypes.Acknowledgement {
+	metadata, err := im.keeper.GetAppMetadata(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err == nil && metadata.AckVersion == icatypes.AckVersion1 {
+		return types.NewStructuredResultAcknowledgement(result, gasUsed)
+	}
+
+	return channeltypes.NewResultAcknowledgement(result)
+}
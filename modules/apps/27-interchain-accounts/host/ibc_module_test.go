@@ -3,10 +3,13 @@ package host_test
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/gogo/protobuf/proto"
 	"github.com/stretchr/testify/suite"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
@@ -14,6 +17,8 @@ import (
 	tmprotostate "github.com/tendermint/tendermint/proto/tendermint/state"
 	tmstate "github.com/tendermint/tendermint/state"
 
+	controllerkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/controller/types"
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
@@ -154,7 +159,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenTry() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, nil, 0, nil))
 			}, false,
 		},
 		{
@@ -276,7 +281,7 @@ func (suite *InterchainAccountsTestSuite) TestOnChanOpenConfirm() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, nil, 0, nil))
 			}, false,
 		},
 		{
@@ -411,7 +416,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 		},
 		{
 			"host submodule disabled", func() {
-				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}))
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(false, []string{}, nil, nil, 0, nil))
 			}, false,
 		},
 		{
@@ -428,6 +433,12 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 				packetData = []byte("invalid data")
 			}, false,
 		},
+		{
+			"ICA OnRecvPacket fails - packet runs out of gas", func() {
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil, nil, 1000, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			}, false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -480,7 +491,7 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 			expectedAck := channeltypes.NewResultAcknowledgement(expectedTxResponse)
 
-			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 			// malleate packetData for test cases
@@ -510,6 +521,206 @@ func (suite *InterchainAccountsTestSuite) TestOnRecvPacket() {
 
 }
 
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketStructuredErrorAcknowledgement() {
+	var ackVersion string
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expStructured bool
+	}{
+		{
+			"success", func() {
+				ackVersion = icatypes.AckVersion1
+			}, true,
+		},
+		{
+			"legacy error acknowledgement without acknowledgement version", func() {}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			ackVersion = ""
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// update the acknowledgement version of the host channel end
+			metadata, err := suite.chainB.GetSimApp().ICAHostKeeper.GetAppMetadata(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().NoError(err)
+
+			metadata.AckVersion = ackVersion
+			channel := path.EndpointB.GetChannel()
+			channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			path.EndpointB.SetChannel(channel)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			// the second message is not allowed by the host chain
+			msgs := []sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				},
+				&stakingtypes.MsgDelegate{
+					DelegatorAddress: interchainAccountAddr,
+					ValidatorAddress: suite.chainB.Vals.Validators[0].Address.String(),
+					Amount:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				},
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msgs[0])}, nil, nil, 0, nil)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, msgs)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
+			suite.Require().False(ack.Success())
+
+			channelAck, ok := ack.(channeltypes.Acknowledgement)
+			suite.Require().True(ok)
+
+			errorAck, err := icatypes.ParseErrorAcknowledgement(channelAck)
+			if tc.expStructured {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdkerrors.ErrUnauthorized.ABCICode(), errorAck.Code)
+				suite.Require().Equal(uint64(1), errorAck.MsgIndex)
+				suite.Require().Equal(sdk.MsgTypeURL(msgs[1]), errorAck.MsgTypeUrl)
+				suite.Require().NotZero(errorAck.GasUsed)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(types.NewErrorAcknowledgement(sdkerrors.ErrUnauthorized), channelAck)
+			}
+		})
+	}
+}
+
+func (suite *InterchainAccountsTestSuite) TestOnRecvPacketStructuredResultAcknowledgement() {
+	var ackVersion string
+
+	testCases := []struct {
+		name          string
+		malleate      func()
+		expStructured bool
+	}{
+		{
+			"success", func() {
+				ackVersion = icatypes.AckVersion1
+			}, true,
+		},
+		{
+			"legacy result acknowledgement without acknowledgement version", func() {}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			ackVersion = ""
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			// update the acknowledgement version of the host channel end
+			metadata, err := suite.chainB.GetSimApp().ICAHostKeeper.GetAppMetadata(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().NoError(err)
+
+			metadata.AckVersion = ackVersion
+			channel := path.EndpointB.GetChannel()
+			channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			path.EndpointB.SetChannel(channel)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			// fund the interchain account
+			amount := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+			_, err = suite.chainB.SendMsgs(&banktypes.MsgSend{FromAddress: suite.chainB.SenderAccount.GetAddress().String(), ToAddress: interchainAccountAddr, Amount: amount})
+			suite.Require().NoError(err)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      amount,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.Codec, []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(icaPacketData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
+
+			// build expected result
+			msgResponseBz, err := proto.Marshal(&banktypes.MsgSendResponse{})
+			suite.Require().NoError(err)
+
+			expectedTxResponse, err := proto.Marshal(&sdk.TxMsgData{
+				Data: []*sdk.MsgData{{MsgType: sdk.MsgTypeURL(msg), Data: msgResponseBz}},
+			})
+			suite.Require().NoError(err)
+
+			module, _, err := suite.chainB.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainB.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			ack := cbs.OnRecvPacket(suite.chainB.GetContext(), packet, nil)
+			suite.Require().True(ack.Success())
+
+			channelAck, ok := ack.(channeltypes.Acknowledgement)
+			suite.Require().True(ok)
+
+			resultAck, err := icatypes.ParseResultAcknowledgement(channelAck)
+			if tc.expStructured {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedTxResponse, resultAck.Result)
+				suite.Require().NotZero(resultAck.GasUsed)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(channeltypes.NewResultAcknowledgement(expectedTxResponse), channelAck)
+			}
+		})
+	}
+}
+
 func (suite *InterchainAccountsTestSuite) TestOnAcknowledgementPacket() {
 
 	testCases := []struct {
@@ -662,7 +873,7 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 		Data: data,
 	}
 
-	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+	params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 	suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 
 	chanCap, ok := suite.chainA.GetSimApp().ScopedICAMockKeeper.GetCapability(path.EndpointA.Chain.GetContext(), host.ChannelCapabilityPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
@@ -713,6 +924,118 @@ func (suite *InterchainAccountsTestSuite) TestControlAccountAfterChannelClose()
 	suite.Require().True(hasBalance)
 }
 
+// TestChannelOrdering tests the channel handshake and the execution of interchain account transactions for both channel
+// orderings negotiated in the metadata. A packet timeout closes an ORDERED channel while an UNORDERED channel remains open
+// and may be used to execute further transactions.
+func (suite *InterchainAccountsTestSuite) TestChannelOrdering() {
+	testCases := []struct {
+		name  string
+		order channeltypes.Order
+	}{
+		{"ORDERED channel", channeltypes.ORDERED},
+		{"UNORDERED channel", channeltypes.UNORDERED},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			suite.coordinator.SetupConnections(path)
+
+			metadata := icatypes.NewMetadata(icatypes.Version, path.EndpointA.ConnectionID, path.EndpointB.ConnectionID, "", icatypes.EncodingProtobuf, icatypes.TxTypeSDKMultiMsg)
+			metadata.Ordering = tc.order
+			version := string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+
+			// register the interchain account through the controller Msg service with the negotiated ordering
+			msgServer := controllerkeeper.NewMsgServerImpl(&suite.chainA.GetSimApp().ICAControllerKeeper)
+			res, err := msgServer.RegisterInterchainAccount(sdk.WrapSDKContext(suite.chainA.GetContext()), controllertypes.NewMsgRegisterInterchainAccount(path.EndpointA.ConnectionID, TestOwnerAddress, version))
+			suite.Require().NoError(err)
+
+			suite.chainA.App.Commit()
+			suite.chainA.NextBlock()
+
+			path.EndpointA.ChannelID = res.ChannelId
+			path.EndpointA.ChannelConfig.PortID = TestPortID
+			path.EndpointA.ChannelConfig.Version = version
+
+			suite.Require().NoError(path.EndpointB.ChanOpenTry())
+			suite.Require().NoError(path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(path.EndpointB.ChanOpenConfirm())
+
+			suite.Require().Equal(tc.order, path.EndpointA.GetChannel().Ordering)
+			suite.Require().Equal(tc.order, path.EndpointB.GetChannel().Ordering)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), TestPortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, TestPortID)
+			suite.Require().True(found)
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1000))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			// sendTx sends the packet data through the controller Msg service and returns the resulting packet
+			sendTx := func(relativeTimeout uint64) channeltypes.Packet {
+				timeoutTimestamp := uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + relativeTimeout
+
+				res, err := msgServer.SendTx(sdk.WrapSDKContext(suite.chainA.GetContext()), controllertypes.NewMsgSendTx(TestOwnerAddress, path.EndpointA.ConnectionID, relativeTimeout, icaPacketData))
+				suite.Require().NoError(err)
+
+				suite.chainA.App.Commit()
+				suite.chainA.NextBlock()
+
+				return channeltypes.NewPacket(icaPacketData.GetBytes(), res.Sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), timeoutTimestamp)
+			}
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			// execute a transaction on the host chain
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			suite.Require().NoError(path.RelayPacket(sendTx(icatypes.DefaultRelativePacketTimeoutTimestamp)))
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.HasBalance(suite.chainB.GetContext(), icaAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(9000))))
+
+			// time out a packet
+			relativeTimeout := uint64(time.Minute.Nanoseconds())
+			packet := sendTx(relativeTimeout)
+
+			suite.coordinator.IncrementTimeBy(time.Duration(relativeTimeout))
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			suite.Require().NoError(path.EndpointA.TimeoutPacket(packet))
+
+			if tc.order == channeltypes.ORDERED {
+				suite.Require().Equal(channeltypes.CLOSED, path.EndpointA.GetChannel().State)
+				return
+			}
+
+			suite.Require().Equal(channeltypes.OPEN, path.EndpointA.GetChannel().State)
+
+			// the UNORDERED channel may still be used to execute transactions
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			suite.Require().NoError(path.RelayPacket(sendTx(icatypes.DefaultRelativePacketTimeoutTimestamp)))
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.HasBalance(suite.chainB.GetContext(), icaAddr, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(8000))))
+		})
+	}
+}
+
 // The safety of including SDK MsgResponses in the acknowledgement rests
 // on the inclusion of the abcitypes.ResponseDeliverTx.Data in the
 // abcitypes.ResposneDeliverTx hash. If the abcitypes.ResponseDeliverTx.Data
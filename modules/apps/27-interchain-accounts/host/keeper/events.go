@@ -1,20 +1,36 @@
 package keeper
 
 import (
+	"strconv"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	
-	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
+// EmitWriteAcknowledgementEvent emits an event signalling a successful acknowledgement and including the gas consumed by the packet
+func EmitWriteAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, gasUsed uint64) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icatypes.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		),
+	)
+}
+
 // EmitWriteErrorAcknowledgementEvent emits an event signalling an error acknowledgement and including the error details
-func EmitWriteErrorAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, err error) {
+// and the gas consumed by the packet
+func EmitWriteErrorAcknowledgementEvent(ctx sdk.Context, packet exported.PacketI, err error, gasUsed uint64) {
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			icatypes.EventTypePacket,
 			sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
 			sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()),
 			sdk.NewAttribute(icatypes.AttributeKeyHostChannelID, packet.GetDestChannel()),
+			sdk.NewAttribute(icatypes.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
 		),
 	)
 }
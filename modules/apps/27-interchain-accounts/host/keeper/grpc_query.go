@@ -2,14 +2,148 @@ package keeper
 
 import (
 	"context"
+	"strings"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
 
+// ControllerPortID implements the Query/ControllerPortID gRPC method
+func (q Keeper) ControllerPortID(c context.Context, req *types.QueryControllerPortIDRequest) (*types.QueryControllerPortIDResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ConnectionIdentifierValidator(req.ConnectionId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the interchain account records the controller port ID as its owner
+	interchainAccount, ok := q.accountKeeper.GetAccount(ctx, accAddress).(*icatypes.InterchainAccount)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "interchain account %s not found", req.Address)
+	}
+
+	addr, found := q.GetInterchainAccountAddress(ctx, req.ConnectionId, interchainAccount.AccountOwner)
+	if !found || addr != req.Address {
+		return nil, status.Errorf(codes.NotFound, "interchain account %s not found on connection %s", req.Address, req.ConnectionId)
+	}
+
+	return &types.QueryControllerPortIDResponse{
+		PortId: interchainAccount.AccountOwner,
+	}, nil
+}
+
+// EffectiveAllowMessages implements the Query/EffectiveAllowMessages gRPC method
+func (q Keeper) EffectiveAllowMessages(c context.Context, req *types.QueryEffectiveAllowMessagesRequest) (*types.QueryEffectiveAllowMessagesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	accAddress, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	interchainAccount, ok := q.accountKeeper.GetAccount(ctx, accAddress).(*icatypes.InterchainAccount)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "interchain account %s not found", req.Address)
+	}
+
+	connectionID, found := q.GetInterchainAccountConnectionID(ctx, interchainAccount.AccountOwner, req.Address)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "interchain account %s is not registered on any connection", req.Address)
+	}
+
+	return &types.QueryEffectiveAllowMessagesResponse{
+		ConnectionId:  connectionID,
+		PortId:        interchainAccount.AccountOwner,
+		AllowMessages: q.GetEffectiveAllowMessages(ctx, connectionID, interchainAccount.AccountOwner),
+	}, nil
+}
+
+// InterchainAccounts implements the Query/InterchainAccounts gRPC method
+func (q Keeper) InterchainAccounts(c context.Context, req *types.QueryInterchainAccountsRequest) (*types.QueryInterchainAccountsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var accounts []types.RegisteredInterchainAccount
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.OwnerKeyPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		keySplit := strings.Split(string(key), "/")
+
+		accounts = append(accounts, types.RegisteredInterchainAccount{
+			ConnectionId:   keySplit[1],
+			PortId:         keySplit[0],
+			AccountAddress: string(value),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryInterchainAccountsResponse{
+		InterchainAccounts: accounts,
+		Pagination:         pageRes,
+	}, nil
+}
+
+// ActiveChannels implements the Query/ActiveChannels gRPC method
+func (q Keeper) ActiveChannels(c context.Context, req *types.QueryActiveChannelsRequest) (*types.QueryActiveChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var channels []types.ActiveChannel
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), []byte(icatypes.ActiveChannelKeyPrefix+"/"))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		keySplit := strings.Split(string(key), "/")
+
+		channels = append(channels, types.ActiveChannel{
+			ConnectionId: keySplit[1],
+			PortId:       keySplit[0],
+			ChannelId:    string(value),
+		})
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActiveChannelsResponse{
+		ActiveChannels: channels,
+		Pagination:     pageRes,
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
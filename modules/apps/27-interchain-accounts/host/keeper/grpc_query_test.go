@@ -4,8 +4,222 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
+func (suite *KeeperTestSuite) TestQueryControllerPortID() {
+	var req *types.QueryControllerPortIDRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid connection identifier",
+			func() {
+				req.ConnectionId = ""
+			},
+			false,
+		},
+		{
+			"invalid account address",
+			func() {
+				req.Address = "invalid"
+			},
+			false,
+		},
+		{
+			"account is not an interchain account",
+			func() {
+				req.Address = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+		{
+			"interchain account not registered on connection",
+			func() {
+				req.ConnectionId = "connection-100"
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			req = &types.QueryControllerPortIDRequest{
+				ConnectionId: ibctesting.FirstConnectionID,
+				Address:      TestAccAddress.String(),
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.ControllerPortID(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(TestPortID, res.PortId)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryEffectiveAllowMessages() {
+	var (
+		req          *types.QueryEffectiveAllowMessagesRequest
+		expAllowMsgs []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: global allowlist",
+			func() {},
+			true,
+		},
+		{
+			"success: connection override",
+			func() {
+				expAllowMsgs = []string{"/cosmos.staking.v1beta1.*"}
+
+				params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, []types.AllowMessagesOverride{
+					{ConnectionId: ibctesting.FirstConnectionID, AllowMessages: expAllowMsgs},
+				}, nil, 0, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"empty request",
+			func() {
+				req = nil
+			},
+			false,
+		},
+		{
+			"invalid account address",
+			func() {
+				req.Address = "invalid"
+			},
+			false,
+		},
+		{
+			"account is not an interchain account",
+			func() {
+				req.Address = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			expAllowMsgs = []string{"/cosmos.bank.v1beta1.MsgSend"}
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, expAllowMsgs, nil, nil, 0, nil))
+
+			req = &types.QueryEffectiveAllowMessagesRequest{
+				Address: TestAccAddress.String(),
+			}
+
+			tc.malleate()
+
+			ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+			res, err := suite.chainB.GetSimApp().ICAHostKeeper.EffectiveAllowMessages(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(ibctesting.FirstConnectionID, res.ConnectionId)
+				suite.Require().Equal(TestPortID, res.PortId)
+				suite.Require().Equal(expAllowMsgs, res.AllowMessages)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryInterchainAccounts() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.InterchainAccounts(ctx, &types.QueryInterchainAccountsRequest{})
+	suite.Require().NoError(err)
+
+	expAccounts := []types.RegisteredInterchainAccount{
+		{
+			ConnectionId:   ibctesting.FirstConnectionID,
+			PortId:         TestPortID,
+			AccountAddress: TestAccAddress.String(),
+		},
+	}
+	suite.Require().Equal(expAccounts, res.InterchainAccounts)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryActiveChannels() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	ctx := sdk.WrapSDKContext(suite.chainB.GetContext())
+	res, err := suite.chainB.GetSimApp().ICAHostKeeper.ActiveChannels(ctx, &types.QueryActiveChannelsRequest{})
+	suite.Require().NoError(err)
+
+	expChannels := []types.ActiveChannel{
+		{
+			ConnectionId: ibctesting.FirstConnectionID,
+			PortId:       TestPortID,
+			ChannelId:    path.EndpointB.ChannelID,
+		},
+	}
+	suite.Require().Equal(expChannels, res.ActiveChannels)
+	suite.Require().Equal(uint64(1), res.Pagination.Total)
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
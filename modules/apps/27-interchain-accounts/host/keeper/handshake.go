@@ -9,6 +9,7 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
@@ -27,10 +28,6 @@ func (k Keeper) OnChanOpenTry(
 	counterparty channeltypes.Counterparty,
 	counterpartyVersion string,
 ) (string, error) {
-	if order != channeltypes.ORDERED {
-		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.ORDERED, order)
-	}
-
 	if portID != icatypes.PortID {
 		return "", sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.PortID, portID)
 	}
@@ -48,6 +45,10 @@ func (k Keeper) OnChanOpenTry(
 		return "", err
 	}
 
+	if order != metadata.ChannelOrdering() {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", metadata.ChannelOrdering(), order)
+	}
+
 	activeChannelID, found := k.GetActiveChannelID(ctx, connectionHops[0], counterparty.PortId)
 	if found {
 		channel, found := k.channelKeeper.GetChannel(ctx, portID, activeChannelID)
@@ -104,6 +105,50 @@ func (k Keeper) OnChanOpenConfirm(
 	return nil
 }
 
+// OnChanUpgradeTry performs basic validation of a channel upgrade initialized by the controller chain.
+// The channel must remain on the same connection and the proposed metadata may not change the
+// interchain account address. The channel order must match the ordering negotiated in the metadata.
+func (k Keeper) OnChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order channeltypes.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+) (string, error) {
+	if portID != icatypes.PortID {
+		return "", sdkerrors.Wrapf(icatypes.ErrInvalidHostPort, "expected %s, got %s", icatypes.PortID, portID)
+	}
+
+	channel, found := k.channelKeeper.GetChannel(ctx, portID, channelID)
+	if !found {
+		return "", sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "failed to retrieve channel %s on port %s", channelID, portID)
+	}
+
+	if connectionHops[0] != channel.ConnectionHops[0] {
+		return "", sdkerrors.Wrapf(connectiontypes.ErrInvalidConnection, "interchain account channels cannot be upgraded to a different connection: expected %s, got %s", channel.ConnectionHops[0], connectionHops[0])
+	}
+
+	var metadata icatypes.Metadata
+	if err := icatypes.ModuleCdc.UnmarshalJSON([]byte(counterpartyVersion), &metadata); err != nil {
+		return "", sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain accounts metadata")
+	}
+
+	if err := icatypes.ValidateHostMetadata(ctx, k.channelKeeper, connectionHops, metadata); err != nil {
+		return "", err
+	}
+
+	if err := icatypes.ValidateUpgradeMetadata(channel.Version, metadata); err != nil {
+		return "", err
+	}
+
+	if order != metadata.ChannelOrdering() {
+		return "", sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", metadata.ChannelOrdering(), order)
+	}
+
+	return counterpartyVersion, nil
+}
+
 // OnChanCloseConfirm removes the active channel stored in state
 func (k Keeper) OnChanCloseConfirm(
 	ctx sdk.Context,
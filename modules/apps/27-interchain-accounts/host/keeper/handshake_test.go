@@ -60,6 +60,20 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 				path.EndpointA.ChannelConfig.Version = string(versionBytes)
 			}, false,
 		},
+		{
+			"success - UNORDERED channel negotiated in metadata",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+				channel.Ordering = channeltypes.UNORDERED
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
 		{
 			"invalid order - UNORDERED",
 			func() {
@@ -67,6 +81,30 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"invalid order - ORDERED channel with UNORDERED metadata",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
+		{
+			"unsupported channel ordering",
+			func() {
+				metadata.Ordering = channeltypes.Order(10)
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"invalid port ID",
 			func() {
@@ -96,6 +134,19 @@ func (suite *KeeperTestSuite) TestOnChanOpenTry() {
 			},
 			false,
 		},
+		{
+			"success - proto3 JSON encoding",
+			func() {
+				metadata.Encoding = icatypes.EncodingProto3JSON
+
+				versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+
+				path.EndpointA.ChannelConfig.Version = string(versionBytes)
+				path.EndpointB.SetChannel(*channel)
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -284,6 +335,98 @@ func (suite *KeeperTestSuite) TestOnChanOpenConfirm() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnChanUpgradeTry() {
+	var (
+		path           *ibctesting.Path
+		metadata       icatypes.Metadata
+		order          channeltypes.Order
+		connectionHops []string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: ORDERED to UNORDERED", func() {
+				metadata.Ordering = channeltypes.UNORDERED
+				order = channeltypes.UNORDERED
+			}, true,
+		},
+		{
+			"invalid port ID", func() {
+				path.EndpointB.ChannelConfig.PortID = "invalid-port-id"
+			}, false,
+		},
+		{
+			"channel not found", func() {
+				path.EndpointB.ChannelID = "channel-100"
+			}, false,
+		},
+		{
+			"connection cannot be changed", func() {
+				connectionHops = []string{"connection-100"}
+			}, false,
+		},
+		{
+			"unsupported transaction type", func() {
+				metadata.TxType = "invalid-tx-types"
+			}, false,
+		},
+		{
+			"interchain account address cannot be changed", func() {
+				metadata.Address = TestOwnerAddress
+			}, false,
+		},
+		{
+			"channel order does not match the metadata", func() {
+				order = channeltypes.UNORDERED
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			err = icatypes.ModuleCdc.UnmarshalJSON([]byte(path.EndpointB.GetChannel().Version), &metadata)
+			suite.Require().NoError(err)
+
+			metadata.Encoding = icatypes.EncodingProto3JSON
+			order = channeltypes.ORDERED
+			connectionHops = []string{path.EndpointB.ConnectionID}
+
+			tc.malleate() // malleate mutates test data
+
+			versionBytes, err := icatypes.ModuleCdc.MarshalJSON(&metadata)
+			suite.Require().NoError(err)
+
+			version, err := suite.chainB.GetSimApp().ICAHostKeeper.OnChanUpgradeTry(suite.chainB.GetContext(),
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, order, connectionHops, string(versionBytes),
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(string(versionBytes), version)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestOnChanCloseConfirm() {
 	var (
 		path *ibctesting.Path
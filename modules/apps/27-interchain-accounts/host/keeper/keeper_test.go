@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/suite"
 	"github.com/tendermint/tendermint/crypto"
 
+	genesistypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/genesis/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
@@ -148,6 +149,28 @@ func (suite *KeeperTestSuite) TestGetInterchainAccountAddress() {
 	suite.Require().Empty(retrievedAddr)
 }
 
+func (suite *KeeperTestSuite) TestGetInterchainAccountConnectionID() {
+	suite.SetupTest()
+
+	path := NewICAPath(suite.chainA, suite.chainB)
+	suite.coordinator.SetupConnections(path)
+
+	err := SetupICAPath(path, TestOwnerAddress)
+	suite.Require().NoError(err)
+
+	connectionID, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountConnectionID(suite.chainB.GetContext(), TestPortID, TestAccAddress.String())
+	suite.Require().True(found)
+	suite.Require().Equal(ibctesting.FirstConnectionID, connectionID)
+
+	connectionID, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountConnectionID(suite.chainB.GetContext(), "invalid port", TestAccAddress.String())
+	suite.Require().False(found)
+	suite.Require().Empty(connectionID)
+
+	connectionID, found = suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountConnectionID(suite.chainB.GetContext(), TestPortID, suite.chainB.SenderAccount.GetAddress().String())
+	suite.Require().False(found)
+	suite.Require().Empty(connectionID)
+}
+
 func (suite *KeeperTestSuite) TestGetAllActiveChannels() {
 	var (
 		expectedChannelID string = "test-channel"
@@ -164,7 +187,7 @@ func (suite *KeeperTestSuite) TestGetAllActiveChannels() {
 
 	suite.chainB.GetSimApp().ICAHostKeeper.SetActiveChannelID(suite.chainB.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedChannelID)
 
-	expectedChannels := []icatypes.ActiveChannel{
+	expectedChannels := []genesistypes.ActiveChannel{
 		{
 			ConnectionId: ibctesting.FirstConnectionID,
 			PortId:       path.EndpointA.ChannelConfig.PortID,
@@ -198,7 +221,7 @@ func (suite *KeeperTestSuite) TestGetAllInterchainAccounts() {
 
 	suite.chainB.GetSimApp().ICAHostKeeper.SetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, expectedPortID, expectedAccAddr)
 
-	expectedAccounts := []icatypes.RegisteredInterchainAccount{
+	expectedAccounts := []genesistypes.RegisteredInterchainAccount{
 		{
 			ConnectionId:   ibctesting.FirstConnectionID,
 			PortId:         TestPortID,
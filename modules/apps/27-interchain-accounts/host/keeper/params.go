@@ -21,9 +21,53 @@ func (k Keeper) GetAllowMessages(ctx sdk.Context) []string {
 	return res
 }
 
+// GetAllowMessagesOverrides retrieves the host allowlist overrides from the paramstore
+func (k Keeper) GetAllowMessagesOverrides(ctx sdk.Context) []types.AllowMessagesOverride {
+	var res []types.AllowMessagesOverride
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowMessagesOverrides, &res)
+	return res
+}
+
+// GetAllowQueries retrieves the host allowed query paths from the paramstore
+func (k Keeper) GetAllowQueries(ctx sdk.Context) []string {
+	var res []string
+	k.paramSpace.GetIfExists(ctx, types.KeyAllowQueries, &res)
+	return res
+}
+
+// GetMaxGasPerPacket retrieves the host max gas per packet from the paramstore.
+// Zero, which does not limit the gas consumed by a packet, is returned if the param has not been set.
+func (k Keeper) GetMaxGasPerPacket(ctx sdk.Context) uint64 {
+	var res uint64
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxGasPerPacket, &res)
+	return res
+}
+
+// GetMaxGasPerPacketOverrides retrieves the host max gas per packet overrides from the paramstore
+func (k Keeper) GetMaxGasPerPacketOverrides(ctx sdk.Context) []types.MaxGasPerPacketOverride {
+	var res []types.MaxGasPerPacketOverride
+	k.paramSpace.GetIfExists(ctx, types.KeyMaxGasPerPacketOverrides, &res)
+	return res
+}
+
+// GetEffectiveAllowMessages returns the msg types allowed to be executed by the interchain accounts of the
+// provided connection and controller port
+func (k Keeper) GetEffectiveAllowMessages(ctx sdk.Context, connectionID, portID string) []string {
+	return k.GetParams(ctx).EffectiveAllowMessages(connectionID, portID)
+}
+
+// GetEffectiveMaxGasPerPacket returns the maximum amount of gas which may be consumed by a packet received on
+// the provided connection
+func (k Keeper) GetEffectiveMaxGasPerPacket(ctx sdk.Context, connectionID string) uint64 {
+	return k.GetParams(ctx).EffectiveMaxGasPerPacket(connectionID)
+}
+
 // GetParams returns the total set of the host submodule parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.IsHostEnabled(ctx), k.GetAllowMessages(ctx))
+	return types.NewParams(
+		k.IsHostEnabled(ctx), k.GetAllowMessages(ctx), k.GetAllowMessagesOverrides(ctx), k.GetAllowQueries(ctx),
+		k.GetMaxGasPerPacket(ctx), k.GetMaxGasPerPacketOverrides(ctx),
+	)
 }
 
 // SetParams sets the total set of the host submodule parameters.
@@ -1,6 +1,11 @@
 package keeper_test
 
-import "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
 
 func (suite *KeeperTestSuite) TestParams() {
 	expParams := types.DefaultParams()
@@ -10,7 +15,21 @@ func (suite *KeeperTestSuite) TestParams() {
 
 	expParams.HostEnabled = false
 	expParams.AllowMessages = []string{"/cosmos.staking.v1beta1.MsgDelegate"}
+	expParams.MaxGasPerPacket = 500000
+	expParams.MaxGasPerPacketOverrides = []types.MaxGasPerPacketOverride{{ConnectionId: "connection-0", MaxGas: 1000000}}
 	suite.chainA.GetSimApp().ICAHostKeeper.SetParams(suite.chainA.GetContext(), expParams)
 	params = suite.chainA.GetSimApp().ICAHostKeeper.GetParams(suite.chainA.GetContext())
 	suite.Require().Equal(expParams, params)
 }
+
+func (suite *KeeperTestSuite) TestGetMaxGasPerPacketNotSet() {
+	ctx := suite.chainA.GetContext()
+
+	// remove the param from the paramstore, as on a chain which has not set it
+	store := prefix.NewStore(ctx.KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), []byte(types.SubModuleName+"/"))
+	store.Delete(types.KeyMaxGasPerPacket)
+
+	// the gas consumed by packets is not limited
+	maxGas := suite.chainA.GetSimApp().ICAHostKeeper.GetMaxGasPerPacket(ctx)
+	suite.Require().Equal(uint64(0), maxGas)
+}
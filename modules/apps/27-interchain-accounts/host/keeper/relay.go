@@ -4,6 +4,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/gogo/protobuf/proto"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -12,6 +13,10 @@ import (
 
 // OnRecvPacket handles a given interchain accounts packet on a destination host chain.
 // If the transaction is successfully executed, the transaction response bytes will be returned.
+// If the queries are successfully executed, the query response bytes will be returned.
+// If a maximum amount of gas per packet is set for the connection of the channel, the packet is executed
+// within a gas meter limited to this amount and running out of gas returns an error.
+// The transaction messages are decoded according to the encoding negotiated in the channel metadata.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byte, error) {
 	var data icatypes.InterchainAccountPacketData
 
@@ -20,9 +25,55 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 		return nil, sdkerrors.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 interchain account packet data")
 	}
 
+	channel, found := k.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	metadata, err := k.GetAppMetadata(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	if maxGas := k.GetEffectiveMaxGasPerPacket(ctx, channel.ConnectionHops[0]); maxGas != 0 {
+		return k.executePacketWithGasLimit(ctx, packet, data, metadata.Encoding, maxGas)
+	}
+
+	return k.executePacket(ctx, packet, data, metadata.Encoding)
+}
+
+// executePacketWithGasLimit executes the packet data within a gas meter limited to the provided amount of gas.
+// The gas consumed by the packet is charged to the gas meter of the provided context. Running out of gas returns
+// an error rather than aborting the transaction relaying the packet, any state changes of the packet are reverted.
+func (k Keeper) executePacketWithGasLimit(ctx sdk.Context, packet channeltypes.Packet, data icatypes.InterchainAccountPacketData, encoding string, gasLimit uint64) (res []byte, err error) {
+	gasMeter := sdk.NewGasMeter(gasLimit)
+
+	defer func() {
+		r := recover()
+
+		ctx.GasMeter().ConsumeGas(gasMeter.GasConsumedToLimit(), "interchain account packet")
+
+		if r == nil {
+			return
+		}
+
+		outOfGas, ok := r.(sdk.ErrorOutOfGas)
+		if !ok {
+			panic(r)
+		}
+
+		res, err = nil, sdkerrors.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %s; gas limit: %d", outOfGas.Descriptor, gasLimit)
+	}()
+
+	return k.executePacket(ctx.WithGasMeter(gasMeter), packet, data, encoding)
+}
+
+// executePacket executes the transaction or the queries of the provided packet data. The transaction
+// messages are decoded using the provided encoding
+func (k Keeper) executePacket(ctx sdk.Context, packet channeltypes.Packet, data icatypes.InterchainAccountPacketData, encoding string) ([]byte, error) {
 	switch data.Type {
 	case icatypes.EXECUTE_TX:
-		msgs, err := icatypes.DeserializeCosmosTx(k.cdc, data.Data)
+		msgs, err := k.deserializeCosmosTx(data.Data, encoding)
 		if err != nil {
 			return nil, err
 		}
@@ -33,11 +84,35 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet) ([]byt
 		}
 
 		return txResponse, nil
+	case icatypes.QUERY:
+		reqs, err := icatypes.DeserializeCosmosQuery(data.Data)
+		if err != nil {
+			return nil, err
+		}
+
+		queryResponse, err := k.executeQuery(ctx, packet.SourcePort, packet.DestinationPort, packet.DestinationChannel, reqs)
+		if err != nil {
+			return nil, err
+		}
+
+		return queryResponse, nil
 	default:
 		return nil, icatypes.ErrUnknownDataType
 	}
 }
 
+// deserializeCosmosTx decodes the transaction messages of a packet according to the provided encoding
+func (k Keeper) deserializeCosmosTx(data []byte, encoding string) ([]sdk.Msg, error) {
+	switch encoding {
+	case icatypes.EncodingProtobuf:
+		return icatypes.DeserializeCosmosTx(k.cdc, data)
+	case icatypes.EncodingProto3JSON:
+		return icatypes.DeserializeCosmosTxJSON(k.cdc, data)
+	default:
+		return nil, sdkerrors.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", encoding)
+	}
+}
+
 // executeTx attempts to execute the provided transaction. It begins by authenticating the transaction signer.
 // If authentication succeeds, it does basic validation of the messages before attempting to deliver each message
 // into state. The state changes will only be committed if all messages in the transaction succeed. Thus the
@@ -61,12 +136,12 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	cacheCtx, writeCache := ctx.CacheContext()
 	for i, msg := range msgs {
 		if err := msg.ValidateBasic(); err != nil {
-			return nil, err
+			return nil, types.WrapMsgError(err, i, msg)
 		}
 
 		msgResponse, err := k.executeMsg(cacheCtx, msg)
 		if err != nil {
-			return nil, err
+			return nil, types.WrapMsgError(err, i, msg)
 		}
 
 		txMsgData.Data[i] = &sdk.MsgData{
@@ -88,6 +163,61 @@ func (k Keeper) executeTx(ctx sdk.Context, sourcePort, destPort, destChannel str
 	return txResponse, nil
 }
 
+// executeQuery attempts to execute the provided queries through the gRPC query router. Each query path must be
+// present in the allowed queries of the host submodule and queries may only be executed against the current state,
+// without proofs. The queries are executed on a branched context which is discarded, any state written by a query
+// handler is therefore reverted. The responses are returned in the order of the requests.
+func (k Keeper) executeQuery(ctx sdk.Context, sourcePort, destPort, destChannel string, reqs []abci.RequestQuery) ([]byte, error) {
+	channel, found := k.channelKeeper.GetChannel(ctx, destPort, destChannel)
+	if !found {
+		return nil, channeltypes.ErrChannelNotFound
+	}
+
+	if _, found := k.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], sourcePort); !found {
+		return nil, sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", sourcePort)
+	}
+
+	if len(reqs) == 0 {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "query packet must contain at least one query request")
+	}
+
+	allowQueries := k.GetAllowQueries(ctx)
+	cacheCtx, _ := ctx.CacheContext()
+
+	responses := make([]abci.ResponseQuery, len(reqs))
+	for i, req := range reqs {
+		if !types.ContainsQueryPath(allowQueries, req.Path) {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "query path not allowed: %s", req.Path)
+		}
+
+		if req.Height != 0 || req.Prove {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "query height and proofs are not supported: %s", req.Path)
+		}
+
+		route := k.queryRouter.Route(req.Path)
+		if route == nil {
+			return nil, sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "no route found for query path: %s", req.Path)
+		}
+
+		res, err := route(cacheCtx, req)
+		if err != nil {
+			return nil, err
+		}
+
+		responses[i] = abci.ResponseQuery{
+			Value:  res.Value,
+			Height: ctx.BlockHeight(),
+		}
+	}
+
+	queryResponse, err := icatypes.SerializeCosmosQueryResponse(responses)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "failed to marshal query response")
+	}
+
+	return queryResponse, nil
+}
+
 // authenticateTx ensures the provided msgs contain the correct interchain account signer address retrieved
 // from state using the provided controller port identifier
 func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, portID string) error {
@@ -96,15 +226,15 @@ func (k Keeper) authenticateTx(ctx sdk.Context, msgs []sdk.Msg, connectionID, po
 		return sdkerrors.Wrapf(icatypes.ErrInterchainAccountNotFound, "failed to retrieve interchain account on port %s", portID)
 	}
 
-	allowMsgs := k.GetAllowMessages(ctx)
-	for _, msg := range msgs {
+	allowMsgs := k.GetEffectiveAllowMessages(ctx, connectionID, portID)
+	for i, msg := range msgs {
 		if !types.ContainsMsgType(allowMsgs, msg) {
-			return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+			return types.WrapMsgError(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg)), i, msg)
 		}
 
 		for _, signer := range msg.GetSigners() {
 			if interchainAccountAddr != signer.String() {
-				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String())
+				return types.WrapMsgError(sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", interchainAccountAddr, signer.String()), i, msg)
 			}
 		}
 	}
@@ -5,10 +5,12 @@ import (
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	disttypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
 	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
@@ -51,7 +53,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -79,7 +81,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -113,7 +115,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msgDelegate), sdk.MsgTypeURL(msgUndelegate)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -148,7 +150,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -187,7 +189,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -213,7 +215,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -239,7 +241,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -278,7 +280,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			true,
@@ -336,6 +338,67 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			},
 			false,
 		},
+		{
+			"interchain account successfully executes banktypes.MsgSend allowed by a wildcard connection override",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				overrides := []types.AllowMessagesOverride{
+					{ConnectionId: ibctesting.FirstConnectionID, AllowMessages: []string{"/cosmos.bank.v1beta1.*"}},
+				}
+				params := types.NewParams(true, nil, overrides, nil, 0, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			true,
+		},
+		{
+			"unauthorised: message type not allowed by controller port override",
+			func() {
+				interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+				suite.Require().True(found)
+
+				msg := &banktypes.MsgSend{
+					FromAddress: interchainAccountAddr,
+					ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+					Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+				}
+
+				data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+				suite.Require().NoError(err)
+
+				icaPacketData := icatypes.InterchainAccountPacketData{
+					Type: icatypes.EXECUTE_TX,
+					Data: data,
+				}
+
+				packetData = icaPacketData.GetBytes()
+
+				overrides := []types.AllowMessagesOverride{
+					{ConnectionId: ibctesting.FirstConnectionID, AllowMessages: []string{sdk.MsgTypeURL(msg)}},
+					{ConnectionId: ibctesting.FirstConnectionID, PortId: path.EndpointA.ChannelConfig.PortID, AllowMessages: []string{"/cosmos.staking.v1beta1.*"}},
+				}
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, overrides, nil, 0, nil)
+				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			false,
+		},
 		{
 			"unauthorised: message type not allowed", // NOTE: do not update params to explicitly force the error
 			func() {
@@ -376,7 +439,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 
 				packetData = icaPacketData.GetBytes()
 
-				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)})
+				params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
 				suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
 			},
 			false,
@@ -437,6 +500,358 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestOnRecvPacketGasLimit() {
+	var params types.Params
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: packet executed within the max gas per packet",
+			func() {
+				params.MaxGasPerPacket = 1000000
+			},
+			true,
+		},
+		{
+			"success: connection override without gas limit",
+			func() {
+				params.MaxGasPerPacket = 1000
+				params.MaxGasPerPacketOverrides = []types.MaxGasPerPacketOverride{{ConnectionId: ibctesting.FirstConnectionID, MaxGas: 0}}
+			},
+			true,
+		},
+		{
+			"packet runs out of gas",
+			func() {
+				params.MaxGasPerPacket = 1000
+			},
+			false,
+		},
+		{
+			"packet runs out of gas with connection override",
+			func() {
+				params.MaxGasPerPacket = 1000000
+				params.MaxGasPerPacketOverrides = []types.MaxGasPerPacketOverride{{ConnectionId: ibctesting.FirstConnectionID, MaxGas: 1000}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			data, err := icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params = types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
+
+			tc.malleate() // malleate mutates test data
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, recvErr := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			icaAddr, err := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(err)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(recvErr)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(sdk.NewInt(9900), balance.Amount)
+			} else {
+				suite.Require().ErrorIs(recvErr, sdkerrors.ErrOutOfGas)
+				suite.Require().Nil(txResponse)
+
+				// the state changes of the packet are reverted and the gas consumed up to the limit is charged
+				suite.Require().Equal(sdk.NewInt(10000), balance.Amount)
+				suite.Require().GreaterOrEqual(ctx.GasMeter().GasConsumed(), uint64(1000))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketEncoding() {
+	testCases := []struct {
+		msg             string
+		channelEncoding string
+		dataEncoding    string
+		expPass         bool
+	}{
+		{"success: protobuf encoding", icatypes.EncodingProtobuf, icatypes.EncodingProtobuf, true},
+		{"success: proto3 JSON encoding", icatypes.EncodingProto3JSON, icatypes.EncodingProto3JSON, true},
+		{"proto3 JSON encoded data on protobuf channel", icatypes.EncodingProtobuf, icatypes.EncodingProto3JSON, false},
+		{"protobuf encoded data on proto3 JSON channel", icatypes.EncodingProto3JSON, icatypes.EncodingProtobuf, false},
+		{"unsupported channel encoding", "invalid-encoding-format", icatypes.EncodingProtobuf, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path := NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			// set the negotiated encoding in the channel metadata of the host chain
+			channel := path.EndpointB.GetChannel()
+
+			var metadata icatypes.Metadata
+			suite.Require().NoError(icatypes.ModuleCdc.UnmarshalJSON([]byte(channel.Version), &metadata))
+
+			metadata.Encoding = tc.channelEncoding
+			channel.Version = string(icatypes.ModuleCdc.MustMarshalJSON(&metadata))
+			path.EndpointB.SetChannel(channel)
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			msg := &banktypes.MsgSend{
+				FromAddress: interchainAccountAddr,
+				ToAddress:   suite.chainB.SenderAccount.GetAddress().String(),
+				Amount:      sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))),
+			}
+
+			var data []byte
+			if tc.dataEncoding == icatypes.EncodingProto3JSON {
+				data, err = icatypes.SerializeCosmosTxJSON(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			} else {
+				data, err = icatypes.SerializeCosmosTx(suite.chainA.GetSimApp().AppCodec(), []sdk.Msg{msg})
+			}
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.EXECUTE_TX,
+				Data: data,
+			}
+
+			params := types.NewParams(true, []string{sdk.MsgTypeURL(msg)}, nil, nil, 0, nil)
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			ctx := suite.chainB.GetContext()
+			txResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(ctx, packet)
+
+			icaAddr, addrErr := sdk.AccAddressFromBech32(interchainAccountAddr)
+			suite.Require().NoError(addrErr)
+
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, icaAddr, sdk.DefaultBondDenom)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(txResponse)
+				suite.Require().Equal(sdk.NewInt(9900), balance.Amount)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(txResponse)
+				suite.Require().Equal(sdk.NewInt(10000), balance.Amount)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnRecvQueryPacket() {
+	var (
+		path     *ibctesting.Path
+		requests []abci.RequestQuery
+		params   types.Params
+	)
+
+	balancePath := "/cosmos.bank.v1beta1.Query/Balance"
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"interchain account successfully queries a balance",
+			func() {},
+			true,
+		},
+		{
+			"interchain account successfully queries multiple balances allowed by a wildcard",
+			func() {
+				params.AllowQueries = []string{"/cosmos.bank.v1beta1.Query/*"}
+				requests = append(requests, requests[0])
+			},
+			true,
+		},
+		{
+			"unauthorised: query path not allowed",
+			func() {
+				params.AllowQueries = []string{"/cosmos.staking.v1beta1.Query/*"}
+			},
+			false,
+		},
+		{
+			"query height is not supported",
+			func() {
+				requests[0].Height = 1
+			},
+			false,
+		},
+		{
+			"query proofs are not supported",
+			func() {
+				requests[0].Prove = true
+			},
+			false,
+		},
+		{
+			"no route found for query path",
+			func() {
+				params.AllowQueries = []string{"*"}
+				requests[0].Path = "/cosmos.bank.v1beta1.Query/DoesNotExist"
+			},
+			false,
+		},
+		{
+			"query handler fails",
+			func() {
+				requests[0].Data = []byte("invalid request")
+			},
+			false,
+		},
+		{
+			"empty query requests",
+			func() {
+				requests = nil
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewICAPath(suite.chainA, suite.chainB)
+			suite.coordinator.SetupConnections(path)
+
+			err := SetupICAPath(path, TestOwnerAddress)
+			suite.Require().NoError(err)
+
+			suite.fundICAWallet(suite.chainB.GetContext(), path.EndpointA.ChannelConfig.PortID, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000))))
+
+			interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(suite.chainB.GetContext(), ibctesting.FirstConnectionID, path.EndpointA.ChannelConfig.PortID)
+			suite.Require().True(found)
+
+			balanceReq := &banktypes.QueryBalanceRequest{
+				Address: interchainAccountAddr,
+				Denom:   sdk.DefaultBondDenom,
+			}
+
+			requests = []abci.RequestQuery{
+				{Path: balancePath, Data: suite.chainB.GetSimApp().AppCodec().MustMarshal(balanceReq)},
+			}
+			params = types.NewParams(true, nil, nil, []string{balancePath}, 0, nil)
+
+			tc.malleate() // malleate mutates test data
+
+			suite.chainB.GetSimApp().ICAHostKeeper.SetParams(suite.chainB.GetContext(), params)
+
+			data, err := icatypes.SerializeCosmosQuery(requests)
+			suite.Require().NoError(err)
+
+			icaPacketData := icatypes.InterchainAccountPacketData{
+				Type: icatypes.QUERY,
+				Data: data,
+			}
+
+			packet := channeltypes.NewPacket(
+				icaPacketData.GetBytes(),
+				suite.chainA.SenderAccount.GetSequence(),
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID,
+				path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100),
+				0,
+			)
+
+			queryResponse, err := suite.chainB.GetSimApp().ICAHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				responses, err := icatypes.DeserializeCosmosQueryResponse(queryResponse)
+				suite.Require().NoError(err)
+				suite.Require().Len(responses, len(requests))
+
+				for _, res := range responses {
+					var balanceRes banktypes.QueryBalanceResponse
+					suite.Require().NoError(suite.chainB.GetSimApp().AppCodec().Unmarshal(res.Value, &balanceRes))
+					suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10000)), *balanceRes.Balance)
+					suite.Require().Equal(suite.chainB.GetContext().BlockHeight(), res.Height)
+				}
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(queryResponse)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) fundICAWallet(ctx sdk.Context, portID string, amount sdk.Coins) {
 	interchainAccountAddr, found := suite.chainB.GetSimApp().ICAHostKeeper.GetInterchainAccountAddress(ctx, ibctesting.FirstConnectionID, portID)
 	suite.Require().True(found)
@@ -1,10 +1,13 @@
 package types
 
 import (
+	"errors"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
@@ -14,6 +17,39 @@ const (
 	ackErrorString = "error handling packet on host chain: see events for details"
 )
 
+// msgError wraps the error returned while handling a message of a transaction with the index and
+// type URL of the message
+type msgError struct {
+	err     error
+	index   int
+	typeURL string
+}
+
+// WrapMsgError wraps the error returned while handling the message at the provided index of a transaction.
+// The index and type URL of the message are included in structured error acknowledgements.
+func WrapMsgError(err error, index int, msg sdk.Msg) error {
+	return &msgError{
+		err:     err,
+		index:   index,
+		typeURL: sdk.MsgTypeURL(msg),
+	}
+}
+
+// Error implements the error interface
+func (e *msgError) Error() string {
+	return fmt.Sprintf("message %d (%s): %s", e.index, e.typeURL, e.err)
+}
+
+// Cause returns the wrapped error, it is used to retrieve the ABCI code of the error
+func (e *msgError) Cause() error {
+	return e.err
+}
+
+// Unwrap returns the wrapped error
+func (e *msgError) Unwrap() error {
+	return e.err
+}
+
 // NewErrorAcknowledgement returns a deterministic error string which may be used in
 // the packet acknowledgement.
 func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
@@ -25,3 +61,37 @@ func NewErrorAcknowledgement(err error) channeltypes.Acknowledgement {
 
 	return channeltypes.NewErrorAcknowledgement(errorString)
 }
+
+// NewStructuredErrorAcknowledgement returns an error acknowledgement whose error is a JSON encoded
+// ErrorAcknowledgement. Only the ABCI code of the error, the gas consumed by the packet, as well as
+// the index and type URL of the failing message if the error was wrapped using WrapMsgError, are
+// included in the acknowledgement as they are deterministic. It is used on channels using the
+// ics27-ack-1 acknowledgement version.
+func NewStructuredErrorAcknowledgement(err error, gasUsed uint64) channeltypes.Acknowledgement {
+	_, code, _ := sdkerrors.ABCIInfo(err, false) // discard non-deterministic codespace and log values
+
+	errorAck := icatypes.ErrorAcknowledgement{
+		Code:    code,
+		GasUsed: gasUsed,
+	}
+
+	var msgErr *msgError
+	if errors.As(err, &msgErr) {
+		errorAck.MsgIndex = uint64(msgErr.index)
+		errorAck.MsgTypeUrl = msgErr.typeURL
+	}
+
+	return channeltypes.NewErrorAcknowledgement(string(errorAck.GetBytes()))
+}
+
+// NewStructuredResultAcknowledgement returns a successful acknowledgement whose result is a JSON encoded
+// ResultAcknowledgement containing the result of the packet execution and the gas consumed by the packet.
+// It is used on channels using the ics27-ack-1 acknowledgement version.
+func NewStructuredResultAcknowledgement(result []byte, gasUsed uint64) channeltypes.Acknowledgement {
+	resultAck := icatypes.ResultAcknowledgement{
+		Result:  result,
+		GasUsed: gasUsed,
+	}
+
+	return channeltypes.NewResultAcknowledgement(resultAck.GetBytes())
+}
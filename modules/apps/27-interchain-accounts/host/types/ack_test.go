@@ -3,13 +3,16 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/stretchr/testify/suite"
 	abcitypes "github.com/tendermint/tendermint/abci/types"
 	tmprotostate "github.com/tendermint/tendermint/proto/tendermint/state"
 	tmstate "github.com/tendermint/tendermint/state"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -99,3 +102,40 @@ func (suite *TypesTestSuite) TestAcknowledgementError() {
 	suite.Require().NotEqual(ack, ackDifferentABCICode)
 
 }
+
+// TestStructuredAcknowledgementError will verify that only the ABCI error code and the index and
+// type URL of the failing message are used in constructing the structured acknowledgement error
+func (suite *TypesTestSuite) TestStructuredAcknowledgementError() {
+	msg := &banktypes.MsgSend{}
+
+	// same ABCI error code used
+	err := types.WrapMsgError(sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 1"), 1, msg)
+	errSameABCICode := types.WrapMsgError(sdkerrors.Wrap(sdkerrors.ErrOutOfGas, "error string 2"), 1, msg)
+
+	// different ABCI error code used
+	errDifferentABCICode := types.WrapMsgError(sdkerrors.ErrNotFound, 1, msg)
+
+	// different message index used
+	errDifferentIndex := types.WrapMsgError(sdkerrors.ErrOutOfGas, 0, msg)
+
+	ack := types.NewStructuredErrorAcknowledgement(err, gasUsed)
+	ackSameABCICode := types.NewStructuredErrorAcknowledgement(errSameABCICode, gasUsed)
+	ackDifferentABCICode := types.NewStructuredErrorAcknowledgement(errDifferentABCICode, gasUsed)
+	ackDifferentIndex := types.NewStructuredErrorAcknowledgement(errDifferentIndex, gasUsed)
+
+	suite.Require().Equal(ack, ackSameABCICode)
+	suite.Require().NotEqual(ack, ackDifferentABCICode)
+	suite.Require().NotEqual(ack, ackDifferentIndex)
+
+	errorAck, parseErr := icatypes.ParseErrorAcknowledgement(ack)
+	suite.Require().NoError(parseErr)
+	suite.Require().Equal(sdkerrors.ErrOutOfGas.ABCICode(), errorAck.Code)
+	suite.Require().Equal(uint64(1), errorAck.MsgIndex)
+	suite.Require().Equal(sdk.MsgTypeURL(msg), errorAck.MsgTypeUrl)
+	suite.Require().Equal(gasUsed, errorAck.GasUsed)
+
+	// errors not wrapped with a message index only include the ABCI code and the gas used
+	errorAck, parseErr = icatypes.ParseErrorAcknowledgement(types.NewStructuredErrorAcknowledgement(sdkerrors.ErrNotFound, gasUsed))
+	suite.Require().NoError(parseErr)
+	suite.Require().Equal(icatypes.ErrorAcknowledgement{Code: sdkerrors.ErrNotFound.ABCICode(), GasUsed: gasUsed}, errorAck)
+}
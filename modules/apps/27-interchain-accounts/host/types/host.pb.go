@@ -28,8 +28,23 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type Params struct {
 	// host_enabled enables or disables the host submodule.
 	HostEnabled bool `protobuf:"varint,1,opt,name=host_enabled,json=hostEnabled,proto3" json:"host_enabled,omitempty" yaml:"host_enabled"`
-	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain.
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed on a host chain. A typeURL ending
+	// with a wildcard (e.g. /cosmos.staking.v1beta1.*) allows all the messages whose typeURL starts with the preceding
+	// prefix.
 	AllowMessages []string `protobuf:"bytes,2,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+	// allow_messages_overrides defines allowlists which replace allow_messages for the interchain accounts of a
+	// connection or of a controller port on a connection.
+	AllowMessagesOverrides []AllowMessagesOverride `protobuf:"bytes,3,rep,name=allow_messages_overrides,json=allowMessagesOverrides,proto3" json:"allow_messages_overrides" yaml:"allow_messages_overrides"`
+	// allow_queries defines a list of gRPC query paths (e.g. /cosmos.bank.v1beta1.Query/Balance) which may be queried
+	// by interchain accounts on a host chain. A path ending with a wildcard allows all the query paths which start with
+	// the preceding prefix.
+	AllowQueries []string `protobuf:"bytes,4,rep,name=allow_queries,json=allowQueries,proto3" json:"allow_queries,omitempty" yaml:"allow_queries"`
+	// max_gas_per_packet defines the maximum amount of gas which may be consumed by the execution of a single
+	// interchain accounts packet. The gas consumed by a packet is not limited if it is zero.
+	MaxGasPerPacket uint64 `protobuf:"varint,5,opt,name=max_gas_per_packet,json=maxGasPerPacket,proto3" json:"max_gas_per_packet,omitempty" yaml:"max_gas_per_packet"`
+	// max_gas_per_packet_overrides defines gas limits which replace max_gas_per_packet for the packets received on
+	// the channels of a connection.
+	MaxGasPerPacketOverrides []MaxGasPerPacketOverride `protobuf:"bytes,6,rep,name=max_gas_per_packet_overrides,json=maxGasPerPacketOverrides,proto3" json:"max_gas_per_packet_overrides" yaml:"max_gas_per_packet_overrides"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -79,8 +94,162 @@ func (m *Params) GetAllowMessages() []string {
 	return nil
 }
 
+func (m *Params) GetAllowMessagesOverrides() []AllowMessagesOverride {
+	if m != nil {
+		return m.AllowMessagesOverrides
+	}
+	return nil
+}
+
+func (m *Params) GetAllowQueries() []string {
+	if m != nil {
+		return m.AllowQueries
+	}
+	return nil
+}
+
+func (m *Params) GetMaxGasPerPacket() uint64 {
+	if m != nil {
+		return m.MaxGasPerPacket
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerPacketOverrides() []MaxGasPerPacketOverride {
+	if m != nil {
+		return m.MaxGasPerPacketOverrides
+	}
+	return nil
+}
+
+// AllowMessagesOverride defines the sdk message typeURLs allowed to be executed by the interchain accounts of a
+// connection, or of a single controller port on a connection. An override for a controller port takes precedence
+// over an override for the whole connection.
+type AllowMessagesOverride struct {
+	// connection_id of the connection the override applies to.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// port_id of the controller port the override applies to. The override applies to all controller ports on the
+	// connection if it is empty.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// allow_messages defines a list of sdk message typeURLs allowed to be executed.
+	AllowMessages []string `protobuf:"bytes,3,rep,name=allow_messages,json=allowMessages,proto3" json:"allow_messages,omitempty" yaml:"allow_messages"`
+}
+
+func (m *AllowMessagesOverride) Reset()         { *m = AllowMessagesOverride{} }
+func (m *AllowMessagesOverride) String() string { return proto.CompactTextString(m) }
+func (*AllowMessagesOverride) ProtoMessage()    {}
+func (*AllowMessagesOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{1}
+}
+func (m *AllowMessagesOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowMessagesOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowMessagesOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowMessagesOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowMessagesOverride.Merge(m, src)
+}
+func (m *AllowMessagesOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowMessagesOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowMessagesOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowMessagesOverride proto.InternalMessageInfo
+
+func (m *AllowMessagesOverride) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *AllowMessagesOverride) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *AllowMessagesOverride) GetAllowMessages() []string {
+	if m != nil {
+		return m.AllowMessages
+	}
+	return nil
+}
+
+// MaxGasPerPacketOverride defines the maximum amount of gas which may be consumed by the execution of a single
+// interchain accounts packet received on the channels of a connection.
+type MaxGasPerPacketOverride struct {
+	// connection_id of the connection the override applies to.
+	ConnectionId string `protobuf:"bytes,1,opt,name=connection_id,json=connectionId,proto3" json:"connection_id,omitempty" yaml:"connection_id"`
+	// max_gas defines the maximum amount of gas which may be consumed by a packet, the gas consumed is not limited if
+	// it is zero.
+	MaxGas uint64 `protobuf:"varint,2,opt,name=max_gas,json=maxGas,proto3" json:"max_gas,omitempty" yaml:"max_gas"`
+}
+
+func (m *MaxGasPerPacketOverride) Reset()         { *m = MaxGasPerPacketOverride{} }
+func (m *MaxGasPerPacketOverride) String() string { return proto.CompactTextString(m) }
+func (*MaxGasPerPacketOverride) ProtoMessage()    {}
+func (*MaxGasPerPacketOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_48e202774f13d08e, []int{2}
+}
+func (m *MaxGasPerPacketOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MaxGasPerPacketOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MaxGasPerPacketOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MaxGasPerPacketOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MaxGasPerPacketOverride.Merge(m, src)
+}
+func (m *MaxGasPerPacketOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *MaxGasPerPacketOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_MaxGasPerPacketOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MaxGasPerPacketOverride proto.InternalMessageInfo
+
+func (m *MaxGasPerPacketOverride) GetConnectionId() string {
+	if m != nil {
+		return m.ConnectionId
+	}
+	return ""
+}
+
+func (m *MaxGasPerPacketOverride) GetMaxGas() uint64 {
+	if m != nil {
+		return m.MaxGas
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "ibc.applications.interchain_accounts.host.v1.Params")
+	proto.RegisterType((*AllowMessagesOverride)(nil), "ibc.applications.interchain_accounts.host.v1.AllowMessagesOverride")
+	proto.RegisterType((*MaxGasPerPacketOverride)(nil), "ibc.applications.interchain_accounts.host.v1.MaxGasPerPacketOverride")
 }
 
 func init() {
@@ -88,26 +257,41 @@ func init() {
 }
 
 var fileDescriptor_48e202774f13d08e = []byte{
-	// 290 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x32, 0xcf, 0x4c, 0x4a, 0xd6,
-	0x4f, 0x2c, 0x28, 0xc8, 0xc9, 0x4c, 0x4e, 0x2c, 0xc9, 0xcc, 0xcf, 0x2b, 0xd6, 0xcf, 0xcc, 0x2b,
-	0x49, 0x2d, 0x4a, 0xce, 0x48, 0xcc, 0xcc, 0x8b, 0x4f, 0x4c, 0x4e, 0xce, 0x2f, 0xcd, 0x2b, 0x29,
-	0xd6, 0xcf, 0xc8, 0x2f, 0x2e, 0xd1, 0x2f, 0x33, 0x04, 0xd3, 0x7a, 0x05, 0x45, 0xf9, 0x25, 0xf9,
-	0x42, 0x3a, 0x99, 0x49, 0xc9, 0x7a, 0xc8, 0x1a, 0xf5, 0xb0, 0x68, 0xd4, 0x03, 0x6b, 0x28, 0x33,
-	0x94, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0x6b, 0xd4, 0x07, 0xb1, 0x20, 0x66, 0x28, 0xb5, 0x31,
-	0x72, 0xb1, 0x05, 0x24, 0x16, 0x25, 0xe6, 0x16, 0x0b, 0x59, 0x71, 0xf1, 0x80, 0xd4, 0xc6, 0xa7,
-	0xe6, 0x25, 0x26, 0xe5, 0xa4, 0xa6, 0x48, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x38, 0x89, 0x7f, 0xba,
-	0x27, 0x2f, 0x5c, 0x99, 0x98, 0x9b, 0x63, 0xa5, 0x84, 0x2c, 0xab, 0x14, 0xc4, 0x0d, 0xe2, 0xba,
-	0x42, 0x78, 0x42, 0x0e, 0x5c, 0x7c, 0x89, 0x39, 0x39, 0xf9, 0xe5, 0xf1, 0xb9, 0xa9, 0xc5, 0xc5,
-	0x89, 0xe9, 0xa9, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0x9c, 0x4e, 0x92, 0x9f, 0xee, 0xc9, 0x8b,
-	0x42, 0x74, 0xa3, 0xca, 0x2b, 0x05, 0xf1, 0x82, 0x05, 0x7c, 0xa1, 0x7c, 0xa7, 0x94, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xf2, 0x4a, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2,
-	0x4b, 0xce, 0xcf, 0xd5, 0x4f, 0xce, 0x2f, 0xce, 0xcd, 0x2f, 0xd6, 0xcf, 0x4c, 0x4a, 0xd6, 0x4d,
-	0xcf, 0xd7, 0x2f, 0x33, 0xd6, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x06, 0x85, 0x5f, 0xb1,
-	0xbe, 0x91, 0xb9, 0x2e, 0x22, 0x04, 0x74, 0x51, 0x83, 0xae, 0xa4, 0xb2, 0x20, 0xb5, 0x38, 0x89,
-	0x0d, 0xec, 0x6b, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf0, 0xc9, 0x05, 0x61, 0x74, 0x01,
-	0x00, 0x00,
+	// 543 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xc1, 0x6a, 0xd4, 0x40,
+	0x18, 0xde, 0x74, 0xd7, 0x55, 0xa7, 0xb5, 0xc2, 0xd8, 0xda, 0x54, 0x34, 0x59, 0xc6, 0x83, 0x0b,
+	0x75, 0x33, 0xb4, 0x3d, 0x14, 0x0a, 0x82, 0xae, 0x14, 0x69, 0xa1, 0xb8, 0xe6, 0xe8, 0x25, 0x4c,
+	0x66, 0x87, 0xec, 0x60, 0x92, 0x89, 0x99, 0xec, 0xda, 0x3e, 0x80, 0x77, 0x9f, 0xc2, 0x07, 0xf0,
+	0x1d, 0x84, 0x1e, 0x7b, 0xf4, 0x14, 0x64, 0xf7, 0x01, 0x84, 0x3c, 0x81, 0x64, 0x12, 0xdc, 0x84,
+	0xa6, 0x87, 0x05, 0x4f, 0x99, 0x7f, 0xbe, 0xff, 0xfb, 0xe6, 0xff, 0xbf, 0xfc, 0xfc, 0xe0, 0x88,
+	0xbb, 0x14, 0x93, 0x28, 0xf2, 0x39, 0x25, 0x09, 0x17, 0xa1, 0xc4, 0x3c, 0x4c, 0x58, 0x4c, 0x27,
+	0x84, 0x87, 0x0e, 0xa1, 0x54, 0x4c, 0xc3, 0x44, 0xe2, 0x89, 0x90, 0x09, 0x9e, 0xed, 0xab, 0xaf,
+	0x15, 0xc5, 0x22, 0x11, 0xf0, 0x25, 0x77, 0xa9, 0x55, 0x25, 0x5a, 0x0d, 0x44, 0x4b, 0x11, 0x66,
+	0xfb, 0x4f, 0xb6, 0x3c, 0xe1, 0x09, 0x45, 0xc4, 0xf9, 0xa9, 0xd0, 0x40, 0x7f, 0x3a, 0xa0, 0x3b,
+	0x22, 0x31, 0x09, 0x24, 0x3c, 0x06, 0x1b, 0x79, 0xae, 0xc3, 0x42, 0xe2, 0xfa, 0x6c, 0xac, 0x6b,
+	0x3d, 0xad, 0x7f, 0x6f, 0xb8, 0x93, 0xa5, 0xe6, 0xa3, 0x4b, 0x12, 0xf8, 0xc7, 0xa8, 0x8a, 0x22,
+	0x7b, 0x3d, 0x0f, 0x4f, 0x8a, 0x08, 0xbe, 0x06, 0x9b, 0xc4, 0xf7, 0xc5, 0x17, 0x27, 0x60, 0x52,
+	0x12, 0x8f, 0x49, 0x7d, 0xad, 0xd7, 0xee, 0xdf, 0x1f, 0xee, 0x66, 0xa9, 0xb9, 0x5d, 0xb0, 0xeb,
+	0x38, 0xb2, 0x1f, 0xa8, 0x8b, 0xf3, 0x32, 0x86, 0xdf, 0x35, 0xa0, 0xd7, 0x53, 0x1c, 0x31, 0x63,
+	0x71, 0xcc, 0xc7, 0x4c, 0xea, 0xed, 0x5e, 0xbb, 0xbf, 0x7e, 0xf0, 0xd6, 0x5a, 0xa5, 0x61, 0xeb,
+	0x4d, 0x55, 0xff, 0x7d, 0xa9, 0x35, 0x7c, 0x71, 0x95, 0x9a, 0xad, 0x2c, 0x35, 0xcd, 0xa6, 0xaa,
+	0x96, 0x4f, 0x22, 0xfb, 0x31, 0x69, 0xe2, 0x4b, 0xf8, 0x0a, 0x14, 0x95, 0x3b, 0x9f, 0xa7, 0x2c,
+	0xe6, 0x4c, 0xea, 0x1d, 0xd5, 0xa9, 0x9e, 0xa5, 0xe6, 0x56, 0x55, 0xb3, 0x84, 0x91, 0xbd, 0xa1,
+	0xe2, 0x0f, 0x45, 0x08, 0xcf, 0x00, 0x0c, 0xc8, 0x85, 0xe3, 0x11, 0xe9, 0x44, 0x2c, 0x76, 0x22,
+	0x42, 0x3f, 0xb1, 0x44, 0xbf, 0xd3, 0xd3, 0xfa, 0x9d, 0xe1, 0xb3, 0x2c, 0x35, 0x77, 0x0b, 0x8d,
+	0x9b, 0x39, 0xc8, 0x7e, 0x18, 0x90, 0x8b, 0x77, 0x44, 0x8e, 0x58, 0x3c, 0x52, 0x37, 0xf0, 0x87,
+	0x06, 0x9e, 0xde, 0x4c, 0xac, 0xf8, 0xd6, 0x55, 0xbe, 0x9d, 0xac, 0xe6, 0xdb, 0x79, 0xfd, 0x95,
+	0x7f, 0xce, 0xed, 0x95, 0xce, 0x3d, 0xbf, 0xad, 0xc2, 0xaa, 0x7b, 0x7a, 0xd0, 0xac, 0x22, 0xd1,
+	0x4f, 0x0d, 0x6c, 0x37, 0xfe, 0x9a, 0xdc, 0x59, 0x2a, 0xc2, 0x90, 0xd1, 0xbc, 0x46, 0x87, 0x17,
+	0x13, 0x58, 0x73, 0xb6, 0x06, 0x23, 0x7b, 0x63, 0x19, 0x9f, 0x8e, 0xe1, 0x1e, 0xb8, 0x1b, 0x89,
+	0x38, 0xc9, 0x89, 0x6b, 0x8a, 0x08, 0xb3, 0xd4, 0xdc, 0x2c, 0x88, 0x25, 0x80, 0xec, 0x6e, 0x7e,
+	0x3a, 0x6d, 0x1a, 0xd8, 0xf6, 0x6a, 0x03, 0x8b, 0xbe, 0x6a, 0x60, 0xe7, 0x16, 0xab, 0xfe, 0x43,
+	0x27, 0xa5, 0xbb, 0xaa, 0x93, 0x4e, 0xb5, 0x93, 0x12, 0x40, 0x76, 0xb7, 0x70, 0x78, 0x38, 0xbe,
+	0x9a, 0x1b, 0xda, 0xf5, 0xdc, 0xd0, 0x7e, 0xcf, 0x0d, 0xed, 0xdb, 0xc2, 0x68, 0x5d, 0x2f, 0x8c,
+	0xd6, 0xaf, 0x85, 0xd1, 0xfa, 0x78, 0xe6, 0xf1, 0x64, 0x32, 0x75, 0x2d, 0x2a, 0x02, 0x4c, 0x85,
+	0x0c, 0x84, 0xc4, 0xdc, 0xa5, 0x03, 0x4f, 0xe0, 0xd9, 0x21, 0x0e, 0xc4, 0x78, 0xea, 0x33, 0x99,
+	0x2f, 0x1e, 0x89, 0x0f, 0x8e, 0x06, 0xcb, 0x89, 0x18, 0xd4, 0x77, 0x4e, 0x72, 0x19, 0x31, 0xe9,
+	0x76, 0xd5, 0xba, 0x38, 0xfc, 0x3b, 0x00, 0xe5, 0x6d, 0x08, 0x09, 0xad, 0x04, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -130,6 +314,48 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MaxGasPerPacketOverrides) > 0 {
+		for iNdEx := len(m.MaxGasPerPacketOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxGasPerPacketOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxGasPerPacket != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGasPerPacket))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowQueries) > 0 {
+		for iNdEx := len(m.AllowQueries) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowQueries[iNdEx])
+			copy(dAtA[i:], m.AllowQueries[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowQueries[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowMessagesOverrides) > 0 {
+		for iNdEx := len(m.AllowMessagesOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AllowMessagesOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintHost(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.AllowMessages) > 0 {
 		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.AllowMessages[iNdEx])
@@ -152,6 +378,87 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *AllowMessagesOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowMessagesOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowMessagesOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowMessages) > 0 {
+		for iNdEx := len(m.AllowMessages) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowMessages[iNdEx])
+			copy(dAtA[i:], m.AllowMessages[iNdEx])
+			i = encodeVarintHost(dAtA, i, uint64(len(m.AllowMessages[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MaxGasPerPacketOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MaxGasPerPacketOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MaxGasPerPacketOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGas != 0 {
+		i = encodeVarintHost(dAtA, i, uint64(m.MaxGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConnectionId) > 0 {
+		i -= len(m.ConnectionId)
+		copy(dAtA[i:], m.ConnectionId)
+		i = encodeVarintHost(dAtA, i, uint64(len(m.ConnectionId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintHost(dAtA []byte, offset int, v uint64) int {
 	offset -= sovHost(v)
 	base := offset
@@ -178,6 +485,66 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovHost(uint64(l))
 		}
 	}
+	if len(m.AllowMessagesOverrides) > 0 {
+		for _, e := range m.AllowMessagesOverrides {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if len(m.AllowQueries) > 0 {
+		for _, s := range m.AllowQueries {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	if m.MaxGasPerPacket != 0 {
+		n += 1 + sovHost(uint64(m.MaxGasPerPacket))
+	}
+	if len(m.MaxGasPerPacketOverrides) > 0 {
+		for _, e := range m.MaxGasPerPacketOverrides {
+			l = e.Size()
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AllowMessagesOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if len(m.AllowMessages) > 0 {
+		for _, s := range m.AllowMessages {
+			l = len(s)
+			n += 1 + l + sovHost(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MaxGasPerPacketOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConnectionId)
+	if l > 0 {
+		n += 1 + l + sovHost(uint64(l))
+	}
+	if m.MaxGas != 0 {
+		n += 1 + sovHost(uint64(m.MaxGas))
+	}
 	return n
 }
 
@@ -268,6 +635,372 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessagesOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessagesOverrides = append(m.AllowMessagesOverrides, AllowMessagesOverride{})
+			if err := m.AllowMessagesOverrides[len(m.AllowMessagesOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowQueries", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowQueries = append(m.AllowQueries, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacket", wireType)
+			}
+			m.MaxGasPerPacket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerPacket |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerPacketOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxGasPerPacketOverrides = append(m.MaxGasPerPacketOverrides, MaxGasPerPacketOverride{})
+			if err := m.MaxGasPerPacketOverrides[len(m.MaxGasPerPacketOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowMessagesOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowMessagesOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowMessagesOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowMessages", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowMessages = append(m.AllowMessages, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipHost(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthHost
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MaxGasPerPacketOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowHost
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MaxGasPerPacketOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MaxGasPerPacketOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthHost
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthHost
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGas", wireType)
+			}
+			m.MaxGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowHost
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipHost(dAtA[iNdEx:])
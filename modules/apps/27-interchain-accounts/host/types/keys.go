@@ -1,6 +1,8 @@
 package types
 
 import (
+	"strings"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -10,12 +12,31 @@ const (
 
 	// StoreKey is the store key string for the interchain accounts host module
 	StoreKey = SubModuleName
+
+	// Wildcard may be used as the last character of an allowed message type URL or query path to allow
+	// all the message type URLs or query paths which start with the preceding prefix
+	Wildcard = "*"
 )
 
-// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs, otherwise false
+// ContainsMsgType returns true if the sdk.Msg TypeURL is present in allowMsgs or matches one of its
+// wildcard prefixes, otherwise false
 func ContainsMsgType(allowMsgs []string, msg sdk.Msg) bool {
-	for _, v := range allowMsgs {
-		if v == sdk.MsgTypeURL(msg) {
+	return isAllowed(allowMsgs, sdk.MsgTypeURL(msg))
+}
+
+// ContainsQueryPath returns true if the gRPC query path is present in allowQueries or matches one of its
+// wildcard prefixes, otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	return isAllowed(allowQueries, path)
+}
+
+func isAllowed(allowlist []string, value string) bool {
+	for _, v := range allowlist {
+		if v == value {
+			return true
+		}
+
+		if strings.HasSuffix(v, Wildcard) && strings.HasPrefix(value, strings.TrimSuffix(v, Wildcard)) {
 			return true
 		}
 	}
@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/types"
+)
+
+func TestContainsMsgType(t *testing.T) {
+	msg := &banktypes.MsgSend{}
+
+	testCases := []struct {
+		name      string
+		allowMsgs []string
+		expPass   bool
+	}{
+		{"exact type URL", []string{"/cosmos.staking.v1beta1.MsgDelegate", sdk.MsgTypeURL(msg)}, true},
+		{"wildcard package prefix", []string{"/cosmos.bank.v1beta1.*"}, true},
+		{"wildcard for all messages", []string{"*"}, true},
+		{"type URL prefix without wildcard", []string{"/cosmos.bank.v1beta1."}, false},
+		{"wildcard for other package", []string{"/cosmos.staking.v1beta1.*"}, false},
+		{"empty allowlist", nil, false},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPass, types.ContainsMsgType(tc.allowMsgs, msg), tc.name)
+	}
+}
@@ -5,11 +5,15 @@ import (
 	"strings"
 
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 const (
 	// DefaultHostEnabled is the default value for the host param (set to true)
 	DefaultHostEnabled = true
+	// DefaultMaxGasPerPacket is the default value for the max gas per packet param (set to 1000000)
+	DefaultMaxGasPerPacket = 1000000
 )
 
 var (
@@ -17,6 +21,14 @@ var (
 	KeyHostEnabled = []byte("HostEnabled")
 	// KeyAllowMessages is the store key for the AllowMessages Params
 	KeyAllowMessages = []byte("AllowMessages")
+	// KeyAllowMessagesOverrides is the store key for the AllowMessagesOverrides Params
+	KeyAllowMessagesOverrides = []byte("AllowMessagesOverrides")
+	// KeyAllowQueries is the store key for the AllowQueries Params
+	KeyAllowQueries = []byte("AllowQueries")
+	// KeyMaxGasPerPacket is the store key for the MaxGasPerPacket Params
+	KeyMaxGasPerPacket = []byte("MaxGasPerPacket")
+	// KeyMaxGasPerPacketOverrides is the store key for the MaxGasPerPacketOverrides Params
+	KeyMaxGasPerPacketOverrides = []byte("MaxGasPerPacketOverrides")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -25,16 +37,23 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the host submodule
-func NewParams(enableHost bool, allowMsgs []string) Params {
+func NewParams(
+	enableHost bool, allowMsgs []string, overrides []AllowMessagesOverride, allowQueries []string,
+	maxGasPerPacket uint64, maxGasOverrides []MaxGasPerPacketOverride,
+) Params {
 	return Params{
-		HostEnabled:   enableHost,
-		AllowMessages: allowMsgs,
+		HostEnabled:              enableHost,
+		AllowMessages:            allowMsgs,
+		AllowMessagesOverrides:   overrides,
+		AllowQueries:             allowQueries,
+		MaxGasPerPacket:          maxGasPerPacket,
+		MaxGasPerPacketOverrides: maxGasOverrides,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the host submodule
 func DefaultParams() Params {
-	return NewParams(DefaultHostEnabled, nil)
+	return NewParams(DefaultHostEnabled, nil, nil, nil, DefaultMaxGasPerPacket, nil)
 }
 
 // Validate validates all host submodule parameters
@@ -47,7 +66,55 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return nil
+	if err := validateOverrides(p.AllowMessagesOverrides); err != nil {
+		return err
+	}
+
+	if err := validateAllowlist(p.AllowQueries); err != nil {
+		return err
+	}
+
+	if err := validateMaxGas(p.MaxGasPerPacket); err != nil {
+		return err
+	}
+
+	return validateMaxGasOverrides(p.MaxGasPerPacketOverrides)
+}
+
+// EffectiveAllowMessages returns the sdk message typeURLs allowed to be executed by the interchain accounts
+// registered by the provided controller port on the provided connection. An override for the controller port
+// on the connection takes precedence over an override for the connection, which takes precedence over the
+// global allowlist.
+func (p Params) EffectiveAllowMessages(connectionID, portID string) []string {
+	allowMsgs := p.AllowMessages
+	for _, override := range p.AllowMessagesOverrides {
+		if override.ConnectionId != connectionID {
+			continue
+		}
+
+		if override.PortId == portID {
+			return override.AllowMessages
+		}
+
+		if override.PortId == "" {
+			allowMsgs = override.AllowMessages
+		}
+	}
+
+	return allowMsgs
+}
+
+// EffectiveMaxGasPerPacket returns the maximum amount of gas which may be consumed by a packet received on the
+// provided connection. An override for the connection takes precedence over the global limit. The gas consumed
+// by a packet is not limited if zero is returned.
+func (p Params) EffectiveMaxGasPerPacket(connectionID string) uint64 {
+	for _, override := range p.MaxGasPerPacketOverrides {
+		if override.ConnectionId == connectionID {
+			return override.MaxGas
+		}
+	}
+
+	return p.MaxGasPerPacket
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -55,6 +122,10 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyHostEnabled, p.HostEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyAllowMessages, p.AllowMessages, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyAllowMessagesOverrides, p.AllowMessagesOverrides, validateOverridesParam),
+		paramtypes.NewParamSetPair(KeyAllowQueries, p.AllowQueries, validateAllowlist),
+		paramtypes.NewParamSetPair(KeyMaxGasPerPacket, p.MaxGasPerPacket, validateMaxGas),
+		paramtypes.NewParamSetPair(KeyMaxGasPerPacketOverrides, p.MaxGasPerPacketOverrides, validateMaxGasOverridesParam),
 	}
 }
 
@@ -77,6 +148,80 @@ func validateAllowlist(i interface{}) error {
 		if strings.TrimSpace(typeURL) == "" {
 			return fmt.Errorf("parameter must not contain empty strings: %s", allowMsgs)
 		}
+
+		if strings.Contains(strings.TrimSuffix(typeURL, Wildcard), Wildcard) {
+			return fmt.Errorf("wildcard may only be used as the last character of an allowlist entry: %s", typeURL)
+		}
+	}
+
+	return nil
+}
+
+func validateOverridesParam(i interface{}) error {
+	overrides, ok := i.([]AllowMessagesOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateOverrides(overrides)
+}
+
+func validateOverrides(overrides []AllowMessagesOverride) error {
+	seen := make(map[string]bool)
+	for _, override := range overrides {
+		if err := host.ConnectionIdentifierValidator(override.ConnectionId); err != nil {
+			return err
+		}
+
+		if override.PortId != "" {
+			if err := host.PortIdentifierValidator(override.PortId); err != nil {
+				return err
+			}
+		}
+
+		if err := validateAllowlist(override.AllowMessages); err != nil {
+			return err
+		}
+
+		key := override.ConnectionId + "/" + override.PortId
+		if seen[key] {
+			return fmt.Errorf("duplicate allow messages override for connection %s and port %s", override.ConnectionId, override.PortId)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
+
+func validateMaxGas(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateMaxGasOverridesParam(i interface{}) error {
+	overrides, ok := i.([]MaxGasPerPacketOverride)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateMaxGasOverrides(overrides)
+}
+
+func validateMaxGasOverrides(overrides []MaxGasPerPacketOverride) error {
+	seen := make(map[string]bool)
+	for _, override := range overrides {
+		if err := host.ConnectionIdentifierValidator(override.ConnectionId); err != nil {
+			return err
+		}
+
+		if seen[override.ConnectionId] {
+			return fmt.Errorf("duplicate max gas per packet override for connection %s", override.ConnectionId)
+		}
+		seen[override.ConnectionId] = true
 	}
 
 	return nil
@@ -10,5 +10,153 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, types.DefaultParams().Validate())
-	require.NoError(t, types.NewParams(false, []string{}).Validate())
+	require.NoError(t, types.NewParams(false, []string{}, nil, nil, 0, nil).Validate())
+}
+
+func TestValidateParamsAllowMessages(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{
+			"valid wildcard type URL",
+			types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.staking.v1beta1.*", "*"}, nil, nil, 0, nil),
+			true,
+		},
+		{
+			"valid connection and controller port overrides",
+			types.NewParams(true, nil, []types.AllowMessagesOverride{
+				{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.bank.v1beta1.*"}},
+				{ConnectionId: "connection-0", PortId: "icacontroller-owner", AllowMessages: []string{"/cosmos.staking.v1beta1.*"}},
+				{ConnectionId: "connection-1", AllowMessages: nil},
+			}, nil, 0, nil),
+			true,
+		},
+		{
+			"empty type URL",
+			types.NewParams(true, []string{" "}, nil, nil, 0, nil),
+			false,
+		},
+		{
+			"wildcard is not the last character",
+			types.NewParams(true, []string{"/cosmos.*.v1beta1.MsgSend"}, nil, nil, 0, nil),
+			false,
+		},
+		{
+			"override with invalid connection identifier",
+			types.NewParams(true, nil, []types.AllowMessagesOverride{
+				{ConnectionId: "", AllowMessages: []string{"/cosmos.bank.v1beta1.*"}},
+			}, nil, 0, nil),
+			false,
+		},
+		{
+			"override with invalid controller port identifier",
+			types.NewParams(true, nil, []types.AllowMessagesOverride{
+				{ConnectionId: "connection-0", PortId: "(invalid)", AllowMessages: []string{"/cosmos.bank.v1beta1.*"}},
+			}, nil, 0, nil),
+			false,
+		},
+		{
+			"override with invalid type URL",
+			types.NewParams(true, nil, []types.AllowMessagesOverride{
+				{ConnectionId: "connection-0", AllowMessages: []string{""}},
+			}, nil, 0, nil),
+			false,
+		},
+		{
+			"duplicate overrides",
+			types.NewParams(true, nil, []types.AllowMessagesOverride{
+				{ConnectionId: "connection-0", PortId: "icacontroller-owner", AllowMessages: []string{"/cosmos.bank.v1beta1.*"}},
+				{ConnectionId: "connection-0", PortId: "icacontroller-owner", AllowMessages: []string{"/cosmos.staking.v1beta1.*"}},
+			}, nil, 0, nil),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestEffectiveAllowMessages(t *testing.T) {
+	params := types.NewParams(true, []string{"/cosmos.bank.v1beta1.MsgSend"}, []types.AllowMessagesOverride{
+		{ConnectionId: "connection-0", PortId: "icacontroller-owner", AllowMessages: []string{"/cosmos.staking.v1beta1.*"}},
+		{ConnectionId: "connection-0", AllowMessages: []string{"/cosmos.gov.v1beta1.*"}},
+		{ConnectionId: "connection-1", PortId: "icacontroller-owner", AllowMessages: nil},
+	}, nil, 0, nil)
+
+	testCases := []struct {
+		name         string
+		connectionID string
+		portID       string
+		expAllowMsgs []string
+	}{
+		{"controller port override", "connection-0", "icacontroller-owner", []string{"/cosmos.staking.v1beta1.*"}},
+		{"connection override", "connection-0", "icacontroller-other", []string{"/cosmos.gov.v1beta1.*"}},
+		{"empty controller port override", "connection-1", "icacontroller-owner", nil},
+		{"global allowlist for other controller port", "connection-1", "icacontroller-other", []string{"/cosmos.bank.v1beta1.MsgSend"}},
+		{"global allowlist for other connection", "connection-2", "icacontroller-owner", []string{"/cosmos.bank.v1beta1.MsgSend"}},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expAllowMsgs, params.EffectiveAllowMessages(tc.connectionID, tc.portID), tc.name)
+	}
+}
+
+func TestValidateParamsMaxGasPerPacket(t *testing.T) {
+	testCases := []struct {
+		name    string
+		params  types.Params
+		expPass bool
+	}{
+		{
+			"valid max gas per packet and overrides",
+			types.NewParams(true, nil, nil, nil, 500000, []types.MaxGasPerPacketOverride{
+				{ConnectionId: "connection-0", MaxGas: 1000000},
+				{ConnectionId: "connection-1", MaxGas: 0},
+			}),
+			true,
+		},
+		{
+			"override with invalid connection identifier",
+			types.NewParams(true, nil, nil, nil, 500000, []types.MaxGasPerPacketOverride{
+				{ConnectionId: "", MaxGas: 1000000},
+			}),
+			false,
+		},
+		{
+			"duplicate overrides",
+			types.NewParams(true, nil, nil, nil, 500000, []types.MaxGasPerPacketOverride{
+				{ConnectionId: "connection-0", MaxGas: 1000000},
+				{ConnectionId: "connection-0", MaxGas: 2000000},
+			}),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.params.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestEffectiveMaxGasPerPacket(t *testing.T) {
+	params := types.NewParams(true, nil, nil, nil, 500000, []types.MaxGasPerPacketOverride{
+		{ConnectionId: "connection-0", MaxGas: 1000000},
+		{ConnectionId: "connection-1", MaxGas: 0},
+	})
+
+	require.Equal(t, uint64(1000000), params.EffectiveMaxGasPerPacket("connection-0"))
+	require.Equal(t, uint64(0), params.EffectiveMaxGasPerPacket("connection-1"))
+	require.Equal(t, uint64(500000), params.EffectiveMaxGasPerPacket("connection-2"))
 }
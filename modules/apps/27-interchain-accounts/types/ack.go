@@ -0,0 +1,50 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// GetBytes returns the sorted JSON encoding of the ErrorAcknowledgement which is written into the
+// error of an error acknowledgement
+func (ea ErrorAcknowledgement) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ea))
+}
+
+// ParseErrorAcknowledgement returns the ErrorAcknowledgement encoded into the error of an acknowledgement
+// written by a host chain on a channel using the ics27-ack-1 acknowledgement version
+func ParseErrorAcknowledgement(ack channeltypes.Acknowledgement) (ErrorAcknowledgement, error) {
+	if ack.Success() {
+		return ErrorAcknowledgement{}, sdkerrors.Wrap(ErrInvalidAcknowledgement, "acknowledgement is not an error acknowledgement")
+	}
+
+	var errorAck ErrorAcknowledgement
+	if err := ModuleCdc.UnmarshalJSON([]byte(ack.GetError()), &errorAck); err != nil {
+		return ErrorAcknowledgement{}, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "failed to unmarshal error acknowledgement: %s", err)
+	}
+
+	return errorAck, nil
+}
+
+// GetBytes returns the sorted JSON encoding of the ResultAcknowledgement which is written into the
+// result of a successful acknowledgement
+func (ra ResultAcknowledgement) GetBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&ra))
+}
+
+// ParseResultAcknowledgement returns the ResultAcknowledgement encoded into the result of an acknowledgement
+// written by a host chain on a channel using the ics27-ack-1 acknowledgement version
+func ParseResultAcknowledgement(ack channeltypes.Acknowledgement) (ResultAcknowledgement, error) {
+	if !ack.Success() {
+		return ResultAcknowledgement{}, sdkerrors.Wrap(ErrInvalidAcknowledgement, "acknowledgement is not a successful acknowledgement")
+	}
+
+	var resultAck ResultAcknowledgement
+	if err := ModuleCdc.UnmarshalJSON(ack.GetResult(), &resultAck); err != nil {
+		return ResultAcknowledgement{}, sdkerrors.Wrapf(ErrInvalidAcknowledgement, "failed to unmarshal result acknowledgement: %s", err)
+	}
+
+	return resultAck, nil
+}
@@ -0,0 +1,135 @@
+package types_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func (suite *TypesTestSuite) TestParseErrorAcknowledgement() {
+	var (
+		ack         channeltypes.Acknowledgement
+		expErrorAck types.ErrorAcknowledgement
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with empty message type URL",
+			func() {
+				expErrorAck = types.ErrorAcknowledgement{Code: 5}
+				ack = channeltypes.NewErrorAcknowledgement(string(expErrorAck.GetBytes()))
+			},
+			true,
+		},
+		{
+			"success acknowledgement",
+			func() {
+				ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			},
+			false,
+		},
+		{
+			"error is not a JSON encoded error acknowledgement",
+			func() {
+				ack = channeltypes.NewErrorAcknowledgement("ABCI code: 5: error handling packet on host chain: see events for details")
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			expErrorAck = types.ErrorAcknowledgement{
+				Code:       11,
+				MsgIndex:   1,
+				MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend",
+			}
+			ack = channeltypes.NewErrorAcknowledgement(string(expErrorAck.GetBytes()))
+
+			tc.malleate()
+
+			errorAck, err := types.ParseErrorAcknowledgement(ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expErrorAck, errorAck)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestParseResultAcknowledgement() {
+	var (
+		ack          channeltypes.Acknowledgement
+		expResultAck types.ResultAcknowledgement
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with empty result",
+			func() {
+				expResultAck = types.ResultAcknowledgement{GasUsed: 100}
+				ack = channeltypes.NewResultAcknowledgement(expResultAck.GetBytes())
+			},
+			true,
+		},
+		{
+			"error acknowledgement",
+			func() {
+				ack = channeltypes.NewErrorAcknowledgement("ABCI code: 5: error handling packet on host chain: see events for details")
+			},
+			false,
+		},
+		{
+			"result is not a JSON encoded result acknowledgement",
+			func() {
+				ack = channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			expResultAck = types.ResultAcknowledgement{
+				Result:  []byte("result"),
+				GasUsed: 50000,
+			}
+			ack = channeltypes.NewResultAcknowledgement(expResultAck.GetBytes())
+
+			tc.malleate()
+
+			resultAck, err := types.ParseResultAcknowledgement(ack)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expResultAck, resultAck)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 )
 
 var (
@@ -83,3 +84,109 @@ func DeserializeCosmosTx(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error)
 
 	return msgs, nil
 }
+
+// SerializeCosmosTxJSON serializes a slice of sdk.Msg's using the CosmosTx type. The sdk.Msg's are
+// packed into Any's and inserted into the Messages field of a CosmosTx. The proto3 JSON encoded
+// CosmosTx bytes are returned, the type URL of each message is included in its "@type" field.
+// Only the ProtoCodec is supported for serializing messages.
+func SerializeCosmosTxJSON(cdc codec.BinaryCodec, msgs []sdk.Msg) (bz []byte, err error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
+	}
+
+	msgAnys := make([]*codectypes.Any, len(msgs))
+
+	for i, msg := range msgs {
+		msgAnys[i], err = codectypes.NewAnyWithValue(msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	cosmosTx := &CosmosTx{
+		Messages: msgAnys,
+	}
+
+	bz, err = protoCdc.MarshalJSON(cosmosTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return bz, nil
+}
+
+// DeserializeCosmosTxJSON unmarshals and unpacks a slice of proto3 JSON encoded
+// transaction bytes into a slice of sdk.Msg's. The messages are resolved by their
+// "@type" field using the interface registry of the codec. Only the ProtoCodec is
+// supported for message deserialization.
+func DeserializeCosmosTxJSON(cdc codec.BinaryCodec, data []byte) ([]sdk.Msg, error) {
+	// only ProtoCodec is supported
+	protoCdc, ok := cdc.(*codec.ProtoCodec)
+	if !ok {
+		return nil, sdkerrors.Wrap(ErrInvalidCodec, "only ProtoCodec is supported for receiving messages on the host chain")
+	}
+
+	var cosmosTx CosmosTx
+	if err := protoCdc.UnmarshalJSON(data, &cosmosTx); err != nil {
+		return nil, err
+	}
+
+	msgs := make([]sdk.Msg, len(cosmosTx.Messages))
+
+	for i, any := range cosmosTx.Messages {
+		var msg sdk.Msg
+
+		err := protoCdc.UnpackAny(any, &msg)
+		if err != nil {
+			return nil, err
+		}
+
+		msgs[i] = msg
+	}
+
+	return msgs, nil
+}
+
+// SerializeCosmosQuery serializes a slice of ABCI query requests using the CosmosQuery type.
+// The proto marshaled CosmosQuery bytes are returned.
+func SerializeCosmosQuery(reqs []abci.RequestQuery) ([]byte, error) {
+	cosmosQuery := &CosmosQuery{
+		Requests: reqs,
+	}
+
+	return cosmosQuery.Marshal()
+}
+
+// DeserializeCosmosQuery unmarshals a slice of query packet data bytes into a slice of ABCI
+// query requests.
+func DeserializeCosmosQuery(data []byte) ([]abci.RequestQuery, error) {
+	var cosmosQuery CosmosQuery
+	if err := cosmosQuery.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	return cosmosQuery.Requests, nil
+}
+
+// SerializeCosmosQueryResponse serializes a slice of ABCI query responses using the CosmosQueryResponse
+// type. The proto marshaled CosmosQueryResponse bytes are returned.
+func SerializeCosmosQueryResponse(resps []abci.ResponseQuery) ([]byte, error) {
+	cosmosQueryResponse := &CosmosQueryResponse{
+		Responses: resps,
+	}
+
+	return cosmosQueryResponse.Marshal()
+}
+
+// DeserializeCosmosQueryResponse unmarshals the acknowledgement result bytes of a query packet into
+// the slice of ABCI query responses returned by the host chain.
+func DeserializeCosmosQueryResponse(data []byte) ([]abci.ResponseQuery, error) {
+	var cosmosQueryResponse CosmosQueryResponse
+	if err := cosmosQueryResponse.Unmarshal(data); err != nil {
+		return nil, err
+	}
+
+	return cosmosQueryResponse.Responses, nil
+}
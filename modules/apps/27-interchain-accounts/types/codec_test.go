@@ -5,6 +5,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
 	"github.com/cosmos/ibc-go/v3/testing/simapp"
@@ -150,3 +151,124 @@ func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxWithAmino() {
 	suite.Require().Empty(bz)
 
 }
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosTxJSON() {
+	testCases := []struct {
+		name    string
+		msgs    []sdk.Msg
+		expPass bool
+	}{
+		{
+			"single msg",
+			[]sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: TestOwnerAddress,
+					ToAddress:   TestOwnerAddress,
+					Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+				},
+			},
+			true,
+		},
+		{
+			"multiple msgs, different types",
+			[]sdk.Msg{
+				&banktypes.MsgSend{
+					FromAddress: TestOwnerAddress,
+					ToAddress:   TestOwnerAddress,
+					Amount:      sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+				},
+				&govtypes.MsgSubmitProposal{
+					InitialDeposit: sdk.NewCoins(sdk.NewCoin("bananas", sdk.NewInt(100))),
+					Proposer:       TestOwnerAddress,
+				},
+			},
+			true,
+		},
+		{
+			"unregistered msg type",
+			[]sdk.Msg{
+				&mockSdkMsg{},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		bz, err := types.SerializeCosmosTxJSON(simapp.MakeTestEncodingConfig().Marshaler, tc.msgs)
+		if !tc.expPass {
+			// the type URL of an unregistered message cannot be resolved when encoding the Any to JSON
+			suite.Require().Error(err, tc.name)
+			continue
+		}
+
+		suite.Require().NoError(err, tc.name)
+
+		msgs, err := types.DeserializeCosmosTxJSON(simapp.MakeTestEncodingConfig().Marshaler, bz)
+		suite.Require().NoError(err, tc.name)
+		suite.Require().Equal(tc.msgs, msgs, tc.name)
+	}
+
+	// test deserializing a payload built without the Go codec, e.g. by a CosmWasm contract
+	msgs, err := types.DeserializeCosmosTxJSON(simapp.MakeTestEncodingConfig().Marshaler, []byte(`{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgSend","from_address":"`+TestOwnerAddress+`","to_address":"`+TestOwnerAddress+`","amount":[{"denom":"bananas","amount":"100"}]}]}`))
+	suite.Require().NoError(err)
+	suite.Require().Equal([]sdk.Msg{testCases[0].msgs[0]}, msgs)
+
+	// test deserializing an unregistered type URL
+	msgs, err = types.DeserializeCosmosTxJSON(simapp.MakeTestEncodingConfig().Marshaler, []byte(`{"messages":[{"@type":"/cosmos.bank.v1beta1.MsgUnknown"}]}`))
+	suite.Require().Error(err)
+	suite.Require().Empty(msgs)
+
+	// test deserializing protobuf encoded bytes
+	bz, err := types.SerializeCosmosTx(simapp.MakeTestEncodingConfig().Marshaler, testCases[0].msgs)
+	suite.Require().NoError(err)
+
+	msgs, err = types.DeserializeCosmosTxJSON(simapp.MakeTestEncodingConfig().Marshaler, bz)
+	suite.Require().Error(err)
+	suite.Require().Empty(msgs)
+}
+
+// test that the proto3 JSON encoding returns an error on an unsupported amino codec.
+func (suite *TypesTestSuite) TestDeserializeAndSerializeCosmosTxJSONWithAmino() {
+	cdc := codec.NewLegacyAmino()
+	marshaler := codec.NewAminoCodec(cdc)
+
+	bz, err := types.SerializeCosmosTxJSON(marshaler, []sdk.Msg{&banktypes.MsgSend{}})
+	suite.Require().Error(err)
+	suite.Require().Empty(bz)
+
+	msgs, err := types.DeserializeCosmosTxJSON(marshaler, []byte(`{"messages":[]}`))
+	suite.Require().Error(err)
+	suite.Require().Empty(msgs)
+}
+
+func (suite *TypesTestSuite) TestSerializeAndDeserializeCosmosQuery() {
+	reqs := []abci.RequestQuery{
+		{Path: "/cosmos.bank.v1beta1.Query/Balance", Data: []byte("balance request")},
+		{Path: "/cosmos.staking.v1beta1.Query/Delegation", Data: []byte("delegation request")},
+	}
+
+	bz, err := types.SerializeCosmosQuery(reqs)
+	suite.Require().NoError(err)
+
+	deserializedReqs, err := types.DeserializeCosmosQuery(bz)
+	suite.Require().NoError(err)
+	suite.Require().Equal(reqs, deserializedReqs)
+
+	resps := []abci.ResponseQuery{
+		{Value: []byte("balance response"), Height: 10},
+		{Value: []byte("delegation response"), Height: 10},
+	}
+
+	bz, err = types.SerializeCosmosQueryResponse(resps)
+	suite.Require().NoError(err)
+
+	deserializedResps, err := types.DeserializeCosmosQueryResponse(bz)
+	suite.Require().NoError(err)
+	suite.Require().Equal(resps, deserializedResps)
+
+	_, err = types.DeserializeCosmosQuery([]byte("invalid"))
+	suite.Require().Error(err)
+
+	_, err = types.DeserializeCosmosQueryResponse([]byte("invalid"))
+	suite.Require().Error(err)
+}
@@ -36,6 +36,22 @@ var (
 
 	// PortKeyPrefix defines the key prefix used to store ports
 	PortKeyPrefix = "port"
+
+	// IsMiddlewareEnabledPrefix defines the key prefix used to store the middleware enabled flag of controller
+	// port and connection pairs
+	IsMiddlewareEnabledPrefix = "isMiddlewareEnabled"
+
+	// PendingReopenKeyPrefix defines the key prefix used to store the controller port and connection pairs whose
+	// channel is reopened at the end of the block
+	PendingReopenKeyPrefix = "pendingReopen"
+
+	// MiddlewareEnabled is the value stored for a controller port and connection pair whose interchain account
+	// is controlled by an authentication module through the controller middleware
+	MiddlewareEnabled = []byte{0x01}
+
+	// MiddlewareDisabled is the value stored for a controller port and connection pair whose interchain account
+	// is controlled by its owner through the controller Msg service
+	MiddlewareDisabled = []byte{0x02}
 )
 
 // KeyActiveChannel creates and returns a new key used for active channels store operations
@@ -52,3 +68,13 @@ func KeyOwnerAccount(portID, connectionID string) []byte {
 func KeyPort(portID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", PortKeyPrefix, portID))
 }
+
+// KeyIsMiddlewareEnabled creates and returns a new key used for the middleware enabled flag store operations
+func KeyIsMiddlewareEnabled(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", IsMiddlewareEnabledPrefix, portID, connectionID))
+}
+
+// KeyPendingReopen creates and returns a new key used for pending channel reopening store operations
+func KeyPendingReopen(portID, connectionID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PendingReopenKeyPrefix, portID, connectionID))
+}
@@ -5,14 +5,22 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
 const (
 	// EncodingProtobuf defines the protocol buffers proto3 encoding format
 	EncodingProtobuf = "proto3"
 
+	// EncodingProto3JSON defines the proto3 JSON encoding format
+	EncodingProto3JSON = "proto3json"
+
 	// TxTypeSDKMultiMsg defines the multi message transaction type supported by the Cosmos SDK
 	TxTypeSDKMultiMsg = "sdk_multi_msg"
+
+	// AckVersion1 defines the acknowledgement version in which the error of an error acknowledgement is a
+	// JSON encoded ErrorAcknowledgement
+	AckVersion1 = "ics27-ack-1"
 )
 
 // NewMetadata creates and returns a new ICS27 Metadata instance
@@ -27,6 +35,16 @@ func NewMetadata(version, controllerConnectionID, hostConnectionID, accAddress,
 	}
 }
 
+// ChannelOrdering returns the ordering of the interchain account channel negotiated in the metadata.
+// ORDERED is returned if the ordering is unspecified
+func (metadata Metadata) ChannelOrdering() channeltypes.Order {
+	if metadata.Ordering == channeltypes.NONE {
+		return channeltypes.ORDERED
+	}
+
+	return metadata.Ordering
+}
+
 // IsPreviousMetadataEqual compares a metadata to a previous version string set in a channel struct.
 // It ensures all fields are equal except the Address string
 func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
@@ -39,7 +57,25 @@ func IsPreviousMetadataEqual(previousVersion string, metadata Metadata) bool {
 		previousMetadata.ControllerConnectionId == metadata.ControllerConnectionId &&
 		previousMetadata.HostConnectionId == metadata.HostConnectionId &&
 		previousMetadata.Encoding == metadata.Encoding &&
-		previousMetadata.TxType == metadata.TxType)
+		previousMetadata.TxType == metadata.TxType &&
+		previousMetadata.AckVersion == metadata.AckVersion &&
+		previousMetadata.ChannelOrdering() == metadata.ChannelOrdering())
+}
+
+// ValidateUpgradeMetadata ensures the metadata proposed in a channel upgrade does not change the
+// interchain account address set in the metadata of the channel version before the upgrade. The
+// interchain account address and the connection identifiers cannot be changed by a channel upgrade.
+func ValidateUpgradeMetadata(previousVersion string, metadata Metadata) error {
+	var previousMetadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(previousVersion), &previousMetadata); err != nil {
+		return sdkerrors.Wrapf(ErrUnknownDataType, "cannot unmarshal previous ICS-27 interchain accounts metadata")
+	}
+
+	if previousMetadata.Address != metadata.Address {
+		return sdkerrors.Wrapf(ErrInvalidAccountAddress, "expected %s, got %s", previousMetadata.Address, metadata.Address)
+	}
+
+	return nil
 }
 
 // ValidateControllerMetadata performs validation of the provided ICS27 controller metadata parameters
@@ -52,6 +88,14 @@ func ValidateControllerMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, co
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckVersion(metadata.AckVersion) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "unsupported acknowledgement version %s", metadata.AckVersion)
+	}
+
+	if !isSupportedOrdering(metadata.Ordering) {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "unsupported channel ordering %s", metadata.Ordering)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -84,6 +128,14 @@ func ValidateHostMetadata(ctx sdk.Context, channelKeeper ChannelKeeper, connecti
 		return sdkerrors.Wrapf(ErrUnknownDataType, "unsupported transaction type %s", metadata.TxType)
 	}
 
+	if !isSupportedAckVersion(metadata.AckVersion) {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "unsupported acknowledgement version %s", metadata.AckVersion)
+	}
+
+	if !isSupportedOrdering(metadata.Ordering) {
+		return sdkerrors.Wrapf(channeltypes.ErrInvalidChannelOrdering, "unsupported channel ordering %s", metadata.Ordering)
+	}
+
 	connection, err := channelKeeper.GetConnection(ctx, connectionHops[0])
 	if err != nil {
 		return err
@@ -119,7 +171,7 @@ func isSupportedEncoding(encoding string) bool {
 
 // getSupportedEncoding returns a string slice of supported encoding formats
 func getSupportedEncoding() []string {
-	return []string{EncodingProtobuf}
+	return []string{EncodingProtobuf, EncodingProto3JSON}
 }
 
 // isSupportedTxType returns true if the provided transaction type is supported, otherwise false
@@ -138,6 +190,23 @@ func getSupportedTxTypes() []string {
 	return []string{TxTypeSDKMultiMsg}
 }
 
+// isSupportedAckVersion returns true if the provided acknowledgement version is supported, otherwise false.
+// An empty acknowledgement version selects the legacy acknowledgement format
+func isSupportedAckVersion(ackVersion string) bool {
+	return ackVersion == "" || ackVersion == AckVersion1
+}
+
+// isSupportedOrdering returns true if the provided channel ordering is supported, otherwise false.
+// An unspecified ordering selects an ORDERED channel
+func isSupportedOrdering(ordering channeltypes.Order) bool {
+	switch ordering {
+	case channeltypes.NONE, channeltypes.ORDERED, channeltypes.UNORDERED:
+		return true
+	default:
+		return false
+	}
+}
+
 // validateConnectionParams compares the given the controller and host connection IDs to those set in the provided ICS27 Metadata
 func validateConnectionParams(metadata Metadata, controllerConnectionID, hostConnectionID string) error {
 	if metadata.ControllerConnectionId != controllerConnectionID {
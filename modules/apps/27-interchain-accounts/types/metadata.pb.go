@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -39,6 +40,11 @@ type Metadata struct {
 	Encoding string `protobuf:"bytes,5,opt,name=encoding,proto3" json:"encoding,omitempty"`
 	// tx_type defines the type of transactions the interchain account can execute
 	TxType string `protobuf:"bytes,6,opt,name=tx_type,json=txType,proto3" json:"tx_type,omitempty"`
+	// ack_version defines the format of the acknowledgements written by the host chain. The legacy acknowledgement
+	// format is used if it is empty
+	AckVersion string `protobuf:"bytes,7,opt,name=ack_version,json=ackVersion,proto3" json:"ack_version,omitempty" yaml:"ack_version"`
+	// ordering defines the ordering of the interchain account channel. An ORDERED channel is used if it is unspecified
+	Ordering types.Order `protobuf:"varint,8,opt,name=ordering,proto3,enum=ibc.core.channel.v1.Order" json:"ordering,omitempty"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -116,6 +122,20 @@ func (m *Metadata) GetTxType() string {
 	return ""
 }
 
+func (m *Metadata) GetAckVersion() string {
+	if m != nil {
+		return m.AckVersion
+	}
+	return ""
+}
+
+func (m *Metadata) GetOrdering() types.Order {
+	if m != nil {
+		return m.Ordering
+	}
+	return types.NONE
+}
+
 func init() {
 	proto.RegisterType((*Metadata)(nil), "ibc.applications.interchain_accounts.v1.Metadata")
 }
@@ -125,29 +145,34 @@ func init() {
 }
 
 var fileDescriptor_c29c32e397d1f21e = []byte{
-	// 349 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcf, 0x4a, 0xeb, 0x40,
-	0x18, 0xc5, 0x9b, 0xde, 0x6b, 0x5b, 0x67, 0x25, 0x83, 0xe8, 0x58, 0x30, 0x95, 0xb8, 0xd0, 0x4d,
-	0x33, 0xd4, 0x82, 0x82, 0xcb, 0x8a, 0x0b, 0x11, 0x37, 0xc5, 0x95, 0x20, 0x61, 0x32, 0x33, 0xa4,
-	0x03, 0xc9, 0x7c, 0x21, 0x33, 0x0d, 0xed, 0x5b, 0xf8, 0x06, 0xbe, 0x8e, 0xcb, 0x2e, 0x5d, 0x15,
-	0x69, 0xdf, 0xa0, 0x4f, 0x20, 0x49, 0x6b, 0xeb, 0xdf, 0x5d, 0x4e, 0xce, 0x39, 0xbf, 0x8f, 0xe1,
-	0xa0, 0x73, 0x15, 0x72, 0xca, 0xd2, 0x34, 0x56, 0x9c, 0x59, 0x05, 0xda, 0x50, 0xa5, 0xad, 0xcc,
-	0xf8, 0x80, 0x29, 0x1d, 0x30, 0xce, 0x61, 0xa8, 0xad, 0xa1, 0x79, 0x87, 0x26, 0xd2, 0x32, 0xc1,
-	0x2c, 0xf3, 0xd3, 0x0c, 0x2c, 0xe0, 0x13, 0x15, 0x72, 0xff, 0x73, 0xcf, 0xff, 0xa5, 0xe7, 0xe7,
-	0x9d, 0xe6, 0x6e, 0x04, 0x11, 0x94, 0x1d, 0x5a, 0x7c, 0x2d, 0xeb, 0xde, 0x73, 0x15, 0x35, 0xee,
-	0x56, 0x44, 0x4c, 0x50, 0x3d, 0x97, 0x99, 0x51, 0xa0, 0x89, 0x73, 0xe4, 0x9c, 0x6e, 0xf7, 0x3f,
-	0x24, 0x7e, 0x44, 0x84, 0x83, 0xb6, 0x19, 0xc4, 0xb1, 0xcc, 0x02, 0x0e, 0x5a, 0x4b, 0x5e, 0x5c,
-	0x0b, 0x94, 0x20, 0xd5, 0x22, 0xda, 0x3b, 0x5e, 0x4c, 0x5b, 0xad, 0x31, 0x4b, 0xe2, 0x4b, 0xef,
-	0xaf, 0xa4, 0xd7, 0xdf, 0xdb, 0x58, 0x57, 0x6b, 0xe7, 0x46, 0xe0, 0x5b, 0x84, 0x07, 0x60, 0xec,
-	0x37, 0xf0, 0xbf, 0x12, 0x7c, 0xb8, 0x98, 0xb6, 0x0e, 0x96, 0xe0, 0x9f, 0x19, 0xaf, 0xbf, 0x53,
-	0xfc, 0xfc, 0x02, 0x23, 0xa8, 0xce, 0x84, 0xc8, 0xa4, 0x31, 0xe4, 0xff, 0xf2, 0x15, 0x2b, 0x89,
-	0x9b, 0xa8, 0x21, 0x35, 0x07, 0xa1, 0x74, 0x44, 0xb6, 0x4a, 0x6b, 0xad, 0xf1, 0x3e, 0xaa, 0xdb,
-	0x51, 0x60, 0xc7, 0xa9, 0x24, 0xb5, 0xd2, 0xaa, 0xd9, 0xd1, 0xfd, 0x38, 0x95, 0xbd, 0xe0, 0x65,
-	0xe6, 0x3a, 0x93, 0x99, 0xeb, 0xbc, 0xcd, 0x5c, 0xe7, 0x69, 0xee, 0x56, 0x26, 0x73, 0xb7, 0xf2,
-	0x3a, 0x77, 0x2b, 0x0f, 0xd7, 0x91, 0xb2, 0x83, 0x61, 0xe8, 0x73, 0x48, 0x28, 0x07, 0x93, 0x80,
-	0xa1, 0x2a, 0xe4, 0xed, 0x08, 0x68, 0xde, 0xa5, 0x09, 0x88, 0x61, 0x2c, 0x4d, 0x31, 0xa9, 0xa1,
-	0x67, 0x17, 0xed, 0xcd, 0x2a, 0xed, 0xf5, 0x9a, 0xc5, 0x35, 0x13, 0xd6, 0xca, 0x25, 0xba, 0xef,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x6e, 0x74, 0x28, 0x89, 0x02, 0x02, 0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x5d, 0x8b, 0x13, 0x31,
+	0x14, 0xed, 0xb8, 0xda, 0xd6, 0x08, 0x22, 0x41, 0xd6, 0xb1, 0xe0, 0x74, 0x1d, 0x1f, 0xdc, 0x97,
+	0x4e, 0xe8, 0x2e, 0xec, 0x82, 0x8f, 0x2b, 0x3e, 0x88, 0x88, 0x30, 0x88, 0x0f, 0x82, 0x0c, 0x99,
+	0x9b, 0x30, 0x0d, 0x3b, 0x93, 0x3b, 0x24, 0xe9, 0xb0, 0xfd, 0x17, 0xfb, 0xb3, 0x7c, 0xdc, 0x47,
+	0x9f, 0x8a, 0xb4, 0xff, 0xa0, 0xbf, 0x40, 0x32, 0xd3, 0x76, 0xd7, 0xaf, 0xb7, 0xdc, 0x9c, 0x73,
+	0xcf, 0xb9, 0xc9, 0xb9, 0xe4, 0x4c, 0xe5, 0xc0, 0x78, 0x5d, 0x97, 0x0a, 0xb8, 0x53, 0xa8, 0x2d,
+	0x53, 0xda, 0x49, 0x03, 0x33, 0xae, 0x74, 0xc6, 0x01, 0x70, 0xae, 0x9d, 0x65, 0xcd, 0x94, 0x55,
+	0xd2, 0x71, 0xc1, 0x1d, 0x4f, 0x6a, 0x83, 0x0e, 0xe9, 0x6b, 0x95, 0x43, 0x72, 0xb7, 0x2f, 0xf9,
+	0x47, 0x5f, 0xd2, 0x4c, 0x47, 0x4f, 0x0b, 0x2c, 0xb0, 0xed, 0x61, 0xfe, 0xd4, 0xb5, 0x8f, 0x5e,
+	0x7a, 0x5b, 0x40, 0x23, 0x19, 0xcc, 0xb8, 0xd6, 0xb2, 0xf4, 0x16, 0xdb, 0x63, 0x47, 0x89, 0xaf,
+	0x0f, 0xc8, 0xf0, 0xe3, 0xd6, 0x94, 0x86, 0x64, 0xd0, 0x48, 0x63, 0x15, 0xea, 0x30, 0x38, 0x0a,
+	0x8e, 0x1f, 0xa6, 0xbb, 0x92, 0x7e, 0x23, 0x21, 0xa0, 0x76, 0x06, 0xcb, 0x52, 0x9a, 0x0c, 0x50,
+	0x6b, 0x09, 0x7e, 0xa0, 0x4c, 0x89, 0xf0, 0x9e, 0xa7, 0x5e, 0xbc, 0xda, 0x2c, 0xc7, 0xe3, 0x05,
+	0xaf, 0xca, 0x37, 0xf1, 0xff, 0x98, 0x71, 0x7a, 0x78, 0x0b, 0xbd, 0xdd, 0x23, 0xef, 0x05, 0xfd,
+	0x40, 0xe8, 0x0c, 0xad, 0xfb, 0x43, 0xf8, 0xa0, 0x15, 0x7e, 0xb1, 0x59, 0x8e, 0x9f, 0x77, 0xc2,
+	0x7f, 0x73, 0xe2, 0xf4, 0x89, 0xbf, 0xfc, 0x4d, 0x2c, 0x24, 0x03, 0x2e, 0x84, 0x91, 0xd6, 0x86,
+	0xf7, 0xbb, 0x57, 0x6c, 0x4b, 0x3a, 0x22, 0x43, 0xa9, 0x01, 0x85, 0xd2, 0x45, 0xf8, 0xa0, 0x85,
+	0xf6, 0x35, 0x7d, 0x46, 0x06, 0xee, 0x2a, 0x73, 0x8b, 0x5a, 0x86, 0xfd, 0x16, 0xea, 0xbb, 0xab,
+	0xcf, 0x8b, 0x5a, 0xd2, 0x73, 0xf2, 0x88, 0xc3, 0x65, 0xb6, 0xfb, 0x98, 0x41, 0x3b, 0xd4, 0xe1,
+	0x66, 0x39, 0xa6, 0xdd, 0x50, 0x77, 0xc0, 0x38, 0x25, 0x1c, 0x2e, 0xbf, 0x6c, 0xff, 0xec, 0x8c,
+	0x0c, 0xd1, 0x08, 0x69, 0xbc, 0xdb, 0xf0, 0x28, 0x38, 0x7e, 0x7c, 0x32, 0x4a, 0x7c, 0x9e, 0x3e,
+	0x90, 0x64, 0x97, 0x42, 0x33, 0x4d, 0x3e, 0x79, 0x52, 0xba, 0xe7, 0x5e, 0x64, 0xdf, 0x57, 0x51,
+	0x70, 0xb3, 0x8a, 0x82, 0x9f, 0xab, 0x28, 0xb8, 0x5e, 0x47, 0xbd, 0x9b, 0x75, 0xd4, 0xfb, 0xb1,
+	0x8e, 0x7a, 0x5f, 0xdf, 0x15, 0xca, 0xcd, 0xe6, 0x79, 0x02, 0x58, 0x31, 0x40, 0x5b, 0xa1, 0x65,
+	0x2a, 0x87, 0x49, 0x81, 0xac, 0x39, 0x65, 0x15, 0x8a, 0x79, 0x29, 0xad, 0x5f, 0x33, 0xcb, 0x4e,
+	0xce, 0x27, 0xb7, 0x9b, 0x32, 0xd9, 0x6f, 0x98, 0x7f, 0x9e, 0xcd, 0xfb, 0x6d, 0xf4, 0xa7, 0xbf,
+	0x06, 0x00, 0x8f, 0x4a, 0x62, 0x4e, 0x96, 0x02, 0x00, 0x00,
 }
 
 func (m *Metadata) Marshal() (dAtA []byte, err error) {
@@ -170,6 +195,18 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Ordering != 0 {
+		i = encodeVarintMetadata(dAtA, i, uint64(m.Ordering))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.AckVersion) > 0 {
+		i -= len(m.AckVersion)
+		copy(dAtA[i:], m.AckVersion)
+		i = encodeVarintMetadata(dAtA, i, uint64(len(m.AckVersion)))
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.TxType) > 0 {
 		i -= len(m.TxType)
 		copy(dAtA[i:], m.TxType)
@@ -256,6 +293,13 @@ func (m *Metadata) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovMetadata(uint64(l))
 	}
+	l = len(m.AckVersion)
+	if l > 0 {
+		n += 1 + l + sovMetadata(uint64(l))
+	}
+	if m.Ordering != 0 {
+		n += 1 + sovMetadata(uint64(m.Ordering))
+	}
 	return n
 }
 
@@ -486,6 +530,57 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			}
 			m.TxType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AckVersion", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AckVersion = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ordering", wireType)
+			}
+			m.Ordering = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ordering |= types.Order(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMetadata(dAtA[iNdEx:])
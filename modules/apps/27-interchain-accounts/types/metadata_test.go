@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
@@ -67,6 +68,39 @@ func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 			},
 			false,
 		},
+		{
+			"unequal acknowledgement version",
+			func() {
+				metadata.AckVersion = types.AckVersion1
+
+				versionBytes, err := types.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+				previousVersion = string(versionBytes)
+			},
+			false,
+		},
+		{
+			"success with explicit ORDERED channel",
+			func() {
+				metadata.Ordering = channeltypes.ORDERED
+
+				versionBytes, err := types.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+				previousVersion = string(versionBytes)
+			},
+			true,
+		},
+		{
+			"unequal channel ordering",
+			func() {
+				metadata.Ordering = channeltypes.UNORDERED
+
+				versionBytes, err := types.ModuleCdc.MarshalJSON(&metadata)
+				suite.Require().NoError(err)
+				previousVersion = string(versionBytes)
+			},
+			false,
+		},
 		{
 			"unequal controller connection",
 			func() {
@@ -126,6 +160,71 @@ func (suite *TypesTestSuite) TestIsPreviousMetadataEqual() {
 	}
 }
 
+func (suite *TypesTestSuite) TestValidateUpgradeMetadata() {
+	var (
+		metadata        types.Metadata
+		previousVersion string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"success with changed encoding and ordering",
+			func() {
+				metadata.Encoding = types.EncodingProto3JSON
+				metadata.Ordering = channeltypes.UNORDERED
+			},
+			true,
+		},
+		{
+			"cannot decode previous version",
+			func() {
+				previousVersion = "invalid previous version"
+			},
+			false,
+		},
+		{
+			"interchain account address changed",
+			func() {
+				metadata.Address = ""
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			previousMetadata := types.NewMetadata(types.Version, ibctesting.FirstConnectionID, ibctesting.FirstConnectionID, TestOwnerAddress, types.EncodingProtobuf, types.TxTypeSDKMultiMsg)
+			versionBytes, err := types.ModuleCdc.MarshalJSON(&previousMetadata)
+			suite.Require().NoError(err)
+
+			previousVersion = string(versionBytes)
+			metadata = previousMetadata
+
+			tc.malleate() // malleate mutates test data
+
+			err = types.ValidateUpgradeMetadata(previousVersion, metadata)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 
 	var metadata types.Metadata
@@ -154,6 +253,20 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3 JSON encoding",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProto3JSON,
+					TxType:                 types.TxTypeSDKMultiMsg,
+				}
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -182,6 +295,66 @@ func (suite *TypesTestSuite) TestValidateControllerMetadata() {
 			},
 			false,
 		},
+		{
+			"success with acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             types.AckVersion1,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             "invalid-ack-version",
+				}
+			},
+			false,
+		},
+		{
+			"success with unordered channel",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					Ordering:               channeltypes.UNORDERED,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported channel ordering",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					Ordering:               channeltypes.Order(10),
+				}
+			},
+			false,
+		},
 		{
 			"invalid controller connection",
 			func() {
@@ -296,6 +469,20 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			true,
 		},
+		{
+			"success with proto3 JSON encoding",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProto3JSON,
+					TxType:                 types.TxTypeSDKMultiMsg,
+				}
+			},
+			true,
+		},
 		{
 			"unsupported encoding format",
 			func() {
@@ -324,6 +511,66 @@ func (suite *TypesTestSuite) TestValidateHostMetadata() {
 			},
 			false,
 		},
+		{
+			"success with acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             types.AckVersion1,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported acknowledgement version",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					AckVersion:             "invalid-ack-version",
+				}
+			},
+			false,
+		},
+		{
+			"success with unordered channel",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					Ordering:               channeltypes.UNORDERED,
+				}
+			},
+			true,
+		},
+		{
+			"unsupported channel ordering",
+			func() {
+				metadata = types.Metadata{
+					Version:                types.Version,
+					ControllerConnectionId: ibctesting.FirstConnectionID,
+					HostConnectionId:       ibctesting.FirstConnectionID,
+					Address:                TestOwnerAddress,
+					Encoding:               types.EncodingProtobuf,
+					TxType:                 types.TxTypeSDKMultiMsg,
+					Ordering:               channeltypes.Order(10),
+				}
+			},
+			false,
+		},
 		{
 			"invalid controller connection",
 			func() {
@@ -0,0 +1,385 @@
+package fee_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var timeoutHeight = clienttypes.NewHeight(0, 100)
+
+// payPacketFee escrows the default fees for the next packet sent from chainA using the
+// provided refund account.
+func (suite *FeeTestSuite) payPacketFee(refundAcc sdk.AccAddress) types.Fee {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	msg := types.NewMsgPayPacketFee(fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, refundAcc.String())
+
+	_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	return fee
+}
+
+// sendPacket sends a mock packet with the provided data from chainA to chainB.
+func (suite *FeeTestSuite) sendPacket(data []byte, timeoutHeight clienttypes.Height) channeltypes.Packet {
+	sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+	suite.Require().True(found)
+
+	packet := channeltypes.NewPacket(
+		data, sequence,
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		timeoutHeight, 0,
+	)
+	suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+
+	return packet
+}
+
+// balance returns the bond denomination balance of the address on chainA.
+func (suite *FeeTestSuite) balance(addr sdk.AccAddress) sdk.Coin {
+	return suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), addr, sdk.DefaultBondDenom)
+}
+
+func (suite *FeeTestSuite) TestFeeEnabledHandshake() {
+	var (
+		versionA, versionB string
+		expFeeEnabled      bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"fee version on both ends", func() {},
+		},
+		{
+			"fee version only on the initializing end", func() {
+				versionB = ibcmock.Version
+			},
+		},
+		{
+			"no fee version", func() {
+				versionA = ibcmock.Version
+				versionB = ibcmock.Version
+				expFeeEnabled = false
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.SetupConnections(suite.path)
+
+			versionA = suite.path.EndpointA.ChannelConfig.Version
+			versionB = suite.path.EndpointB.ChannelConfig.Version
+			expFeeEnabled = true
+
+			tc.malleate()
+
+			suite.path.EndpointA.ChannelConfig.Version = versionA
+			suite.path.EndpointB.ChannelConfig.Version = versionB
+
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenInit())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenAck())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenConfirm())
+
+			isFeeEnabledA := suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			isFeeEnabledB := suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+			suite.Require().Equal(expFeeEnabled, isFeeEnabledA)
+			suite.Require().Equal(expFeeEnabled, isFeeEnabledB)
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestFeeEnabledUpgrade() {
+	feeVersion := suite.path.EndpointA.ChannelConfig.Version
+
+	testCases := []struct {
+		name           string
+		channelVersion string
+		upgradeVersion string
+		expFeeEnabled  bool
+	}{
+		{"fees are disabled by the upgrade", feeVersion, ibcmock.Version, false},
+		{"fees are enabled by the upgrade", ibcmock.Version, feeVersion, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			suite.path.EndpointA.ChannelConfig.Version = tc.channelVersion
+			suite.path.EndpointB.ChannelConfig.Version = tc.channelVersion
+			suite.coordinator.Setup(suite.path)
+
+			upgradeTimeout := channeltypes.NewUpgradeTimeout(clienttypes.NewHeight(0, 10000), 0)
+			suite.Require().NoError(suite.path.EndpointA.ChanUpgradeInit(channeltypes.UNORDERED, tc.upgradeVersion, upgradeTimeout))
+			suite.Require().NoError(suite.path.EndpointB.ChanUpgradeTry())
+			suite.Require().NoError(suite.path.EndpointA.ChanUpgradeAck())
+			suite.Require().NoError(suite.path.EndpointB.ChanUpgradeConfirm())
+
+			isFeeEnabledA := suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			isFeeEnabledB := suite.chainB.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+			suite.Require().Equal(tc.expFeeEnabled, isFeeEnabledA)
+			suite.Require().Equal(tc.expFeeEnabled, isFeeEnabledB)
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestOnChanOpenInit() {
+	var (
+		version       string
+		expFeeEnabled bool
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: fee version", func() {}, true,
+		},
+		{
+			"success: version is passed to the underlying application", func() {
+				version = ibcmock.Version
+				expFeeEnabled = false
+			}, true,
+		},
+		{
+			"invalid fee version", func() {
+				metadata := types.Metadata{FeeVersion: "ics29-2", AppVersion: ibcmock.Version}
+				version = string(types.ModuleCdc.MustMarshalJSON(&metadata))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.SetupConnections(suite.path)
+
+			version = suite.path.EndpointA.ChannelConfig.Version
+			expFeeEnabled = true
+
+			tc.malleate()
+
+			portID := suite.path.EndpointA.ChannelConfig.PortID
+			channelID := ibctesting.FirstChannelID
+			counterparty := channeltypes.NewCounterparty(suite.path.EndpointB.ChannelConfig.PortID, "")
+
+			chanCap, err := suite.chainA.App.GetScopedIBCKeeper().NewCapability(suite.chainA.GetContext(), host.ChannelCapabilityPath(portID, channelID))
+			suite.Require().NoError(err)
+
+			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), portID)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			err = cbs.OnChanOpenInit(suite.chainA.GetContext(), channeltypes.UNORDERED, []string{suite.path.EndpointA.ConnectionID}, portID, channelID, chanCap, counterparty, version)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expFeeEnabled, suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(suite.chainA.GetContext(), portID, channelID))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestOnChanOpenAck() {
+	var counterpartyVersion string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"invalid counterparty fee version", func() {
+				metadata := types.Metadata{FeeVersion: "ics29-2", AppVersion: ibcmock.Version}
+				counterpartyVersion = string(types.ModuleCdc.MustMarshalJSON(&metadata))
+			}, false,
+		},
+		{
+			"counterparty version is not a fee version", func() {
+				counterpartyVersion = ibcmock.Version
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.SetupConnections(suite.path)
+
+			suite.Require().NoError(suite.path.EndpointA.ChanOpenInit())
+			suite.Require().NoError(suite.path.EndpointB.ChanOpenTry())
+
+			counterpartyVersion = suite.path.EndpointB.ChannelConfig.Version
+
+			tc.malleate()
+
+			module, _, err := suite.chainA.App.GetIBCKeeper().PortKeeper.LookupModuleByPort(suite.chainA.GetContext(), ibctesting.MockFeePort)
+			suite.Require().NoError(err)
+
+			cbs, ok := suite.chainA.App.GetIBCKeeper().Router.GetRoute(module)
+			suite.Require().True(ok)
+
+			err = cbs.OnChanOpenAck(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.path.EndpointB.ChannelID, counterpartyVersion)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestFeeDistributionOnAcknowledgement() {
+	testCases := []struct {
+		name       string
+		packetData []byte
+	}{
+		{"successful acknowledgement", ibcmock.MockPacketData},
+		{"error acknowledgement", ibcmock.MockFailPacketData},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.coordinator.Setup(suite.path)
+
+			refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			forwardPayee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+			reversePayee := suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+
+			// the forward relayer registers the address to be paid on chainA
+			_, err := suite.chainB.SendMsgs(types.NewMsgRegisterCounterpartyPayee(
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+				suite.chainB.SenderAccount.GetAddress().String(), forwardPayee.String(),
+			))
+			suite.Require().NoError(err)
+
+			// the reverse relayer registers the address to be paid on chainA
+			_, err = suite.chainA.SendMsgs(types.NewMsgRegisterPayee(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(), reversePayee.String(),
+			))
+			suite.Require().NoError(err)
+
+			refundBalance := suite.balance(refundAcc)
+			forwardBalance := suite.balance(forwardPayee)
+			reverseBalance := suite.balance(reversePayee)
+
+			fee := suite.payPacketFee(refundAcc)
+			suite.Require().Equal(refundBalance.Sub(sdk.NewCoin(sdk.DefaultBondDenom, fee.Total().AmountOf(sdk.DefaultBondDenom))), suite.balance(refundAcc))
+
+			packet := suite.sendPacket(tc.packetData, timeoutHeight)
+			suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(packet.Sequence)))
+
+			suite.Require().NoError(suite.path.RelayPacket(packet))
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(packet.Sequence)))
+			suite.Require().Equal(forwardBalance.Add(defaultRecvFee[0]), suite.balance(forwardPayee))
+			suite.Require().Equal(reverseBalance.Add(defaultAckFee[0]), suite.balance(reversePayee))
+			suite.Require().Equal(refundBalance.Sub(defaultRecvFee[0]).Sub(defaultAckFee[0]), suite.balance(refundAcc))
+		})
+	}
+}
+
+func (suite *FeeTestSuite) TestFeeDistributionOnTimeout() {
+	suite.coordinator.Setup(suite.path)
+
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	timeoutRelayer := suite.chainA.SenderAccount.GetAddress()
+
+	refundBalance := suite.balance(refundAcc)
+
+	suite.payPacketFee(refundAcc)
+
+	// the timeout relayer also pays for the transactions in its own bond denomination balance
+	// so its balance is only inspected after the packet is sent
+	packet := suite.sendPacket(ibcmock.MockPacketData, clienttypes.GetSelfHeight(suite.chainB.GetContext()))
+	timeoutBalance := suite.balance(timeoutRelayer)
+
+	suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(packet.Sequence)))
+	suite.Require().Equal(timeoutBalance.Add(defaultTimeoutFee[0]), suite.balance(timeoutRelayer))
+	suite.Require().Equal(refundBalance.Sub(defaultTimeoutFee[0]), suite.balance(refundAcc))
+}
+
+func (suite *FeeTestSuite) TestAsyncAcknowledgement() {
+	suite.coordinator.Setup(suite.path)
+
+	forwardPayee := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+	_, err := suite.chainB.SendMsgs(types.NewMsgRegisterCounterpartyPayee(
+		suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+		suite.chainB.SenderAccount.GetAddress().String(), forwardPayee.String(),
+	))
+	suite.Require().NoError(err)
+
+	packet := suite.sendPacket(ibcmock.MockAsyncPacketData, timeoutHeight)
+
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+	suite.Require().NoError(suite.path.EndpointB.RecvPacket(packet))
+
+	packetID := channeltypes.NewPacketId(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	relayer, found := suite.chainB.GetSimApp().IBCFeeKeeper.GetRelayerAddressForAsyncAck(suite.chainB.GetContext(), packetID)
+	suite.Require().True(found)
+	suite.Require().Equal(suite.chainB.SenderAccount.GetAddress().String(), relayer)
+
+	ctx := suite.chainB.GetContext()
+	chanCap := suite.chainB.GetChannelCapability(packet.GetDestPort(), packet.GetDestChannel())
+	err = suite.chainB.GetSimApp().IBCFeeKeeper.WriteAcknowledgement(ctx, chanCap, packet, ibcmock.MockAcknowledgement)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainB.GetSimApp().IBCFeeKeeper.GetRelayerAddressForAsyncAck(ctx, packetID)
+	suite.Require().False(found)
+
+	expAck := types.NewIncentivizedAcknowledgement(forwardPayee.String(), ibcmock.MockAcknowledgement.Acknowledgement(), true)
+	commitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), commitment)
+}
+
+func (suite *FeeTestSuite) TestRefundFeesOnChannelClose() {
+	suite.coordinator.Setup(suite.path)
+
+	refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+	refundBalance := suite.balance(refundAcc)
+
+	suite.payPacketFee(refundAcc)
+	packet := suite.sendPacket(ibcmock.MockPacketData, timeoutHeight)
+
+	suite.Require().NoError(suite.path.EndpointA.ChanCloseInit())
+
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(packet.Sequence)))
+	suite.Require().Equal(refundBalance, suite.balance(refundAcc))
+}
@@ -0,0 +1,231 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+// escrowFees escrows the default fees for the packet with the provided sequence on chainA
+// and returns the escrowed packet fees.
+func (suite *KeeperTestSuite) escrowFees(sequence uint64, refundAcc sdk.AccAddress) []types.PacketFee {
+	ctx := suite.chainA.GetContext()
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(ctx, refundAcc, types.ModuleName, fee.Total()))
+
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, refundAcc.String())}
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, suite.packetID(sequence), types.NewPacketFees(packetFees))
+
+	return packetFees
+}
+
+// balance returns the bond denomination balance of the address on chainA.
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdk.Coin {
+	return suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), addr, sdk.DefaultBondDenom)
+}
+
+func (suite *KeeperTestSuite) TestDistributeFeesOnAcknowledgement() {
+	var (
+		forwardRelayer string
+		packetFees     []types.PacketFee
+		expRecvFeePaid bool
+
+		refundAcc, forwardAcc, reverseAcc sdk.AccAddress
+	)
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expLocked bool
+	}{
+		{
+			"success", func() {}, false,
+		},
+		{
+			"recv fee is refunded for an empty forward relayer", func() {
+				forwardRelayer = ""
+				expRecvFeePaid = false
+			}, false,
+		},
+		{
+			"recv fee is refunded for a blocked forward relayer", func() {
+				forwardRelayer = authtypes.NewModuleAddress(types.ModuleName).String()
+				expRecvFeePaid = false
+			}, false,
+		},
+		{
+			"escrow account has insufficient balance", func() {
+				fee := types.NewFee(defaultRecvFee.Add(defaultRecvFee...), defaultAckFee, defaultTimeoutFee)
+				packetFees = append(packetFees, types.NewPacketFee(fee, refundAcc.String()))
+			}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAcc = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			forwardAcc = suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+			reverseAcc = suite.chainA.SenderAccounts[3].SenderAccount.GetAddress()
+
+			refundBalance := suite.balance(refundAcc)
+			forwardBalance := suite.balance(forwardAcc)
+			reverseBalance := suite.balance(reverseAcc)
+
+			forwardRelayer = forwardAcc.String()
+			packetFees = suite.escrowFees(1, refundAcc)
+			expRecvFeePaid = true
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnAcknowledgement(suite.chainA.GetContext(), forwardRelayer, reverseAcc, packetFees, suite.packetID(1))
+
+			if tc.expLocked {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(1)))
+				suite.Require().Equal(forwardBalance, suite.balance(forwardAcc))
+				suite.Require().Equal(reverseBalance, suite.balance(reverseAcc))
+				return
+			}
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(1)))
+			suite.Require().Equal(reverseBalance.Add(defaultAckFee[0]), suite.balance(reverseAcc))
+
+			if expRecvFeePaid {
+				suite.Require().Equal(forwardBalance.Add(defaultRecvFee[0]), suite.balance(forwardAcc))
+				suite.Require().Equal(refundBalance.Sub(defaultRecvFee[0]).Sub(defaultAckFee[0]), suite.balance(refundAcc))
+			} else {
+				suite.Require().Equal(forwardBalance, suite.balance(forwardAcc))
+				suite.Require().Equal(refundBalance.Sub(defaultAckFee[0]), suite.balance(refundAcc))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestDistributeFeesOnTimeout() {
+	var packetFees []types.PacketFee
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expLocked bool
+	}{
+		{
+			"success", func() {}, false,
+		},
+		{
+			"escrow account has insufficient balance", func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee.Add(defaultTimeoutFee...))
+				packetFees = []types.PacketFee{types.NewPacketFee(fee, packetFees[0].RefundAddress)}
+			}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			timeoutAcc := suite.chainA.SenderAccounts[2].SenderAccount.GetAddress()
+
+			refundBalance := suite.balance(refundAcc)
+			timeoutBalance := suite.balance(timeoutAcc)
+
+			packetFees = suite.escrowFees(1, refundAcc)
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().IBCFeeKeeper.DistributePacketFeesOnTimeout(suite.chainA.GetContext(), timeoutAcc, packetFees, suite.packetID(1))
+
+			if tc.expLocked {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(1)))
+				suite.Require().Equal(timeoutBalance, suite.balance(timeoutAcc))
+				return
+			}
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.HasFeesInEscrow(suite.chainA.GetContext(), suite.packetID(1)))
+			suite.Require().Equal(timeoutBalance.Add(defaultTimeoutFee[0]), suite.balance(timeoutAcc))
+			suite.Require().Equal(refundBalance.Sub(defaultTimeoutFee[0]), suite.balance(refundAcc))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundFeesOnChannelClosure() {
+	var expEscrowed []channeltypes.PacketId
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expLocked bool
+	}{
+		{
+			"success", func() {}, false,
+		},
+		{
+			"fees with an invalid refund address are left in escrow", func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, "invalid")})
+
+				err := suite.chainA.GetSimApp().BankKeeper.SendCoinsFromAccountToModule(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), types.ModuleName, fee.Total())
+				suite.Require().NoError(err)
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), suite.packetID(4), packetFees)
+
+				expEscrowed = []channeltypes.PacketId{suite.packetID(4)}
+			}, false,
+		},
+		{
+			"escrow account has insufficient balance", func() {
+				fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+				packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())})
+
+				// the fees are stored but never sent to the escrow account
+				suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), suite.packetID(4), packetFees)
+			}, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			refundAcc := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress()
+			refundBalance := suite.balance(refundAcc)
+
+			for seq := uint64(1); seq <= 3; seq++ {
+				suite.escrowFees(seq, refundAcc)
+			}
+
+			expEscrowed = nil
+
+			tc.malleate()
+
+			err := suite.chainA.GetSimApp().IBCFeeKeeper.RefundFeesOnChannelClosure(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			identifiedFees := suite.chainA.GetSimApp().IBCFeeKeeper.GetIdentifiedPacketFeesForChannel(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			if tc.expLocked {
+				suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+				suite.Require().Len(identifiedFees, 4)
+				return
+			}
+
+			suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+			suite.Require().Equal(refundBalance, suite.balance(refundAcc))
+			suite.Require().Len(identifiedFees, len(expEscrowed))
+			for i, packetID := range expEscrowed {
+				suite.Require().Equal(packetID, identifiedFees[i].PacketId)
+			}
+		})
+	}
+}
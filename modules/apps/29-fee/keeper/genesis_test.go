@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	suite.SetupTest()
+
+	ctx := suite.chainA.GetContext()
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	counterpartyPayee := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, relayer)}
+
+	genesisState := types.NewGenesisState(
+		[]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(suite.packetID(1), packetFees)},
+		[]types.FeeEnabledChannel{{PortId: ibctesting.MockPort, ChannelId: "channel-5"}},
+		[]types.RegisteredPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: relayer, Payee: payee}},
+		[]types.RegisteredCounterpartyPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: relayer, CounterpartyPayee: counterpartyPayee}},
+		[]types.ForwardRelayerAddress{{Address: relayer, PacketId: suite.packetID(2)}},
+	)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.InitGenesis(ctx, *genesisState)
+
+	storedFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(ctx, suite.packetID(1))
+	suite.Require().True(found)
+	suite.Require().Equal(types.NewPacketFees(packetFees), storedFees)
+
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsFeeEnabled(ctx, ibctesting.MockPort, "channel-5"))
+
+	storedPayee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(ctx, relayer, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(payee, storedPayee)
+
+	storedCounterpartyPayee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(ctx, relayer, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(counterpartyPayee, storedCounterpartyPayee)
+
+	storedRelayer, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetRelayerAddressForAsyncAck(ctx, suite.packetID(2))
+	suite.Require().True(found)
+	suite.Require().Equal(relayer, storedRelayer)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	suite.SetupTest()
+
+	ctx := suite.chainA.GetContext()
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	counterpartyPayee := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := []types.PacketFee{types.NewPacketFee(fee, relayer)}
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(ctx, suite.packetID(1), types.NewPacketFees(packetFees))
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(ctx, relayer, payee, ibctesting.FirstChannelID)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(ctx, relayer, counterpartyPayee, ibctesting.FirstChannelID)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetRelayerAddressForAsyncAck(ctx, suite.packetID(2), relayer)
+
+	genesisState := suite.chainA.GetSimApp().IBCFeeKeeper.ExportGenesis(ctx)
+
+	suite.Require().Equal([]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(suite.packetID(1), packetFees)}, genesisState.IdentifiedFees)
+	suite.Require().Equal([]types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}}, genesisState.FeeEnabledChannels)
+	suite.Require().Equal([]types.RegisteredPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: relayer, Payee: payee}}, genesisState.RegisteredPayees)
+	suite.Require().Equal([]types.RegisteredCounterpartyPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: relayer, CounterpartyPayee: counterpartyPayee}}, genesisState.RegisteredCounterpartyPayees)
+	suite.Require().Equal([]types.ForwardRelayerAddress{{Address: relayer, PacketId: suite.packetID(2)}}, genesisState.ForwardRelayers)
+}
@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+// setFeesInEscrow stores two packet fees for each of the provided sequences on the channel of chainA.
+func (suite *KeeperTestSuite) setFeesInEscrow(sequences ...uint64) []types.IdentifiedPacketFees {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())
+	packetFees := []types.PacketFee{packetFee, packetFee}
+
+	var identifiedFees []types.IdentifiedPacketFees
+	for _, seq := range sequences {
+		suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), suite.packetID(seq), types.NewPacketFees(packetFees))
+		identifiedFees = append(identifiedFees, types.NewIdentifiedPacketFees(suite.packetID(seq), packetFees))
+	}
+
+	return identifiedFees
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPackets() {
+	expPackets := suite.setFeesInEscrow(1, 2, 3)
+
+	res, err := suite.queryClient.IncentivizedPackets(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryIncentivizedPacketsRequest{
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expPackets[:2], res.IncentivizedPackets)
+	suite.Require().Equal(uint64(3), res.Pagination.Total)
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacket() {
+	var req *types.QueryIncentivizedPacketRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"fees not found for packet id", func() {
+				req.PacketId = suite.packetID(100)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest()
+
+			expPackets := suite.setFeesInEscrow(1)
+			req = &types.QueryIncentivizedPacketRequest{PacketId: suite.packetID(1)}
+
+			tc.malleate()
+
+			res, err := suite.queryClient.IncentivizedPacket(sdk.WrapSDKContext(suite.chainA.GetContext()), req)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expPackets[0], res.IncentivizedPacket)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryIncentivizedPacketsForChannel() {
+	expPackets := suite.setFeesInEscrow(1, 2)
+
+	// fees escrowed on another channel are not returned
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())})
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetFeesInEscrow(suite.chainA.GetContext(), channeltypes.NewPacketId(ibctesting.MockFeePort, "channel-10", 1), packetFees)
+
+	res, err := suite.queryClient.IncentivizedPacketsForChannel(sdk.WrapSDKContext(suite.chainA.GetContext()), &types.QueryIncentivizedPacketsForChannelRequest{
+		PortId:    suite.path.EndpointA.ChannelConfig.PortID,
+		ChannelId: suite.path.EndpointA.ChannelID,
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expPackets, res.IncentivizedPackets)
+}
+
+func (suite *KeeperTestSuite) TestQueryTotalFees() {
+	suite.setFeesInEscrow(1)
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	recvRes, err := suite.queryClient.TotalRecvFees(ctx, &types.QueryTotalRecvFeesRequest{PacketId: suite.packetID(1)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultRecvFee.Add(defaultRecvFee...), recvRes.RecvFees)
+
+	ackRes, err := suite.queryClient.TotalAckFees(ctx, &types.QueryTotalAckFeesRequest{PacketId: suite.packetID(1)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultAckFee.Add(defaultAckFee...), ackRes.AckFees)
+
+	timeoutRes, err := suite.queryClient.TotalTimeoutFees(ctx, &types.QueryTotalTimeoutFeesRequest{PacketId: suite.packetID(1)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(defaultTimeoutFee.Add(defaultTimeoutFee...), timeoutRes.TimeoutFees)
+
+	_, err = suite.queryClient.TotalRecvFees(ctx, &types.QueryTotalRecvFeesRequest{PacketId: suite.packetID(2)})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestQueryPayees() {
+	ctx := suite.chainA.GetContext()
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	counterpartyPayee := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	_, err := suite.queryClient.Payee(sdk.WrapSDKContext(ctx), &types.QueryPayeeRequest{ChannelId: ibctesting.FirstChannelID, Relayer: relayer})
+	suite.Require().Error(err)
+
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetPayeeAddress(ctx, relayer, payee, ibctesting.FirstChannelID)
+	suite.chainA.GetSimApp().IBCFeeKeeper.SetCounterpartyPayeeAddress(ctx, relayer, counterpartyPayee, ibctesting.FirstChannelID)
+
+	payeeRes, err := suite.queryClient.Payee(sdk.WrapSDKContext(ctx), &types.QueryPayeeRequest{ChannelId: ibctesting.FirstChannelID, Relayer: relayer})
+	suite.Require().NoError(err)
+	suite.Require().Equal(payee, payeeRes.PayeeAddress)
+
+	counterpartyRes, err := suite.queryClient.CounterpartyPayee(sdk.WrapSDKContext(ctx), &types.QueryCounterpartyPayeeRequest{ChannelId: ibctesting.FirstChannelID, Relayer: relayer})
+	suite.Require().NoError(err)
+	suite.Require().Equal(counterpartyPayee, counterpartyRes.CounterpartyPayee)
+}
+
+func (suite *KeeperTestSuite) TestQueryFeeEnabledChannels() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+	res, err := suite.queryClient.FeeEnabledChannels(ctx, &types.QueryFeeEnabledChannelsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}}, res.FeeEnabledChannels)
+
+	channelRes, err := suite.queryClient.FeeEnabledChannel(ctx, &types.QueryFeeEnabledChannelRequest{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID})
+	suite.Require().NoError(err)
+	suite.Require().True(channelRes.FeeEnabled)
+
+	channelRes, err = suite.queryClient.FeeEnabledChannel(ctx, &types.QueryFeeEnabledChannelRequest{PortId: ibctesting.MockPort, ChannelId: ibctesting.FirstChannelID})
+	suite.Require().NoError(err)
+	suite.Require().False(channelRes.FeeEnabled)
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(300)}}
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	metadata := types.NewMetadata(ibcmock.Version)
+	feeVersion := string(types.ModuleCdc.MustMarshalJSON(&metadata))
+
+	suite.path = ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.path.EndpointA.ChannelConfig.PortID = ibctesting.MockFeePort
+	suite.path.EndpointB.ChannelConfig.PortID = ibctesting.MockFeePort
+	suite.path.EndpointA.ChannelConfig.Version = feeVersion
+	suite.path.EndpointB.ChannelConfig.Version = feeVersion
+	suite.coordinator.Setup(suite.path)
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().IBCFeeKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// packetID returns the identifier of the packet with the provided sequence on the channel of chainA.
+func (suite *KeeperTestSuite) packetID(sequence uint64) channeltypes.PacketId {
+	return channeltypes.NewPacketId(suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sequence)
+}
+
+// lockFeeModule sets the locked flag of the fee module on the provided chain.
+func lockFeeModule(chain *ibctesting.TestChain) {
+	store := chain.GetContext().KVStore(chain.GetSimApp().GetKey(types.StoreKey))
+	store.Set([]byte(types.KeyLocked), []byte{1})
+}
+
+func (suite *KeeperTestSuite) TestFeeEnabled() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	suite.Require().True(keeper.IsFeeEnabled(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID))
+	suite.Require().False(keeper.IsFeeEnabled(ctx, ibctesting.MockPort, ibctesting.FirstChannelID))
+
+	keeper.SetFeeEnabled(ctx, ibctesting.MockPort, "channel-5")
+	suite.Require().True(keeper.IsFeeEnabled(ctx, ibctesting.MockPort, "channel-5"))
+
+	expChannels := []types.FeeEnabledChannel{
+		{PortId: ibctesting.MockPort, ChannelId: "channel-5"},
+		{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID},
+	}
+	suite.Require().Equal(expChannels, keeper.GetAllFeeEnabledChannels(ctx))
+
+	keeper.DeleteFeeEnabled(ctx, ibctesting.MockPort, "channel-5")
+	suite.Require().False(keeper.IsFeeEnabled(ctx, ibctesting.MockPort, "channel-5"))
+}
+
+func (suite *KeeperTestSuite) TestIsLocked() {
+	suite.Require().False(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+
+	lockFeeModule(suite.chainA)
+	suite.Require().True(suite.chainA.GetSimApp().IBCFeeKeeper.IsLocked(suite.chainA.GetContext()))
+}
+
+func (suite *KeeperTestSuite) TestFeesInEscrow() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	packetFees := types.NewPacketFees([]types.PacketFee{types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())})
+
+	for seq := uint64(1); seq <= 3; seq++ {
+		keeper.SetFeesInEscrow(ctx, suite.packetID(seq), packetFees)
+	}
+
+	// fees on another channel are not included in the channel lookup
+	keeper.SetFeesInEscrow(ctx, channeltypes.NewPacketId(ibctesting.MockFeePort, "channel-10", 1), packetFees)
+
+	storedFees, found := keeper.GetFeesInEscrow(ctx, suite.packetID(1))
+	suite.Require().True(found)
+	suite.Require().Equal(packetFees, storedFees)
+
+	identifiedFees := keeper.GetIdentifiedPacketFeesForChannel(ctx, ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	suite.Require().Len(identifiedFees, 3)
+	for i, identifiedFee := range identifiedFees {
+		suite.Require().Equal(suite.packetID(uint64(i+1)), identifiedFee.PacketId)
+		suite.Require().Equal(packetFees.PacketFees, identifiedFee.PacketFees)
+	}
+
+	suite.Require().Len(keeper.GetAllIdentifiedPacketFees(ctx), 4)
+
+	keeper.DeleteFeesInEscrow(ctx, suite.packetID(1))
+	suite.Require().False(keeper.HasFeesInEscrow(ctx, suite.packetID(1)))
+}
+
+func (suite *KeeperTestSuite) TestPayees() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().IBCFeeKeeper
+
+	relayer := suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String()
+	payee := suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+	counterpartyPayee := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+
+	_, found := keeper.GetPayeeAddress(ctx, relayer, ibctesting.FirstChannelID)
+	suite.Require().False(found)
+
+	keeper.SetPayeeAddress(ctx, relayer, payee, ibctesting.FirstChannelID)
+	keeper.SetCounterpartyPayeeAddress(ctx, relayer, counterpartyPayee, ibctesting.FirstChannelID)
+
+	storedPayee, found := keeper.GetPayeeAddress(ctx, relayer, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(payee, storedPayee)
+
+	storedCounterpartyPayee, found := keeper.GetCounterpartyPayeeAddress(ctx, relayer, ibctesting.FirstChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(counterpartyPayee, storedCounterpartyPayee)
+
+	suite.Require().Equal([]types.RegisteredPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: relayer, Payee: payee}}, keeper.GetAllPayees(ctx))
+	suite.Require().Equal([]types.RegisteredCounterpartyPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: relayer, CounterpartyPayee: counterpartyPayee}}, keeper.GetAllCounterpartyPayees(ctx))
+}
+
+func (suite *KeeperTestSuite) TestRelayerAddressForAsyncAck() {
+	ctx := suite.chainA.GetContext()
+	keeper := suite.chainA.GetSimApp().IBCFeeKeeper
+	relayer := suite.chainA.SenderAccount.GetAddress().String()
+
+	_, found := keeper.GetRelayerAddressForAsyncAck(ctx, suite.packetID(1))
+	suite.Require().False(found)
+
+	keeper.SetRelayerAddressForAsyncAck(ctx, suite.packetID(1), relayer)
+
+	storedRelayer, found := keeper.GetRelayerAddressForAsyncAck(ctx, suite.packetID(1))
+	suite.Require().True(found)
+	suite.Require().Equal(relayer, storedRelayer)
+	suite.Require().Equal([]types.ForwardRelayerAddress{{Address: relayer, PacketId: suite.packetID(1)}}, keeper.GetAllForwardRelayerAddresses(ctx))
+
+	keeper.DeleteForwardRelayerAddress(ctx, suite.packetID(1))
+	_, found = keeper.GetRelayerAddressForAsyncAck(ctx, suite.packetID(1))
+	suite.Require().False(found)
+}
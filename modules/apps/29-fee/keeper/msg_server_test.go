@@ -0,0 +1,269 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestRegisterPayee() {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"channel does not exist", func() {
+				msg.ChannelId = "channel-100"
+			}, false,
+		},
+		{
+			"channel is not fee enabled", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			}, false,
+		},
+		{
+			"payee is a blocked address", func() {
+				msg.Payee = authtypes.NewModuleAddress(types.ModuleName).String()
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgRegisterPayee(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccounts[0].SenderAccount.GetAddress().String(),
+				suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterPayee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				payee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetPayeeAddress(suite.chainA.GetContext(), msg.Relayer, msg.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.Payee, payee)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterCounterpartyPayee() {
+	var msg *types.MsgRegisterCounterpartyPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"channel does not exist", func() {
+				msg.ChannelId = "channel-100"
+			}, false,
+		},
+		{
+			"channel is not fee enabled", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg = types.NewMsgRegisterCounterpartyPayee(
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().IBCFeeKeeper.RegisterCounterpartyPayee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				counterpartyPayee, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetCounterpartyPayeeAddress(suite.chainA.GetContext(), msg.Relayer, msg.ChannelId)
+				suite.Require().True(found)
+				suite.Require().Equal(msg.CounterpartyPayee, counterpartyPayee)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFee() {
+	var msg *types.MsgPayPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"success: fees are added to previously escrowed fees", func() {
+				_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+				suite.Require().NoError(err)
+			}, true,
+		},
+		{
+			"channel is not fee enabled", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			}, false,
+		},
+		{
+			"fee module is locked", func() {
+				lockFeeModule(suite.chainA)
+			}, false,
+		},
+		{
+			"signer is a blocked address", func() {
+				msg.Signer = authtypes.NewModuleAddress(types.ModuleName).String()
+			}, false,
+		},
+		{
+			"refund account does not exist", func() {
+				msg.Signer = sdk.AccAddress([]byte("unknown-account")).String()
+			}, false,
+		},
+		{
+			"insufficient funds", func() {
+				msg.Fee.RecvFee = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewIntWithDecimal(1, 30)))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			msg = types.NewMsgPayPacketFee(fee, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			escrowBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom)
+
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFee(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				packetFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), suite.packetID(1))
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPacketFee(fee, msg.Signer), packetFees.PacketFees[0])
+
+				expEscrowBalance := escrowBalance.Add(sdk.NewCoin(sdk.DefaultBondDenom, fee.Total().AmountOf(sdk.DefaultBondDenom)))
+				suite.Require().Equal(expEscrowBalance, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.GetSimApp().IBCFeeKeeper.GetFeeModuleAddress(), sdk.DefaultBondDenom))
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPayPacketFeeAsync() {
+	var (
+		msg    *types.MsgPayPacketFeeAsync
+		packet channeltypes.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success", func() {}, true,
+		},
+		{
+			"channel is not fee enabled", func() {
+				suite.chainA.GetSimApp().IBCFeeKeeper.DeleteFeeEnabled(suite.chainA.GetContext(), suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID)
+			}, false,
+		},
+		{
+			"fee module is locked", func() {
+				lockFeeModule(suite.chainA)
+			}, false,
+		},
+		{
+			"refund address is a blocked address", func() {
+				msg.PacketFee.RefundAddress = authtypes.NewModuleAddress(types.ModuleName).String()
+			}, false,
+		},
+		{
+			"packet has not been sent", func() {
+				msg.PacketId.Sequence = 2
+			}, false,
+		},
+		{
+			"packet has already been acknowledged", func() {
+				suite.Require().NoError(suite.path.RelayPacket(packet))
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			packet = channeltypes.NewPacket(
+				ibcmock.MockPacketData, 1,
+				suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+				suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+				clienttypes.NewHeight(0, 100), 0,
+			)
+			suite.Require().NoError(suite.path.EndpointA.SendPacket(packet))
+
+			fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+			packetFee := types.NewPacketFee(fee, suite.chainA.SenderAccount.GetAddress().String())
+			msg = types.NewMsgPayPacketFeeAsync(suite.packetID(packet.Sequence), packetFee)
+
+			tc.malleate()
+
+			_, err := suite.chainA.GetSimApp().IBCFeeKeeper.PayPacketFeeAsync(sdk.WrapSDKContext(suite.chainA.GetContext()), msg)
+			if tc.expPass {
+				suite.Require().NoError(err)
+
+				packetFees, found := suite.chainA.GetSimApp().IBCFeeKeeper.GetFeesInEscrow(suite.chainA.GetContext(), msg.PacketId)
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewPacketFees([]types.PacketFee{packetFee}), packetFees)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,79 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+)
+
+var (
+	defaultRecvFee    = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(100)}}
+	defaultAckFee     = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(200)}}
+	defaultTimeoutFee = sdk.Coins{sdk.Coin{Denom: sdk.DefaultBondDenom, Amount: sdk.NewInt(300)}}
+	invalidCoins      = sdk.Coins{sdk.Coin{Denom: "invalid-denom", Amount: sdk.NewInt(-2)}}
+
+	validAddr   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	validAddr2  = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	invalidAddr = "invalid-address"
+)
+
+func TestFeeTotal(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 600)), fee.Total())
+}
+
+func TestPacketFeeValidation(t *testing.T) {
+	var packetFee types.PacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success with empty recv fee", func() { packetFee.Fee.RecvFee = sdk.Coins{} }, true},
+		{"invalid refund address", func() { packetFee.RefundAddress = invalidAddr }, false},
+		{"invalid recv fee", func() { packetFee.Fee.RecvFee = invalidCoins }, false},
+		{"invalid ack fee", func() { packetFee.Fee.AckFee = invalidCoins }, false},
+		{"invalid timeout fee", func() { packetFee.Fee.TimeoutFee = invalidCoins }, false},
+		{
+			"all fees are zero", func() {
+				packetFee.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{})
+			}, false,
+		},
+	}
+
+	for _, tc := range testCases {
+		packetFee = types.NewPacketFee(types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee), validAddr)
+
+		tc.malleate()
+
+		err := packetFee.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMetadata(t *testing.T) {
+	metadata := types.NewMetadata("ics20-1")
+	require.Equal(t, types.Version, metadata.FeeVersion)
+	require.NoError(t, metadata.ValidateBasic())
+
+	version := string(types.ModuleCdc.MustMarshalJSON(&metadata))
+	parsed, err := types.MetadataFromVersion(version)
+	require.NoError(t, err)
+	require.Equal(t, metadata, parsed)
+
+	_, err = types.MetadataFromVersion("ics20-1")
+	require.Error(t, err)
+
+	metadata.FeeVersion = "ics29-2"
+	require.Error(t, metadata.ValidateBasic())
+}
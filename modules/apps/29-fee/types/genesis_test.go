@@ -0,0 +1,57 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	identifiedFees := []types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(validPacketID, []types.PacketFee{types.NewPacketFee(fee, validAddr)})}
+	feeEnabledChannels := []types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ibctesting.FirstChannelID}}
+	payees := []types.RegisteredPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: validAddr, Payee: validAddr2}}
+	counterpartyPayees := []types.RegisteredCounterpartyPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: validAddr, CounterpartyPayee: validAddr2}}
+	forwardRelayers := []types.ForwardRelayerAddress{{Address: validAddr, PacketId: validPacketID}}
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default genesis", types.DefaultGenesisState(), true},
+		{"valid genesis", types.NewGenesisState(identifiedFees, feeEnabledChannels, payees, counterpartyPayees, forwardRelayers), true},
+		{
+			"invalid packet id",
+			types.NewGenesisState([]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 0), identifiedFees[0].PacketFees)}, nil, nil, nil, nil),
+			false,
+		},
+		{
+			"invalid packet fee",
+			types.NewGenesisState([]types.IdentifiedPacketFees{types.NewIdentifiedPacketFees(validPacketID, []types.PacketFee{types.NewPacketFee(fee, invalidAddr)})}, nil, nil, nil, nil),
+			false,
+		},
+		{"invalid fee enabled port", types.NewGenesisState(nil, []types.FeeEnabledChannel{{PortId: "", ChannelId: ibctesting.FirstChannelID}}, nil, nil, nil), false},
+		{"invalid fee enabled channel", types.NewGenesisState(nil, []types.FeeEnabledChannel{{PortId: ibctesting.MockFeePort, ChannelId: ""}}, nil, nil, nil), false},
+		{"relayer and payee are equal", types.NewGenesisState(nil, nil, []types.RegisteredPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: validAddr, Payee: validAddr}}, nil, nil), false},
+		{"invalid payee", types.NewGenesisState(nil, nil, []types.RegisteredPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: validAddr, Payee: invalidAddr}}, nil, nil), false},
+		{"invalid payee channel", types.NewGenesisState(nil, nil, []types.RegisteredPayee{{ChannelId: "", Relayer: validAddr, Payee: validAddr2}}, nil, nil), false},
+		{"invalid counterparty payee relayer", types.NewGenesisState(nil, nil, nil, []types.RegisteredCounterpartyPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: invalidAddr, CounterpartyPayee: validAddr2}}, nil), false},
+		{"empty counterparty payee", types.NewGenesisState(nil, nil, nil, []types.RegisteredCounterpartyPayee{{ChannelId: ibctesting.FirstChannelID, Relayer: validAddr, CounterpartyPayee: ""}}, nil), false},
+		{"invalid forward relayer address", types.NewGenesisState(nil, nil, nil, nil, []types.ForwardRelayerAddress{{Address: invalidAddr, PacketId: validPacketID}}), false},
+		{"invalid forward relayer packet id", types.NewGenesisState(nil, nil, nil, nil, []types.ForwardRelayerAddress{{Address: validAddr, PacketId: channeltypes.PacketId{}}}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,148 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+const (
+	// ModuleName defines the ICS29 fee middleware module name
+	ModuleName = "feeibc"
+
+	// StoreKey is the store key string for the ICS29 fee middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the ICS29 fee middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the ICS29 fee middleware
+	QuerierRoute = ModuleName
+
+	// Version defines the current version the ICS29 fee middleware supports
+	Version = "ics29-1"
+)
+
+var (
+	// KeyLocked defines the key used to store the lock flag of the fee module
+	KeyLocked = "locked"
+
+	// FeeEnabledKeyPrefix defines the key prefix used to store fee enabled channels
+	FeeEnabledKeyPrefix = "feeEnabled"
+
+	// PayeeKeyPrefix defines the key prefix used to store payee addresses
+	PayeeKeyPrefix = "payee"
+
+	// CounterpartyPayeeKeyPrefix defines the key prefix used to store counterparty payee addresses
+	CounterpartyPayeeKeyPrefix = "counterpartyPayee"
+
+	// FeesInEscrowPrefix defines the key prefix used to store the fees escrowed for a packet
+	FeesInEscrowPrefix = "feesInEscrow"
+
+	// ForwardRelayerPrefix defines the key prefix used to store the forward relayer address of async acknowledgements
+	ForwardRelayerPrefix = "forwardRelayer"
+)
+
+// KeyFeeEnabled returns the key that stores a flag to determine if fee logic should
+// be enabled for the given port and channel identifiers.
+func KeyFeeEnabled(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", FeeEnabledKeyPrefix, portID, channelID))
+}
+
+// ParseKeyFeeEnabled parses the key used to indicate if the fee logic should be
+// enabled for the given port and channel identifiers.
+func ParseKeyFeeEnabled(key string) (portID, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 || keySplit[0] != FeeEnabledKeyPrefix {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyPayee returns the key for relayer address -> payee address mapping
+func KeyPayee(relayerAddr, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", PayeeKeyPrefix, relayerAddr, channelID))
+}
+
+// ParseKeyPayeeAddress returns the registered relayer address and channelID used to store the payee address
+func ParseKeyPayeeAddress(key string) (relayerAddr, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 || keySplit[0] != PayeeKeyPrefix {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyCounterpartyPayee returns the key for relayer address -> counterparty payee address mapping
+func KeyCounterpartyPayee(address, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", CounterpartyPayeeKeyPrefix, address, channelID))
+}
+
+// ParseKeyCounterpartyPayee returns the registered relayer address and channelID used to store the counterparty payee address
+func ParseKeyCounterpartyPayee(key string) (address string, channelID string, err error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 3 || keySplit[0] != CounterpartyPayeeKeyPrefix {
+		return "", "", sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 3, len(keySplit),
+		)
+	}
+
+	return keySplit[1], keySplit[2], nil
+}
+
+// KeyRelayerAddressForAsyncAck returns the key for packetID -> forwardAddress mapping
+func KeyRelayerAddressForAsyncAck(packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s", ForwardRelayerPrefix, packetIDPath(packetID)))
+}
+
+// ParseKeyRelayerAddressForAsyncAck parses the key used to store the forward relayer address and returns the packetID
+func ParseKeyRelayerAddressForAsyncAck(key string) (channeltypes.PacketId, error) {
+	return parsePacketIDKey(key, ForwardRelayerPrefix)
+}
+
+// KeyFeesInEscrow returns the key for escrowed fees
+func KeyFeesInEscrow(packetID channeltypes.PacketId) []byte {
+	return []byte(fmt.Sprintf("%s/%s", FeesInEscrowPrefix, packetIDPath(packetID)))
+}
+
+// ParseKeyFeesInEscrow parses the key used to store fees in escrow and returns the packet id
+func ParseKeyFeesInEscrow(key string) (channeltypes.PacketId, error) {
+	return parsePacketIDKey(key, FeesInEscrowPrefix)
+}
+
+// KeyFeesInEscrowChannelPrefix returns the key prefix for escrowed fees on the given channel
+func KeyFeesInEscrowChannelPrefix(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", FeesInEscrowPrefix, portID, channelID))
+}
+
+// packetIDPath returns the port/channel/sequence path of the packet identifier used in store keys
+func packetIDPath(packetID channeltypes.PacketId) string {
+	return fmt.Sprintf("%s/%s/%d", packetID.PortId, packetID.ChannelId, packetID.Sequence)
+}
+
+// parsePacketIDKey parses a prefix/port/channel/sequence key into a packet identifier
+func parsePacketIDKey(key, prefix string) (channeltypes.PacketId, error) {
+	keySplit := strings.Split(key, "/")
+	if len(keySplit) != 4 || keySplit[0] != prefix {
+		return channeltypes.PacketId{}, sdkerrors.Wrapf(
+			sdkerrors.ErrLogic, "key provided is incorrect: the key split has incorrect length, expected %d, got %d", 4, len(keySplit),
+		)
+	}
+
+	seq, err := strconv.ParseUint(keySplit[3], 10, 64)
+	if err != nil {
+		return channeltypes.PacketId{}, err
+	}
+
+	return channeltypes.NewPacketId(keySplit[1], keySplit[2], seq), nil
+}
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var validPacketID = channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+
+func TestKeyFeeEnabled(t *testing.T) {
+	key := types.KeyFeeEnabled(ibctesting.MockFeePort, ibctesting.FirstChannelID)
+	require.Equal(t, "feeEnabled/mockfeeibc/channel-0", string(key))
+
+	portID, channelID, err := types.ParseKeyFeeEnabled(string(key))
+	require.NoError(t, err)
+	require.Equal(t, ibctesting.MockFeePort, portID)
+	require.Equal(t, ibctesting.FirstChannelID, channelID)
+
+	_, _, err = types.ParseKeyFeeEnabled("feeEnabled/mockfeeibc")
+	require.Error(t, err)
+
+	_, _, err = types.ParseKeyFeeEnabled("payee/mockfeeibc/channel-0")
+	require.Error(t, err)
+}
+
+func TestKeyPayee(t *testing.T) {
+	key := types.KeyPayee("relayer", ibctesting.FirstChannelID)
+	require.Equal(t, "payee/relayer/channel-0", string(key))
+
+	relayer, channelID, err := types.ParseKeyPayeeAddress(string(key))
+	require.NoError(t, err)
+	require.Equal(t, "relayer", relayer)
+	require.Equal(t, ibctesting.FirstChannelID, channelID)
+
+	_, _, err = types.ParseKeyPayeeAddress("payee/relayer")
+	require.Error(t, err)
+}
+
+func TestKeyCounterpartyPayee(t *testing.T) {
+	key := types.KeyCounterpartyPayee("relayer", ibctesting.FirstChannelID)
+	require.Equal(t, "counterpartyPayee/relayer/channel-0", string(key))
+
+	relayer, channelID, err := types.ParseKeyCounterpartyPayee(string(key))
+	require.NoError(t, err)
+	require.Equal(t, "relayer", relayer)
+	require.Equal(t, ibctesting.FirstChannelID, channelID)
+}
+
+func TestKeyFeesInEscrow(t *testing.T) {
+	key := types.KeyFeesInEscrow(validPacketID)
+	require.Equal(t, "feesInEscrow/mockfeeibc/channel-0/1", string(key))
+
+	packetID, err := types.ParseKeyFeesInEscrow(string(key))
+	require.NoError(t, err)
+	require.Equal(t, validPacketID, packetID)
+
+	_, err = types.ParseKeyFeesInEscrow("feesInEscrow/mockfeeibc/channel-0/sequence")
+	require.Error(t, err)
+
+	_, err = types.ParseKeyFeesInEscrow("forwardRelayer/mockfeeibc/channel-0/1")
+	require.Error(t, err)
+
+	require.Equal(t, "feesInEscrow/mockfeeibc/channel-0/", string(types.KeyFeesInEscrowChannelPrefix(ibctesting.MockFeePort, ibctesting.FirstChannelID)))
+}
+
+func TestKeyRelayerAddressForAsyncAck(t *testing.T) {
+	key := types.KeyRelayerAddressForAsyncAck(validPacketID)
+	require.Equal(t, "forwardRelayer/mockfeeibc/channel-0/1", string(key))
+
+	packetID, err := types.ParseKeyRelayerAddressForAsyncAck(string(key))
+	require.NoError(t, err)
+	require.Equal(t, validPacketID, packetID)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// NewMetadata creates and returns a new ICS29 Metadata instance wrapping the provided application version
+func NewMetadata(appVersion string) Metadata {
+	return Metadata{
+		FeeVersion: Version,
+		AppVersion: appVersion,
+	}
+}
+
+// MetadataFromVersion attempts to parse the given string into an ICS29 Metadata instance.
+// An error is returned if the version is not a JSON encoded ICS29 metadata string.
+func MetadataFromVersion(version string) (Metadata, error) {
+	var metadata Metadata
+	if err := ModuleCdc.UnmarshalJSON([]byte(version), &metadata); err != nil {
+		return Metadata{}, sdkerrors.Wrapf(ErrInvalidVersion, "failed to unmarshal ICS29 metadata: %v", err)
+	}
+
+	return metadata, nil
+}
+
+// ValidateBasic performs a basic validation of the ICS29 Metadata fee version
+func (m Metadata) ValidateBasic() error {
+	if m.FeeVersion != Version {
+		return sdkerrors.Wrapf(ErrInvalidVersion, "expected %s, got %s", Version, m.FeeVersion)
+	}
+
+	return nil
+}
@@ -0,0 +1,147 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/29-fee/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func TestMsgRegisterPayeeValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid port", func() { msg.PortId = "" }, false},
+		{"invalid channel", func() { msg.ChannelId = "" }, false},
+		{"relayer and payee are equal", func() { msg.Payee = msg.Relayer }, false},
+		{"invalid relayer address", func() { msg.Relayer = invalidAddr }, false},
+		{"invalid payee address", func() { msg.Payee = invalidAddr }, false},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, validAddr, validAddr2)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgRegisterCounterpartyPayeeValidateBasic(t *testing.T) {
+	var msg *types.MsgRegisterCounterpartyPayee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"counterparty payee may be any non-empty string", func() { msg.CounterpartyPayee = "arbitrary-string" }, true},
+		{"invalid port", func() { msg.PortId = "" }, false},
+		{"invalid channel", func() { msg.ChannelId = "" }, false},
+		{"invalid relayer address", func() { msg.Relayer = invalidAddr }, false},
+		{"empty counterparty payee", func() { msg.CounterpartyPayee = "  " }, false},
+	}
+
+	for _, tc := range testCases {
+		msg = types.NewMsgRegisterCounterpartyPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, validAddr, validAddr2)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeValidateBasic(t *testing.T) {
+	var msg *types.MsgPayPacketFee
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid source port", func() { msg.SourcePortId = "" }, false},
+		{"invalid source channel", func() { msg.SourceChannelId = "" }, false},
+		{"invalid signer address", func() { msg.Signer = invalidAddr }, false},
+		{"invalid fee", func() { msg.Fee.AckFee = invalidCoins }, false},
+		{"all fees are zero", func() { msg.Fee = types.NewFee(sdk.Coins{}, sdk.Coins{}, sdk.Coins{}) }, false},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		msg = types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, validAddr)
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgPayPacketFeeAsyncValidateBasic(t *testing.T) {
+	var msg *types.MsgPayPacketFeeAsync
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"invalid packet port", func() { msg.PacketId.PortId = "" }, false},
+		{"invalid packet channel", func() { msg.PacketId.ChannelId = "" }, false},
+		{"zero packet sequence", func() { msg.PacketId.Sequence = 0 }, false},
+		{"invalid refund address", func() { msg.PacketFee.RefundAddress = invalidAddr }, false},
+		{"invalid fee", func() { msg.PacketFee.Fee.RecvFee = invalidCoins }, false},
+	}
+
+	for _, tc := range testCases {
+		fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+		packetID := channeltypes.NewPacketId(ibctesting.MockFeePort, ibctesting.FirstChannelID, 1)
+		msg = types.NewMsgPayPacketFeeAsync(packetID, types.NewPacketFee(fee, validAddr))
+
+		tc.malleate()
+
+		err := msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestMsgSigners(t *testing.T) {
+	fee := types.NewFee(defaultRecvFee, defaultAckFee, defaultTimeoutFee)
+	addr, err := sdk.AccAddressFromBech32(validAddr)
+	require.NoError(t, err)
+
+	expSigners := []sdk.AccAddress{addr}
+	require.Equal(t, expSigners, types.NewMsgRegisterPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, validAddr, validAddr2).GetSigners())
+	require.Equal(t, expSigners, types.NewMsgRegisterCounterpartyPayee(ibctesting.MockFeePort, ibctesting.FirstChannelID, validAddr, validAddr2).GetSigners())
+	require.Equal(t, expSigners, types.NewMsgPayPacketFee(fee, ibctesting.MockFeePort, ibctesting.FirstChannelID, validAddr).GetSigners())
+	require.Equal(t, expSigners, types.NewMsgPayPacketFeeAsync(validPacketID, types.NewPacketFee(fee, validAddr)).GetSigners())
+}
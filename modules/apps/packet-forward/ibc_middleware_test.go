@@ -0,0 +1,428 @@
+package forward_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	ratelimittypes "github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var (
+	timeoutHeight = clienttypes.NewHeight(0, 110)
+	transferCoin  = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+)
+
+type ForwardTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	// NOTE:
+	// pathAToB.EndpointA = endpoint on chainA, pathAToB.EndpointB = endpoint on chainB
+	// pathBToC.EndpointA = endpoint on chainB, pathBToC.EndpointB = endpoint on chainC
+	pathAToB *ibctesting.Path
+	pathBToC *ibctesting.Path
+}
+
+func (suite *ForwardTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.pathAToB = NewTransferPath(suite.chainA, suite.chainB)
+	suite.pathBToC = NewTransferPath(suite.chainB, suite.chainC)
+	suite.coordinator.Setup(suite.pathAToB)
+	suite.coordinator.Setup(suite.pathBToC)
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+func TestForwardTestSuite(t *testing.T) {
+	suite.Run(t, new(ForwardTestSuite))
+}
+
+// forwardMemo returns a memo forwarding the tokens received on chainB to chainC.
+func (suite *ForwardTestSuite) forwardMemo(receiver, timeout string, next json.RawMessage) string {
+	bz, err := json.Marshal(map[string]types.ForwardMetadata{
+		types.ForwardMemoKey: {
+			Receiver: receiver,
+			Port:     suite.pathBToC.EndpointA.ChannelConfig.PortID,
+			Channel:  suite.pathBToC.EndpointA.ChannelID,
+			Timeout:  timeout,
+			Next:     next,
+		},
+	})
+	suite.Require().NoError(err)
+
+	return string(bz)
+}
+
+// transferAToB sends the coin from chainA to chainB with the provided memo and receives the
+// packet on chainB. The packet sent by chainA and the result of the receive are returned.
+func (suite *ForwardTestSuite) transferAToB(coin sdk.Coin, memo string) (channeltypes.Packet, *sdk.Result) {
+	msg := transfertypes.NewMsgTransfer(
+		suite.pathAToB.EndpointA.ChannelConfig.PortID, suite.pathAToB.EndpointA.ChannelID, coin,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		timeoutHeight, 0, memo,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	suite.Require().NoError(suite.pathAToB.EndpointB.UpdateClient())
+
+	res, err = suite.pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	return packet, res
+}
+
+// relayAckToA verifies that the acknowledgement written on chainB for the packet matches the expected
+// acknowledgement and relays it to chainA.
+func (suite *ForwardTestSuite) relayAckToA(packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	commitment, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), commitment)
+
+	suite.Require().NoError(suite.pathAToB.EndpointA.UpdateClient())
+	suite.Require().NoError(suite.pathAToB.EndpointA.AcknowledgePacket(packet, ack.Acknowledgement()))
+}
+
+// requireAckPending verifies that no acknowledgement has been written on chainB for the packet and
+// that the packet is stored as in-flight.
+func (suite *ForwardTestSuite) requireAckPending(packet, forwardPacket channeltypes.Packet) {
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	inFlightPacket, found := suite.chainB.GetSimApp().ForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet, inFlightPacket.OriginalPacket)
+}
+
+// requireNotInFlight verifies that the forwarded packet is no longer stored as in-flight.
+func (suite *ForwardTestSuite) requireNotInFlight(forwardPacket channeltypes.Packet) {
+	_, found := suite.chainB.GetSimApp().ForwardKeeper.GetInFlightPacket(suite.chainB.GetContext(), forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel(), forwardPacket.GetSequence())
+	suite.Require().False(found)
+}
+
+func (suite *ForwardTestSuite) TestForwardPacket() {
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	receiverC := suite.chainC.SenderAccount.GetAddress()
+	balanceA := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, sdk.DefaultBondDenom)
+
+	next := json.RawMessage(`{"note": "for chainC"}`)
+	packet, res := suite.transferAToB(transferCoin, suite.forwardMemo(receiverC.String(), "", next))
+
+	// the tokens are forwarded from chainB to chainC by the intermediate address
+	forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	intermediateAddress := types.GetIntermediateAddress(packet.GetDestChannel(), senderA.String())
+	voucherTraceB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+
+	var forwardData transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(forwardPacket.GetData(), &forwardData))
+	suite.Require().Equal(voucherTraceB.GetFullDenomPath(), forwardData.Denom)
+	suite.Require().Equal(transferCoin.Amount.String(), forwardData.Amount)
+	suite.Require().Equal(intermediateAddress.String(), forwardData.Sender)
+	suite.Require().Equal(receiverC.String(), forwardData.Receiver)
+	suite.Require().Equal(`{"note":"for chainC"}`, forwardData.Memo)
+	suite.Require().Equal(suite.pathBToC.EndpointA.ChannelID, forwardPacket.GetSourceChannel())
+	suite.Require().True(forwardPacket.GetTimeoutHeight().IsZero())
+	suite.Require().NotZero(forwardPacket.GetTimeoutTimestamp())
+
+	// the acknowledgement is held until the forwarded packet completes
+	suite.requireAckPending(packet, forwardPacket)
+
+	// the vouchers are escrowed on chainB
+	escrowAddressB := transfertypes.GetEscrowAddress(forwardPacket.GetSourcePort(), forwardPacket.GetSourceChannel())
+	suite.Require().Equal(transferCoin.Amount, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddressB, voucherTraceB.IBCDenom()).Amount)
+	suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), intermediateAddress, voucherTraceB.IBCDenom()).IsZero())
+
+	// relay the forwarded packet to chainC and its acknowledgement back to chainB
+	suite.Require().NoError(suite.pathBToC.RelayPacket(forwardPacket))
+	suite.requireNotInFlight(forwardPacket)
+
+	voucherTraceC := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(forwardPacket.GetDestPort(), forwardPacket.GetDestChannel(), voucherTraceB.GetFullDenomPath()))
+	suite.Require().Equal(transferCoin.Amount, suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), receiverC, voucherTraceC.IBCDenom()).Amount)
+
+	// the successful acknowledgement is written on chainB and relayed to chainA
+	suite.relayAckToA(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+	suite.Require().Equal(balanceA.Sub(transferCoin), suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, sdk.DefaultBondDenom))
+}
+
+func (suite *ForwardTestSuite) TestForwardPacketFailure() {
+	var (
+		coin     sdk.Coin
+		receiver string
+		timeout  string
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		// completeForward relays the forwarded packet to chainC and the acknowledgement
+		// or timeout back to chainB
+		completeForward func(forwardPacket channeltypes.Packet)
+		expAck          channeltypes.Acknowledgement
+	}{
+		{
+			"error acknowledgement on chainC, vouchers are burned on chainB", func() {
+				receiver = "invalid address"
+			},
+			func(forwardPacket channeltypes.Packet) {
+				suite.Require().NoError(suite.pathBToC.RelayPacket(forwardPacket))
+			},
+			transfertypes.NewErrorAcknowledgement(types.ErrForwardFailed),
+		},
+		{
+			"error acknowledgement on chainC, tokens are escrowed on chainB", func() {
+				// send native tokens of chainB to chainA, these are sent back and forwarded
+				msg := transfertypes.NewMsgTransfer(
+					suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, transferCoin,
+					suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(),
+					timeoutHeight, 0, "",
+				)
+				res, err := suite.chainB.SendMsgs(msg)
+				suite.Require().NoError(err)
+
+				packet, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+				suite.Require().NoError(suite.pathAToB.RelayPacket(packet))
+
+				voucherTraceA := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+				coin = sdk.NewCoin(voucherTraceA.IBCDenom(), transferCoin.Amount)
+				receiver = "invalid address"
+			},
+			func(forwardPacket channeltypes.Packet) {
+				suite.Require().NoError(suite.pathBToC.RelayPacket(forwardPacket))
+			},
+			transfertypes.NewErrorAcknowledgement(types.ErrForwardFailed),
+		},
+		{
+			"forwarded packet timed out", func() {
+				timeout = "1s"
+			},
+			func(forwardPacket channeltypes.Packet) {
+				suite.coordinator.CommitBlock(suite.chainC)
+				suite.Require().NoError(suite.pathBToC.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.pathBToC.EndpointA.TimeoutPacket(forwardPacket))
+			},
+			transfertypes.NewErrorAcknowledgement(types.ErrForwardTimeout),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			coin = transferCoin
+			receiver = suite.chainC.SenderAccount.GetAddress().String()
+			timeout = ""
+
+			tc.malleate()
+
+			var (
+				senderA         = suite.chainA.SenderAccount.GetAddress()
+				escrowAddressB  = transfertypes.GetEscrowAddress(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID)
+				voucherTraceB   = transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+				balanceA        = suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, coin.Denom)
+				escrowBalanceB  = suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddressB, sdk.DefaultBondDenom)
+				totalEscrowB    = suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
+				voucherSupplyB  = suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherTraceB.IBCDenom())
+				intermediateAcc = types.GetIntermediateAddress(suite.pathAToB.EndpointB.ChannelID, senderA.String())
+			)
+
+			packet, res := suite.transferAToB(coin, suite.forwardMemo(receiver, timeout, nil))
+
+			forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.requireAckPending(packet, forwardPacket)
+
+			tc.completeForward(forwardPacket)
+			suite.requireNotInFlight(forwardPacket)
+
+			// the receive on chainB is reverted
+			suite.Require().Equal(escrowBalanceB, suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddressB, sdk.DefaultBondDenom))
+			suite.Require().Equal(totalEscrowB, suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom))
+			suite.Require().Equal(voucherSupplyB, suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherTraceB.IBCDenom()))
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetAllBalances(suite.chainB.GetContext(), intermediateAcc).IsZero())
+
+			// the error acknowledgement is relayed to chainA and the sender is refunded
+			suite.relayAckToA(packet, tc.expAck)
+			suite.Require().Equal(balanceA, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, coin.Denom))
+		})
+	}
+}
+
+func (suite *ForwardTestSuite) TestForwardPacketRateLimitInflow() {
+	testCases := []struct {
+		msg       string
+		receiver  func() string
+		expInflow sdk.Int
+	}{
+		{
+			"forward succeeds, inflow is kept", func() string {
+				return suite.chainC.SenderAccount.GetAddress().String()
+			},
+			transferCoin.Amount,
+		},
+		{
+			"forward fails, inflow is reverted", func() string {
+				return "invalid address"
+			},
+			sdk.ZeroInt(),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			voucherTraceB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(suite.pathAToB.EndpointB.ChannelConfig.PortID, suite.pathAToB.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			rateLimit := ratelimittypes.NewRateLimit(
+				suite.pathAToB.EndpointB.ChannelID, voucherTraceB.IBCDenom(),
+				ratelimittypes.NewQuota(0, 0, sdk.Int{}, sdk.NewInt(1000), time.Hour),
+			)
+			suite.chainB.GetSimApp().RateLimitKeeper.SetParams(suite.chainB.GetContext(), ratelimittypes.NewParams(rateLimit))
+
+			requireInflow := func(expInflow sdk.Int) {
+				channelFlow, found := suite.chainB.GetSimApp().RateLimitKeeper.GetChannelFlow(suite.chainB.GetContext(), rateLimit.ChannelId, rateLimit.Denom)
+				suite.Require().True(found)
+				suite.Require().Equal(expInflow, channelFlow.Flow.Inflow)
+			}
+
+			packet, res := suite.transferAToB(transferCoin, suite.forwardMemo(tc.receiver(), "", nil))
+
+			// the inflow is recorded on receive and the packet is pending until its acknowledgement is written
+			requireInflow(transferCoin.Amount)
+			_, found := suite.chainB.GetSimApp().RateLimitKeeper.GetPendingRecvPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().True(found)
+
+			forwardPacket, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().NoError(suite.pathBToC.RelayPacket(forwardPacket))
+			suite.requireNotInFlight(forwardPacket)
+
+			requireInflow(tc.expInflow)
+			_, found = suite.chainB.GetSimApp().RateLimitKeeper.GetPendingRecvPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *ForwardTestSuite) TestForwardPacketRejected() {
+	var memo string
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"invalid forward metadata", func() {
+				memo = `{"forward":{"receiver":"","port":"transfer","channel":"channel-0"}}`
+			},
+			types.ErrInvalidForwardMetadata,
+		},
+		{
+			"malformed forward metadata", func() {
+				memo = `{"forward":"channel-0"}`
+			},
+			types.ErrInvalidForwardMetadata,
+		},
+		{
+			"forward channel does not exist", func() {
+				memo = `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-100"}}`
+			},
+			types.ErrForwardFailed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			senderA := suite.chainA.SenderAccount.GetAddress()
+			balanceA := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, sdk.DefaultBondDenom)
+
+			packet, res := suite.transferAToB(transferCoin, memo)
+
+			// the error acknowledgement is written synchronously and no packet is forwarded
+			_, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().Error(err)
+			suite.Require().Empty(suite.chainB.GetSimApp().ForwardKeeper.GetAllInFlightPackets(suite.chainB.GetContext()))
+
+			voucherTraceB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+			suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), voucherTraceB.IBCDenom()).IsZero())
+
+			suite.relayAckToA(packet, transfertypes.NewErrorAcknowledgement(tc.expErr))
+			suite.Require().Equal(balanceA, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), senderA, sdk.DefaultBondDenom))
+		})
+	}
+}
+
+func (suite *ForwardTestSuite) TestNoForwardMetadata() {
+	testCases := []struct {
+		msg  string
+		memo string
+	}{
+		{"empty memo", ""},
+		{"memo is not JSON", "memo"},
+		{"memo without forward metadata", `{"other":{"receiver":"cosmos1"}}`},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			packet, res := suite.transferAToB(transferCoin, tc.memo)
+
+			// the packet is not forwarded and the tokens are received by the receiver
+			_, err := ibctesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().Error(err)
+
+			voucherTraceB := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(packet.GetDestPort(), packet.GetDestChannel(), sdk.DefaultBondDenom))
+			balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), voucherTraceB.IBCDenom())
+			suite.Require().Equal(transferCoin.Amount, balance.Amount)
+
+			suite.relayAckToA(packet, channeltypes.NewResultAcknowledgement([]byte{byte(1)}))
+		})
+	}
+}
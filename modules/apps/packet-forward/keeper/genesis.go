@@ -0,0 +1,19 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+)
+
+// InitGenesis initializes the packet forward middleware state from a provided genesis state
+func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) {
+	for _, inFlightPacket := range state.InFlightPackets {
+		keeper.SetInFlightPacket(ctx, inFlightPacket)
+	}
+}
+
+// ExportGenesis returns the packet forward middleware exported genesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
+	return types.NewGenesisState(keeper.GetAllInFlightPackets(ctx))
+}
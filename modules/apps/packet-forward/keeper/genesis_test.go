@@ -0,0 +1,30 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	suite.SetupTest()
+
+	genesisState := types.NewGenesisState([]types.InFlightPacket{newInFlightPacket("channel-1", 1)})
+
+	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ForwardKeeper, *genesisState)
+
+	inFlightPacket, found := suite.chainA.GetSimApp().ForwardKeeper.GetInFlightPacket(suite.chainA.GetContext(), ibctesting.TransferPort, "channel-1", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.InFlightPackets[0], inFlightPacket)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	suite.SetupTest()
+
+	expInFlightPacket := newInFlightPacket("channel-1", 1)
+	suite.chainA.GetSimApp().ForwardKeeper.SetInFlightPacket(suite.chainA.GetContext(), expInFlightPacket)
+
+	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ForwardKeeper)
+
+	suite.Require().Equal([]types.InFlightPacket{expInFlightPacket}, genesisState.GetInFlightPackets())
+}
@@ -0,0 +1,119 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitykeeper "github.com/cosmos/cosmos-sdk/x/capability/keeper"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Keeper defines the IBC packet forward middleware keeper
+type Keeper struct {
+	storeKey sdk.StoreKey
+	cdc      codec.BinaryCodec
+
+	transferKeeper types.TransferKeeper
+	ics4Wrapper    types.ICS4Wrapper
+	channelKeeper  types.ChannelKeeper
+	bankKeeper     types.BankKeeper
+
+	scopedKeeper capabilitykeeper.ScopedKeeper
+}
+
+// NewKeeper creates a new packet forward middleware Keeper instance. The scoped keeper
+// must be the one of the transfer module, as the middleware writes acknowledgements on
+// channels owned by the transfer module.
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey,
+	transferKeeper types.TransferKeeper, ics4Wrapper types.ICS4Wrapper, channelKeeper types.ChannelKeeper,
+	bankKeeper types.BankKeeper, scopedKeeper capabilitykeeper.ScopedKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:       key,
+		cdc:            cdc,
+		transferKeeper: transferKeeper,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		bankKeeper:     bankKeeper,
+		scopedKeeper:   scopedKeeper,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// SendPacket wraps the ICS4Wrapper SendPacket function
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps the ICS4Wrapper WriteAcknowledgement function
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement)
+}
+
+// GetInFlightPacket retrieves the in-flight packet from the store, keyed by the identifiers of the forwarded packet
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyInFlightPacket(portID, channelID, sequence))
+	if bz == nil {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlightPacket types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlightPacket)
+
+	return inFlightPacket, true
+}
+
+// SetInFlightPacket stores the in-flight packet, keyed by the identifiers of the forwarded packet
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyInFlightPacket(inFlightPacket.ForwardPortId, inFlightPacket.ForwardChannelId, inFlightPacket.ForwardSequence)
+	store.Set(key, k.cdc.MustMarshal(&inFlightPacket))
+}
+
+// DeleteInFlightPacket removes the in-flight packet keyed by the identifiers of the forwarded packet from the store
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyInFlightPacket(portID, channelID, sequence))
+}
+
+// GetAllInFlightPackets returns all in-flight packets. Used in ExportGenesis
+func (k Keeper) GetAllInFlightPackets(ctx sdk.Context) []types.InFlightPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.InFlightPacketKeyPrefix))
+	defer iterator.Close()
+
+	var inFlightPackets []types.InFlightPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var inFlightPacket types.InFlightPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &inFlightPacket)
+
+		inFlightPackets = append(inFlightPackets, inFlightPacket)
+	}
+
+	return inFlightPackets
+}
+
+// writeAcknowledgement writes the acknowledgement of the original packet of an in-flight packet
+// using the channel capability of the transfer module.
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, packet channeltypes.Packet, ack ibcexported.Acknowledgement) error {
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(packet.GetDestPort(), packet.GetDestChannel()))
+	if !ok {
+		return sdkerrors.Wrap(channeltypes.ErrChannelCapabilityNotFound, "module does not own channel capability")
+	}
+
+	return k.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// newInFlightPacket returns an in-flight packet forwarded over the transfer port on the provided channel with the provided sequence
+func newInFlightPacket(channelID string, sequence uint64) types.InFlightPacket {
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 1, ibctesting.TransferPort, ibctesting.FirstChannelID, ibctesting.TransferPort, ibctesting.FirstChannelID,
+		clienttypes.NewHeight(0, 100), 0,
+	)
+
+	return types.NewInFlightPacket(packet, ibctesting.TransferPort, channelID, sequence)
+}
+
+func (suite *KeeperTestSuite) TestSetInFlightPacket() {
+	keeper := suite.chainA.GetSimApp().ForwardKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+	suite.Require().False(found)
+
+	expInFlightPacket := newInFlightPacket("channel-1", 1)
+	keeper.SetInFlightPacket(ctx, expInFlightPacket)
+
+	inFlightPacket, found := keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expInFlightPacket, inFlightPacket)
+
+	// the in-flight packet is keyed by the forwarded packet sequence
+	_, found = keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 2)
+	suite.Require().False(found)
+
+	keeper.DeleteInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+
+	_, found = keeper.GetInFlightPacket(ctx, ibctesting.TransferPort, "channel-1", 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetAllInFlightPackets() {
+	keeper := suite.chainA.GetSimApp().ForwardKeeper
+	ctx := suite.chainA.GetContext()
+
+	expInFlightPackets := []types.InFlightPacket{
+		newInFlightPacket("channel-1", 1),
+		newInFlightPacket("channel-1", 2),
+		newInFlightPacket("channel-2", 1),
+	}
+
+	for _, inFlightPacket := range expInFlightPackets {
+		keeper.SetInFlightPacket(ctx, inFlightPacket)
+	}
+
+	suite.Require().Equal(expInFlightPackets, keeper.GetAllInFlightPackets(ctx))
+}
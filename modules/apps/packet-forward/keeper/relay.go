@@ -0,0 +1,198 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ForwardTransferPacket sends the tokens of a received ICS20 packet, which have been credited
+// to the intermediate address by the transfer application, to the next hop described by the
+// forward metadata. The received packet is stored as in-flight so that its acknowledgement
+// can be written once the forwarded packet is acknowledged or timed out.
+func (k Keeper) ForwardTransferPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData, metadata types.ForwardMetadata) error {
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	timeout, err := metadata.GetTimeout()
+	if err != nil {
+		return err
+	}
+
+	memo, err := metadata.GetNextMemo()
+	if err != nil {
+		return err
+	}
+
+	// the sequence of the forwarded packet is used to key the in-flight packet
+	sequence, found := k.channelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	if !found {
+		return sdkerrors.Wrapf(
+			types.ErrForwardFailed,
+			"%s: source port: %s, source channel: %s", channeltypes.ErrSequenceSendNotFound, metadata.Port, metadata.Channel,
+		)
+	}
+
+	token := sdk.NewCoin(getReceivedDenom(packet, data), amount)
+	intermediateAddress := types.GetIntermediateAddress(packet.GetDestChannel(), data.Sender)
+	timeoutTimestamp := uint64(ctx.BlockTime().Add(timeout).UnixNano())
+
+	if err := k.transferKeeper.SendTransfer(
+		ctx, metadata.Port, metadata.Channel, token, intermediateAddress, metadata.Receiver,
+		clienttypes.ZeroHeight(), timeoutTimestamp, memo,
+	); err != nil {
+		return sdkerrors.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	k.SetInFlightPacket(ctx, types.NewInFlightPacket(packet, metadata.Port, metadata.Channel, sequence))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardPacket,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+			sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+			sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+			sdk.NewAttribute(types.AttributeKeyForwardPort, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyForwardChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", sequence)),
+			sdk.NewAttribute(types.AttributeKeyReceiver, metadata.Receiver),
+		),
+	)
+
+	return nil
+}
+
+// OnForwardAcknowledgementPacket writes the acknowledgement of the original packet of a forwarded
+// packet. A successful acknowledgement is written if the forwarded packet was acknowledged successfully,
+// otherwise the receive of the original packet is reverted and an error acknowledgement is written so
+// that the tokens are refunded along the path. It is a no-op if the packet was not forwarded by the
+// middleware. The transfer application must have processed the acknowledgement beforehand.
+func (k Keeper) OnForwardAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	if !ack.Success() {
+		return k.refundInFlightPacket(ctx, inFlightPacket, sdkerrors.Wrap(types.ErrForwardFailed, ack.GetError()))
+	}
+
+	return k.writeForwardResult(ctx, inFlightPacket, channeltypes.NewResultAcknowledgement([]byte{byte(1)}), nil)
+}
+
+// OnForwardTimeoutPacket reverts the receive of the original packet of a timed out forwarded packet
+// and writes an error acknowledgement so that the tokens are refunded along the path. It is a no-op
+// if the packet was not forwarded by the middleware. The transfer application must have processed
+// the timeout beforehand.
+func (k Keeper) OnForwardTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	inFlightPacket, found := k.GetInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeleteInFlightPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	return k.refundInFlightPacket(ctx, inFlightPacket, types.ErrForwardTimeout)
+}
+
+// refundInFlightPacket reverts the receive of the original packet and writes an error acknowledgement for it.
+// The tokens refunded to the intermediate address by the transfer application are escrowed again if this
+// chain is the source of the tokens, otherwise the vouchers minted on receive are burned. The state changes
+// of the receive are committed as the packet was acknowledged asynchronously, middlewares wrapping this one
+// revert their own state when the error acknowledgement is written through the ICS4Wrapper.
+func (k Keeper) refundInFlightPacket(ctx sdk.Context, inFlightPacket types.InFlightPacket, forwardErr error) error {
+	packet := inFlightPacket.OriginalPacket
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	intermediateAddress := types.GetIntermediateAddress(packet.GetDestChannel(), data.Sender)
+	token := sdk.NewCoin(getReceivedDenom(packet, data), amount)
+	coins := sdk.NewCoins(token)
+
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// the tokens were unescrowed on receive, put them back into escrow
+		escrowAddress := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := k.bankKeeper.SendCoins(ctx, intermediateAddress, escrowAddress, coins); err != nil {
+			return err
+		}
+
+		// the total escrow was decreased on receive, increase it again
+		currentTotalEscrow := k.transferKeeper.GetTotalEscrowForDenom(ctx, token.GetDenom())
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(token))
+	} else {
+		// the vouchers were minted on receive, burn them
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, intermediateAddress, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	return k.writeForwardResult(ctx, inFlightPacket, transfertypes.NewErrorAcknowledgement(forwardErr), forwardErr)
+}
+
+// writeForwardResult writes the acknowledgement of the original packet and emits an event for the
+// result of the forward.
+func (k Keeper) writeForwardResult(ctx sdk.Context, inFlightPacket types.InFlightPacket, ack ibcexported.Acknowledgement, forwardErr error) error {
+	packet := inFlightPacket.OriginalPacket
+	if err := k.writeAcknowledgement(ctx, packet, ack); err != nil {
+		return err
+	}
+
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstPort, packet.GetDestPort()),
+		sdk.NewAttribute(channeltypes.AttributeKeyDstChannel, packet.GetDestChannel()),
+		sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+		sdk.NewAttribute(types.AttributeKeyForwardPort, inFlightPacket.ForwardPortId),
+		sdk.NewAttribute(types.AttributeKeyForwardChannel, inFlightPacket.ForwardChannelId),
+		sdk.NewAttribute(types.AttributeKeyForwardSequence, fmt.Sprintf("%d", inFlightPacket.ForwardSequence)),
+		sdk.NewAttribute(types.AttributeKeySuccess, fmt.Sprintf("%t", ack.Success())),
+	}
+
+	if forwardErr != nil {
+		attributes = append(attributes, sdk.NewAttribute(types.AttributeKeyError, forwardErr.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeForwardResult, attributes...))
+
+	return nil
+}
+
+// getReceivedDenom returns the denomination on this chain of the tokens received in the provided
+// ICS20 packet, following the denomination logic of the transfer application.
+func getReceivedDenom(packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := transfertypes.GetDenomPrefix(packet.GetSourcePort(), packet.GetSourceChannel())
+		unprefixedDenom := data.Denom[len(voucherPrefix):]
+
+		return transfertypes.ParseDenomTrace(unprefixedDenom).IBCDenom()
+	}
+
+	prefixedDenom := transfertypes.GetDenomPrefix(packet.GetDestPort(), packet.GetDestChannel()) + data.Denom
+
+	return transfertypes.ParseDenomTrace(prefixedDenom).IBCDenom()
+}
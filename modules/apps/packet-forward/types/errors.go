@@ -0,0 +1,13 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// packet forward middleware sentinel errors
+var (
+	ErrInvalidForwardMetadata = sdkerrors.Register(ModuleName, 2, "invalid forward metadata")
+	ErrInFlightPacketNotFound = sdkerrors.Register(ModuleName, 3, "in-flight packet not found")
+	ErrForwardFailed          = sdkerrors.Register(ModuleName, 4, "packet forward failed")
+	ErrForwardTimeout         = sdkerrors.Register(ModuleName, 5, "forwarded packet timed out")
+)
@@ -0,0 +1,14 @@
+package types
+
+// packet forward middleware events
+const (
+	EventTypeForwardPacket = "forward_packet"
+	EventTypeForwardResult = "forward_result"
+
+	AttributeKeyForwardPort     = "forward_port"
+	AttributeKeyForwardChannel  = "forward_channel"
+	AttributeKeyForwardSequence = "forward_sequence"
+	AttributeKeyReceiver        = "receiver"
+	AttributeKeySuccess         = "success"
+	AttributeKeyError           = "error"
+)
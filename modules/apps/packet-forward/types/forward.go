@@ -0,0 +1,145 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// DefaultForwardTimeout is the relative timeout used for forwarded packets if the
+// forward metadata does not specify one.
+const DefaultForwardTimeout = 10 * time.Minute
+
+// ForwardMemoKey is the top level key of an ICS20 memo carrying forward metadata, e.g.
+//
+//	{"forward":{"receiver":"cosmos1...","port":"transfer","channel":"channel-1","timeout":"10m","next":{...}}}
+const ForwardMemoKey = "forward"
+
+// ForwardMetadata defines the next hop of a multi-hop transfer. The tokens received
+// are sent to Receiver over the channel identified by Port and Channel. Next is used
+// as the memo of the forwarded packet and may itself contain forward metadata for
+// further hops.
+type ForwardMetadata struct {
+	Receiver string          `json:"receiver"`
+	Port     string          `json:"port"`
+	Channel  string          `json:"channel"`
+	Timeout  string          `json:"timeout,omitempty"`
+	Next     json.RawMessage `json:"next,omitempty"`
+}
+
+// ParseForwardMetadata parses the forward metadata contained in the provided ICS20 memo.
+// Nil is returned without error if the memo is not a JSON object or does not contain
+// the forward key, in which case the packet is not forwarded. An error is returned if
+// the forward metadata is present but invalid.
+func ParseForwardMetadata(memo string) (*ForwardMetadata, error) {
+	if strings.TrimSpace(memo) == "" {
+		return nil, nil
+	}
+
+	var memoFields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &memoFields); err != nil {
+		return nil, nil
+	}
+
+	rawMetadata, ok := memoFields[ForwardMemoKey]
+	if !ok {
+		return nil, nil
+	}
+
+	var metadata ForwardMetadata
+	if err := json.Unmarshal(rawMetadata, &metadata); err != nil {
+		return nil, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "failed to unmarshal forward metadata: %s", err.Error())
+	}
+
+	if err := metadata.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+// ValidateBasic performs a basic validation of the forward metadata fields.
+func (fm ForwardMetadata) ValidateBasic() error {
+	if strings.TrimSpace(fm.Receiver) == "" {
+		return sdkerrors.Wrap(ErrInvalidForwardMetadata, "receiver cannot be empty")
+	}
+
+	if err := host.PortIdentifierValidator(fm.Port); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid port: %s", err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(fm.Channel); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid channel: %s", err.Error())
+	}
+
+	if _, err := fm.GetTimeout(); err != nil {
+		return err
+	}
+
+	if _, err := fm.GetNextMemo(); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet. The DefaultForwardTimeout
+// is returned if no timeout is set.
+func (fm ForwardMetadata) GetTimeout() (time.Duration, error) {
+	if fm.Timeout == "" {
+		return DefaultForwardTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(fm.Timeout)
+	if err != nil {
+		return 0, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid timeout: %s", err.Error())
+	}
+
+	if timeout <= 0 {
+		return 0, sdkerrors.Wrapf(ErrInvalidForwardMetadata, "timeout must be positive, got %s", timeout)
+	}
+
+	return timeout, nil
+}
+
+// GetNextMemo returns the memo of the forwarded packet. The next field must be a JSON object
+// if it is set, it is returned in its compact encoding.
+func (fm ForwardMetadata) GetNextMemo() (string, error) {
+	if len(fm.Next) == 0 || string(fm.Next) == "null" {
+		return "", nil
+	}
+
+	var next map[string]json.RawMessage
+	if err := json.Unmarshal(fm.Next, &next); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidForwardMetadata, "next must be a JSON object: %s", err.Error())
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, fm.Next); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid next: %s", err.Error())
+	}
+
+	memo := buf.String()
+	if err := transfertypes.ValidateMemo(memo); err != nil {
+		return "", sdkerrors.Wrapf(ErrInvalidForwardMetadata, "invalid next: %s", err.Error())
+	}
+
+	return memo, nil
+}
+
+// GetIntermediateAddress returns the address which receives the tokens of a packet to be
+// forwarded on the receiving chain and sends them to the next hop. The address is derived
+// from the destination channel and the sender of the received packet, the receiver set in
+// the received packet is ignored.
+func GetIntermediateAddress(channelID, sender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(ModuleName, []byte(fmt.Sprintf("%s/%s", channelID, sender))))
+}
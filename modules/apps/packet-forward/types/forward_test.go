@@ -0,0 +1,83 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		memo        string
+		expMetadata *types.ForwardMetadata
+		expPass     bool
+	}{
+		{"empty memo", "", nil, true},
+		{"memo is not JSON", "memo", nil, true},
+		{"memo is not a JSON object", `["forward"]`, nil, true},
+		{"memo without forward metadata", `{"wasm":{}}`, nil, true},
+		{
+			"valid forward metadata",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1"}}`,
+			&types.ForwardMetadata{Receiver: "cosmos1", Port: "transfer", Channel: "channel-1"},
+			true,
+		},
+		{
+			"valid forward metadata with timeout and next",
+			`{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":"1h","next":{"forward":{}}}}`,
+			&types.ForwardMetadata{Receiver: "cosmos1", Port: "transfer", Channel: "channel-1", Timeout: "1h", Next: json.RawMessage(`{"forward":{}}`)},
+			true,
+		},
+		{"forward metadata is not an object", `{"forward":"channel-1"}`, nil, false},
+		{"empty receiver", `{"forward":{"receiver":"","port":"transfer","channel":"channel-1"}}`, nil, false},
+		{"invalid port", `{"forward":{"receiver":"cosmos1","port":"(invalid)","channel":"channel-1"}}`, nil, false},
+		{"invalid channel", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":""}}`, nil, false},
+		{"invalid timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":"1"}}`, nil, false},
+		{"negative timeout", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","timeout":"-1h"}}`, nil, false},
+		{"next is not an object", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-1","next":"memo"}}`, nil, false},
+	}
+
+	for _, tc := range testCases {
+		metadata, err := types.ParseForwardMetadata(tc.memo)
+
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+			require.Equal(t, tc.expMetadata, metadata, tc.name)
+		} else {
+			require.ErrorIs(t, err, types.ErrInvalidForwardMetadata, tc.name)
+		}
+	}
+}
+
+func TestForwardMetadataGetTimeout(t *testing.T) {
+	timeout, err := types.ForwardMetadata{}.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultForwardTimeout, timeout)
+
+	timeout, err = types.ForwardMetadata{Timeout: "90s"}.GetTimeout()
+	require.NoError(t, err)
+	require.Equal(t, 90*time.Second, timeout)
+}
+
+func TestForwardMetadataGetNextMemo(t *testing.T) {
+	memo, err := types.ForwardMetadata{}.GetNextMemo()
+	require.NoError(t, err)
+	require.Empty(t, memo)
+
+	memo, err = types.ForwardMetadata{Next: json.RawMessage("{\n  \"forward\": {\"receiver\": \"cosmos1\"}\n}")}.GetNextMemo()
+	require.NoError(t, err)
+	require.Equal(t, `{"forward":{"receiver":"cosmos1"}}`, memo)
+}
+
+func TestGetIntermediateAddress(t *testing.T) {
+	address := types.GetIntermediateAddress("channel-0", "cosmos1sender")
+
+	require.Equal(t, address, types.GetIntermediateAddress("channel-0", "cosmos1sender"))
+	require.NotEqual(t, address, types.GetIntermediateAddress("channel-1", "cosmos1sender"))
+	require.NotEqual(t, address, types.GetIntermediateAddress("channel-0", "cosmos1other"))
+}
@@ -0,0 +1,62 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// DefaultGenesis creates and returns the default packet forward middleware GenesisState
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// NewGenesisState creates and returns a new GenesisState instance from the provided in-flight packets
+func NewGenesisState(inFlightPackets []InFlightPacket) *GenesisState {
+	return &GenesisState{
+		InFlightPackets: inFlightPackets,
+	}
+}
+
+// Validate performs basic validation of the packet forward middleware GenesisState
+func (gs GenesisState) Validate() error {
+	for _, inFlightPacket := range gs.InFlightPackets {
+		if err := inFlightPacket.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NewInFlightPacket creates and returns a new InFlightPacket instance
+func NewInFlightPacket(originalPacket channeltypes.Packet, forwardPortID, forwardChannelID string, forwardSequence uint64) InFlightPacket {
+	return InFlightPacket{
+		OriginalPacket:   originalPacket,
+		ForwardPortId:    forwardPortID,
+		ForwardChannelId: forwardChannelID,
+		ForwardSequence:  forwardSequence,
+	}
+}
+
+// Validate performs basic validation of the InFlightPacket
+func (p InFlightPacket) Validate() error {
+	if err := p.OriginalPacket.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if err := host.PortIdentifierValidator(p.ForwardPortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(p.ForwardChannelId); err != nil {
+		return err
+	}
+
+	if p.ForwardSequence == 0 {
+		return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "forward sequence cannot be 0")
+	}
+
+	return nil
+}
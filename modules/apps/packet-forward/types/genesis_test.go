@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/packet-forward/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	packet := channeltypes.NewPacket([]byte("data"), 1, "transfer", "channel-0", "transfer", "channel-0", clienttypes.NewHeight(0, 100), 0)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default genesis", types.DefaultGenesis(), true},
+		{"valid in-flight packet", types.NewGenesisState([]types.InFlightPacket{types.NewInFlightPacket(packet, "transfer", "channel-1", 1)}), true},
+		{"invalid original packet", types.NewGenesisState([]types.InFlightPacket{types.NewInFlightPacket(channeltypes.Packet{}, "transfer", "channel-1", 1)}), false},
+		{"invalid forward port", types.NewGenesisState([]types.InFlightPacket{types.NewInFlightPacket(packet, "", "channel-1", 1)}), false},
+		{"invalid forward channel", types.NewGenesisState([]types.InFlightPacket{types.NewInFlightPacket(packet, "transfer", "", 1)}), false},
+		{"zero forward sequence", types.NewGenesisState([]types.InFlightPacket{types.NewInFlightPacket(packet, "transfer", "channel-1", 0)}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
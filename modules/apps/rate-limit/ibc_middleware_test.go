@@ -0,0 +1,284 @@
+package ratelimit_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+var timeoutHeight = clienttypes.NewHeight(0, 110)
+
+type RateLimitTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	path *ibctesting.Path
+}
+
+func (suite *RateLimitTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	suite.path = NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(suite.path)
+}
+
+func NewTransferPath(chainA, chainB *ibctesting.TestChain) *ibctesting.Path {
+	path := ibctesting.NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointB.ChannelConfig.PortID = ibctesting.TransferPort
+	path.EndpointA.ChannelConfig.Version = transfertypes.Version
+	path.EndpointB.ChannelConfig.Version = transfertypes.Version
+
+	return path
+}
+
+func TestRateLimitTestSuite(t *testing.T) {
+	suite.Run(t, new(RateLimitTestSuite))
+}
+
+// setSendRateLimit sets an absolute send quota of the provided amount per hour for the bond
+// denomination over the channel of chainA.
+func (suite *RateLimitTestSuite) setSendRateLimit(amount int64) types.RateLimit {
+	rateLimit := types.NewRateLimit(
+		suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom,
+		types.NewQuota(0, 0, sdk.NewInt(amount), sdk.Int{}, time.Hour),
+	)
+	suite.chainA.GetSimApp().RateLimitKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(rateLimit))
+
+	return rateLimit
+}
+
+// sendTransfer sends the amount of the bond denomination from chainA to the receiver on chainB.
+func (suite *RateLimitTestSuite) sendTransfer(amount int64, receiver string, timeoutHeight clienttypes.Height) (channeltypes.Packet, error) {
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(amount)),
+		suite.chainA.SenderAccount.GetAddress().String(), receiver,
+		timeoutHeight, 0, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+
+	return ibctesting.ParsePacketFromEvents(res.GetEvents())
+}
+
+// recvPacket receives the packet on chainB and returns the acknowledgement written.
+func (suite *RateLimitTestSuite) recvPacket(packet channeltypes.Packet) []byte {
+	suite.Require().NoError(suite.path.EndpointB.UpdateClient())
+
+	res, err := suite.path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	return ack
+}
+
+// requireOutflow verifies the outflow of the bond denomination over the channel of chainA.
+func (suite *RateLimitTestSuite) requireOutflow(expOutflow int64) {
+	channelFlow, found := suite.chainA.GetSimApp().RateLimitKeeper.GetChannelFlow(suite.chainA.GetContext(), suite.path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(expOutflow), channelFlow.Flow.Outflow)
+}
+
+// requireNotPending verifies that the packet is no longer pending on chainA.
+func (suite *RateLimitTestSuite) requireNotPending(packet channeltypes.Packet) {
+	_, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
+func (suite *RateLimitTestSuite) TestSendRateLimited() {
+	suite.setSendRateLimit(100)
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+
+	packet, err := suite.sendTransfer(60, receiver, timeoutHeight)
+	suite.Require().NoError(err)
+	suite.requireOutflow(60)
+
+	pendingSendPacket, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingSendPacket(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(packet.GetSequence(), pendingSendPacket.Sequence)
+
+	// the transfer exceeds the remaining quota
+	cacheCtx, _ := suite.chainA.GetContext().CacheContext()
+	err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+		cacheCtx, suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+		sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(41)), suite.chainA.SenderAccount.GetAddress(), receiver,
+		timeoutHeight, 0, "",
+	)
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	_, err = suite.sendTransfer(40, receiver, timeoutHeight)
+	suite.Require().NoError(err)
+	suite.requireOutflow(100)
+
+	// other denominations are not rate limited
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), transfertypes.ModuleName, sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1000)))))
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), transfertypes.ModuleName, suite.chainA.SenderAccount.GetAddress(), sdk.NewCoins(sdk.NewCoin("atom", sdk.NewInt(1000)))))
+
+	msg := transfertypes.NewMsgTransfer(
+		suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID, sdk.NewCoin("atom", sdk.NewInt(1000)),
+		suite.chainA.SenderAccount.GetAddress().String(), receiver, timeoutHeight, 0, "",
+	)
+	_, err = suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	// the window is reset once the quota duration has elapsed
+	suite.coordinator.IncrementTimeBy(time.Hour)
+
+	_, err = suite.sendTransfer(100, receiver, timeoutHeight)
+	suite.Require().NoError(err)
+	suite.requireOutflow(100)
+}
+
+func (suite *RateLimitTestSuite) TestSendPacketCompletion() {
+	var (
+		receiver      string
+		timeoutHeight clienttypes.Height
+	)
+
+	testCases := []struct {
+		msg        string
+		malleate   func()
+		complete   func(packet channeltypes.Packet)
+		expOutflow int64
+	}{
+		{
+			"successful acknowledgement",
+			func() {},
+			func(packet channeltypes.Packet) {
+				suite.Require().NoError(suite.path.RelayPacket(packet))
+			},
+			60,
+		},
+		{
+			"error acknowledgement",
+			func() {
+				receiver = "invalid"
+			},
+			func(packet channeltypes.Packet) {
+				ack := suite.recvPacket(packet)
+				suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack))
+			},
+			0,
+		},
+		{
+			"timeout",
+			func() {
+				timeoutHeight = suite.path.EndpointA.GetClientState().GetLatestHeight().Increment().(clienttypes.Height)
+			},
+			func(packet channeltypes.Packet) {
+				suite.coordinator.CommitNBlocks(suite.chainB, 3)
+				suite.Require().NoError(suite.path.EndpointA.UpdateClient())
+				suite.Require().NoError(suite.path.EndpointA.TimeoutPacket(packet))
+			},
+			0,
+		},
+		{
+			"error acknowledgement after the window elapsed",
+			func() {
+				receiver = "invalid"
+			},
+			func(packet channeltypes.Packet) {
+				ack := suite.recvPacket(packet)
+
+				// a transfer in the new window
+				suite.coordinator.IncrementTimeBy(time.Hour)
+				_, err := suite.sendTransfer(30, suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 1000))
+				suite.Require().NoError(err)
+
+				suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack))
+			},
+			30,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			receiver = suite.chainB.SenderAccount.GetAddress().String()
+			timeoutHeight = clienttypes.NewHeight(0, 1000)
+
+			tc.malleate()
+
+			suite.setSendRateLimit(100)
+
+			packet, err := suite.sendTransfer(60, receiver, timeoutHeight)
+			suite.Require().NoError(err)
+			suite.requireOutflow(60)
+
+			tc.complete(packet)
+
+			suite.requireOutflow(tc.expOutflow)
+			suite.requireNotPending(packet)
+		})
+	}
+}
+
+func (suite *RateLimitTestSuite) TestRecvRateLimited() {
+	// the vouchers of the bond denomination of chainA received on chainB
+	voucherDenom := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+	).IBCDenom()
+
+	rateLimit := types.NewRateLimit(
+		suite.path.EndpointB.ChannelID, voucherDenom,
+		types.NewQuota(0, 0, sdk.Int{}, sdk.NewInt(100), time.Hour),
+	)
+	suite.chainB.GetSimApp().RateLimitKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(rateLimit))
+
+	receiver := suite.chainB.SenderAccount.GetAddress()
+
+	packet, err := suite.sendTransfer(60, receiver.String(), timeoutHeight)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.path.RelayPacket(packet))
+	suite.Require().Equal(sdk.NewInt(60), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom).Amount)
+
+	channelFlow, found := suite.chainB.GetSimApp().RateLimitKeeper.GetChannelFlow(suite.chainB.GetContext(), suite.path.EndpointB.ChannelID, voucherDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(60), channelFlow.Flow.Inflow)
+
+	// the packet is acknowledged synchronously and is not pending
+	_, found = suite.chainB.GetSimApp().RateLimitKeeper.GetPendingRecvPacket(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the packet exceeds the receive quota and is refunded on chainA
+	balanceA := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+
+	packet, err = suite.sendTransfer(41, receiver.String(), timeoutHeight)
+	suite.Require().NoError(err)
+
+	ack := suite.recvPacket(packet)
+	suite.Require().Equal(transfertypes.NewErrorAcknowledgement(types.ErrQuotaExceeded).Acknowledgement(), ack)
+
+	suite.Require().Equal(sdk.NewInt(60), suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), receiver, voucherDenom).Amount)
+
+	channelFlow, found = suite.chainB.GetSimApp().RateLimitKeeper.GetChannelFlow(suite.chainB.GetContext(), suite.path.EndpointB.ChannelID, voucherDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdk.NewInt(60), channelFlow.Flow.Inflow)
+
+	suite.Require().NoError(suite.path.EndpointA.AcknowledgePacket(packet, ack))
+	suite.Require().Equal(balanceA, suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom))
+}
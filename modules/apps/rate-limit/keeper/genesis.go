@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+)
+
+// InitGenesis initializes the rate limit middleware state from a provided genesis state
+func InitGenesis(ctx sdk.Context, keeper Keeper, state types.GenesisState) {
+	keeper.SetParams(ctx, state.Params)
+
+	for _, channelFlow := range state.Flows {
+		keeper.SetChannelFlow(ctx, channelFlow)
+	}
+
+	for _, pendingSendPacket := range state.PendingSendPackets {
+		keeper.SetPendingSendPacket(ctx, pendingSendPacket)
+	}
+
+	for _, pendingRecvPacket := range state.PendingRecvPackets {
+		keeper.SetPendingRecvPacket(ctx, pendingRecvPacket)
+	}
+}
+
+// ExportGenesis returns the rate limit middleware exported genesis
+func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
+	return types.NewGenesisState(
+		keeper.GetParams(ctx), keeper.GetAllChannelFlows(ctx), keeper.GetAllPendingSendPackets(ctx), keeper.GetAllPendingRecvPackets(ctx),
+	)
+}
@@ -0,0 +1,59 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	suite.SetupTest()
+
+	ctx := suite.chainA.GetContext()
+	genesisState := types.NewGenesisState(
+		types.NewParams(newRateLimit(100)),
+		[]types.ChannelFlow{types.NewChannelFlow(ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewFlow(sdk.NewInt(1000), ctx.BlockTime()))},
+		[]types.PendingSendPacket{types.NewPendingSendPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 1, ctx.BlockTime())},
+		[]types.PendingRecvPacket{types.NewPendingRecvPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 2, ctx.BlockTime())},
+	)
+
+	keeper.InitGenesis(ctx, suite.chainA.GetSimApp().RateLimitKeeper, *genesisState)
+
+	suite.Require().Equal(genesisState.Params, suite.chainA.GetSimApp().RateLimitKeeper.GetParams(ctx))
+
+	channelFlow, found := suite.chainA.GetSimApp().RateLimitKeeper.GetChannelFlow(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.Flows[0], channelFlow)
+
+	pendingSendPacket, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingSendPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.PendingSendPackets[0], pendingSendPacket)
+
+	pendingRecvPacket, found := suite.chainA.GetSimApp().RateLimitKeeper.GetPendingRecvPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 2)
+	suite.Require().True(found)
+	suite.Require().Equal(genesisState.PendingRecvPackets[0], pendingRecvPacket)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	suite.SetupTest()
+
+	ctx := suite.chainA.GetContext()
+	expParams := types.NewParams(newRateLimit(100))
+	expChannelFlow := types.NewChannelFlow(ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewFlow(sdk.NewInt(1000), ctx.BlockTime()))
+	expPendingSendPacket := types.NewPendingSendPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 1, ctx.BlockTime())
+	expPendingRecvPacket := types.NewPendingRecvPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 2, ctx.BlockTime())
+
+	suite.chainA.GetSimApp().RateLimitKeeper.SetParams(ctx, expParams)
+	suite.chainA.GetSimApp().RateLimitKeeper.SetChannelFlow(ctx, expChannelFlow)
+	suite.chainA.GetSimApp().RateLimitKeeper.SetPendingSendPacket(ctx, expPendingSendPacket)
+	suite.chainA.GetSimApp().RateLimitKeeper.SetPendingRecvPacket(ctx, expPendingRecvPacket)
+
+	genesisState := keeper.ExportGenesis(ctx, suite.chainA.GetSimApp().RateLimitKeeper)
+
+	suite.Require().Equal(expParams, genesisState.GetParams())
+	suite.Require().Equal([]types.ChannelFlow{expChannelFlow}, genesisState.GetFlows())
+	suite.Require().Equal([]types.PendingSendPacket{expPendingSendPacket}, genesisState.GetPendingSendPackets())
+	suite.Require().Equal([]types.PendingRecvPacket{expPendingRecvPacket}, genesisState.GetPendingRecvPackets())
+}
@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	expParams := types.DefaultParams()
+	res, _ := suite.queryClient.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, res.Params)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	ctx := suite.chainA.GetContext()
+	rateLimit := newRateLimit(100)
+	suite.chainA.GetSimApp().RateLimitKeeper.SetParams(ctx, types.NewParams(rateLimit))
+
+	flow := types.NewFlow(sdk.NewInt(1000), ctx.BlockTime())
+	flow.Outflow = sdk.NewInt(40)
+	suite.chainA.GetSimApp().RateLimitKeeper.SetChannelFlow(ctx, types.NewChannelFlow(rateLimit.ChannelId, rateLimit.Denom, flow))
+
+	res, err := suite.queryClient.RateLimits(sdk.WrapSDKContext(ctx), &types.QueryRateLimitsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.RateLimitStatus{types.NewRateLimitStatus(rateLimit, flow)}, res.RateLimits)
+	suite.Require().Equal(sdk.NewInt(60), *res.RateLimits[0].RemainingSendQuota)
+	suite.Require().Equal(sdk.NewInt(100), *res.RateLimits[0].RemainingRecvQuota)
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var (
+		req       *types.QueryRateLimitRequest
+		expStatus types.RateLimitStatus
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid channel",
+			func() {
+				req = &types.QueryRateLimitRequest{
+					ChannelId: "",
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			false,
+		},
+		{
+			"empty denom",
+			func() {
+				req = &types.QueryRateLimitRequest{
+					ChannelId: ibctesting.FirstChannelID,
+					Denom:     "",
+				}
+			},
+			false,
+		},
+		{
+			"rate limit not found",
+			func() {
+				req = &types.QueryRateLimitRequest{
+					ChannelId: ibctesting.FirstChannelID,
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			false,
+		},
+		{
+			"success with no flow",
+			func() {
+				ctx := suite.chainA.GetContext()
+				rateLimit := newRateLimit(100)
+				suite.chainA.GetSimApp().RateLimitKeeper.SetParams(ctx, types.NewParams(rateLimit))
+
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+				expStatus = types.NewRateLimitStatus(rateLimit, types.NewFlow(supply.Amount, ctx.BlockTime()))
+
+				req = &types.QueryRateLimitRequest{
+					ChannelId: ibctesting.FirstChannelID,
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			true,
+		},
+		{
+			"success with flow",
+			func() {
+				ctx := suite.chainA.GetContext()
+				rateLimit := newRateLimit(100)
+				suite.chainA.GetSimApp().RateLimitKeeper.SetParams(ctx, types.NewParams(rateLimit))
+
+				flow := types.NewFlow(sdk.NewInt(1000), ctx.BlockTime())
+				flow.Inflow = sdk.NewInt(30)
+				suite.chainA.GetSimApp().RateLimitKeeper.SetChannelFlow(ctx, types.NewChannelFlow(rateLimit.ChannelId, rateLimit.Denom, flow))
+				expStatus = types.NewRateLimitStatus(rateLimit, flow)
+
+				req = &types.QueryRateLimitRequest{
+					ChannelId: ibctesting.FirstChannelID,
+					Denom:     sdk.DefaultBondDenom,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.RateLimit(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expStatus, res.RateLimit)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,200 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Keeper defines the IBC rate limit middleware keeper
+type Keeper struct {
+	storeKey   sdk.StoreKey
+	cdc        codec.BinaryCodec
+	paramSpace paramtypes.Subspace
+
+	ics4Wrapper types.ICS4Wrapper
+	bankKeeper  types.BankKeeper
+}
+
+// NewKeeper creates a new rate limit middleware Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ics4Wrapper types.ICS4Wrapper, bankKeeper types.BankKeeper,
+) Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		storeKey:    key,
+		cdc:         cdc,
+		paramSpace:  paramSpace,
+		ics4Wrapper: ics4Wrapper,
+		bankKeeper:  bankKeeper,
+	}
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s-%s", host.ModuleName, types.ModuleName))
+}
+
+// SendPacket wraps the ICS4Wrapper SendPacket function. The outflow of ICS20 packets is checked
+// against the rate limit of the source channel and denomination before the packet is sent.
+func (k Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI) error {
+	if err := k.OnSendPacket(ctx, packet); err != nil {
+		return err
+	}
+
+	return k.ics4Wrapper.SendPacket(ctx, chanCap, packet)
+}
+
+// WriteAcknowledgement wraps the ICS4Wrapper WriteAcknowledgement function. The inflow of a packet
+// which is acknowledged asynchronously is reverted if the acknowledgement is unsuccessful.
+func (k Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet ibcexported.PacketI, acknowledgement ibcexported.Acknowledgement) error {
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, acknowledgement); err != nil {
+		return err
+	}
+
+	return k.OnWriteAcknowledgement(ctx, packet, acknowledgement)
+}
+
+// GetChannelFlow retrieves the flow of the denomination over the channel from the store
+func (k Keeper) GetChannelFlow(ctx sdk.Context, channelID, denom string) (types.ChannelFlow, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyFlow(channelID, denom))
+	if bz == nil {
+		return types.ChannelFlow{}, false
+	}
+
+	var channelFlow types.ChannelFlow
+	k.cdc.MustUnmarshal(bz, &channelFlow)
+
+	return channelFlow, true
+}
+
+// SetChannelFlow stores the flow of a denomination over a channel
+func (k Keeper) SetChannelFlow(ctx sdk.Context, channelFlow types.ChannelFlow) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.KeyFlow(channelFlow.ChannelId, channelFlow.Denom), k.cdc.MustMarshal(&channelFlow))
+}
+
+// DeleteChannelFlow removes the flow of the denomination over the channel from the store
+func (k Keeper) DeleteChannelFlow(ctx sdk.Context, channelID, denom string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyFlow(channelID, denom))
+}
+
+// GetAllChannelFlows returns all stored channel flows. Used in ExportGenesis
+func (k Keeper) GetAllChannelFlows(ctx sdk.Context) []types.ChannelFlow {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.FlowKeyPrefix+"/"))
+	defer iterator.Close()
+
+	var channelFlows []types.ChannelFlow
+	for ; iterator.Valid(); iterator.Next() {
+		var channelFlow types.ChannelFlow
+		k.cdc.MustUnmarshal(iterator.Value(), &channelFlow)
+
+		channelFlows = append(channelFlows, channelFlow)
+	}
+
+	return channelFlows
+}
+
+// GetPendingSendPacket retrieves the pending send packet from the store
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingSendPacket(portID, channelID, sequence))
+	if bz == nil {
+		return types.PendingSendPacket{}, false
+	}
+
+	var pendingSendPacket types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &pendingSendPacket)
+
+	return pendingSendPacket, true
+}
+
+// SetPendingSendPacket stores the pending send packet
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, pendingSendPacket types.PendingSendPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPendingSendPacket(pendingSendPacket.PortId, pendingSendPacket.ChannelId, pendingSendPacket.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&pendingSendPacket))
+}
+
+// DeletePendingSendPacket removes the pending send packet from the store
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingSendPacket(portID, channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all pending send packets. Used in ExportGenesis
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PendingSendPacketKeyPrefix+"/"))
+	defer iterator.Close()
+
+	var pendingSendPackets []types.PendingSendPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingSendPacket types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingSendPacket)
+
+		pendingSendPackets = append(pendingSendPackets, pendingSendPacket)
+	}
+
+	return pendingSendPackets
+}
+
+// GetPendingRecvPacket retrieves the pending receive packet from the store
+func (k Keeper) GetPendingRecvPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.PendingRecvPacket, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.KeyPendingRecvPacket(portID, channelID, sequence))
+	if bz == nil {
+		return types.PendingRecvPacket{}, false
+	}
+
+	var pendingRecvPacket types.PendingRecvPacket
+	k.cdc.MustUnmarshal(bz, &pendingRecvPacket)
+
+	return pendingRecvPacket, true
+}
+
+// SetPendingRecvPacket stores the pending receive packet
+func (k Keeper) SetPendingRecvPacket(ctx sdk.Context, pendingRecvPacket types.PendingRecvPacket) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyPendingRecvPacket(pendingRecvPacket.PortId, pendingRecvPacket.ChannelId, pendingRecvPacket.Sequence)
+	store.Set(key, k.cdc.MustMarshal(&pendingRecvPacket))
+}
+
+// DeletePendingRecvPacket removes the pending receive packet from the store
+func (k Keeper) DeletePendingRecvPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.KeyPendingRecvPacket(portID, channelID, sequence))
+}
+
+// GetAllPendingRecvPackets returns all pending receive packets. Used in ExportGenesis
+func (k Keeper) GetAllPendingRecvPackets(ctx sdk.Context) []types.PendingRecvPacket {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(types.PendingRecvPacketKeyPrefix+"/"))
+	defer iterator.Close()
+
+	var pendingRecvPackets []types.PendingRecvPacket
+	for ; iterator.Valid(); iterator.Next() {
+		var pendingRecvPacket types.PendingRecvPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingRecvPacket)
+
+		pendingRecvPackets = append(pendingRecvPackets, pendingRecvPacket)
+	}
+
+	return pendingRecvPackets
+}
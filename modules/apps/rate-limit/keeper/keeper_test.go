@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 2)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().RateLimitKeeper)
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+// newRateLimit returns a rate limit of the bond denomination over the first channel with an
+// absolute send and receive quota of the provided amount per hour
+func newRateLimit(amount int64) types.RateLimit {
+	return types.NewRateLimit(
+		ibctesting.FirstChannelID, sdk.DefaultBondDenom,
+		types.NewQuota(0, 0, sdk.NewInt(amount), sdk.NewInt(amount), time.Hour),
+	)
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+	ctx := suite.chainA.GetContext()
+
+	suite.Require().Equal(types.DefaultParams(), keeper.GetParams(ctx))
+
+	expParams := types.NewParams(newRateLimit(100))
+	keeper.SetParams(ctx, expParams)
+	suite.Require().Equal(expParams, keeper.GetParams(ctx))
+
+	rateLimit, found := keeper.GetRateLimit(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(newRateLimit(100), rateLimit)
+
+	_, found = keeper.GetRateLimit(ctx, "channel-1", sdk.DefaultBondDenom)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSetChannelFlow() {
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := keeper.GetChannelFlow(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+
+	expChannelFlow := types.NewChannelFlow(ibctesting.FirstChannelID, sdk.DefaultBondDenom, types.NewFlow(sdk.NewInt(1000), ctx.BlockTime()))
+	keeper.SetChannelFlow(ctx, expChannelFlow)
+
+	channelFlow, found := keeper.GetChannelFlow(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(expChannelFlow, channelFlow)
+	suite.Require().Equal([]types.ChannelFlow{expChannelFlow}, keeper.GetAllChannelFlows(ctx))
+
+	keeper.DeleteChannelFlow(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+
+	_, found = keeper.GetChannelFlow(ctx, ibctesting.FirstChannelID, sdk.DefaultBondDenom)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSetPendingSendPacket() {
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := keeper.GetPendingSendPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+
+	expPendingSendPacket := types.NewPendingSendPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 1, ctx.BlockTime())
+	keeper.SetPendingSendPacket(ctx, expPendingSendPacket)
+
+	pendingSendPacket, found := keeper.GetPendingSendPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expPendingSendPacket, pendingSendPacket)
+	suite.Require().Equal([]types.PendingSendPacket{expPendingSendPacket}, keeper.GetAllPendingSendPackets(ctx))
+
+	keeper.DeletePendingSendPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+
+	_, found = keeper.GetPendingSendPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSetPendingRecvPacket() {
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+	ctx := suite.chainA.GetContext()
+
+	_, found := keeper.GetPendingRecvPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+
+	expPendingRecvPacket := types.NewPendingRecvPacket(ibctesting.TransferPort, ibctesting.FirstChannelID, 1, ctx.BlockTime())
+	keeper.SetPendingRecvPacket(ctx, expPendingRecvPacket)
+
+	pendingRecvPacket, found := keeper.GetPendingRecvPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expPendingRecvPacket, pendingRecvPacket)
+	suite.Require().Equal([]types.PendingRecvPacket{expPendingRecvPacket}, keeper.GetAllPendingRecvPackets(ctx))
+
+	keeper.DeletePendingRecvPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+
+	_, found = keeper.GetPendingRecvPacket(ctx, ibctesting.TransferPort, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestGetCurrentFlow() {
+	keeper := suite.chainA.GetSimApp().RateLimitKeeper
+	ctx := suite.chainA.GetContext()
+	rateLimit := newRateLimit(100)
+
+	// a new flow uses the current supply as the channel value
+	supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+	flow := keeper.GetCurrentFlow(ctx, rateLimit)
+	suite.Require().Equal(types.NewFlow(supply.Amount, ctx.BlockTime()), flow)
+
+	// the stored flow is returned within its window
+	storedFlow := types.NewFlow(sdk.NewInt(1000), ctx.BlockTime().Add(-time.Minute))
+	storedFlow.Outflow = sdk.NewInt(50)
+	keeper.SetChannelFlow(ctx, types.NewChannelFlow(rateLimit.ChannelId, rateLimit.Denom, storedFlow))
+	suite.Require().Equal(storedFlow, keeper.GetCurrentFlow(ctx, rateLimit))
+
+	// a new flow is returned once the window has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	suite.Require().Equal(types.NewFlow(supply.Amount, ctx.BlockTime()), keeper.GetCurrentFlow(ctx, rateLimit))
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+)
+
+// GetRateLimits retrieves the rate limits from the paramstore
+func (k Keeper) GetRateLimits(ctx sdk.Context) []types.RateLimit {
+	var res []types.RateLimit
+	k.paramSpace.Get(ctx, types.KeyRateLimits, &res)
+	return res
+}
+
+// GetRateLimit returns the rate limit of the denomination over the channel if it exists
+func (k Keeper) GetRateLimit(ctx sdk.Context, channelID, denom string) (types.RateLimit, bool) {
+	return k.GetParams(ctx).GetRateLimit(channelID, denom)
+}
+
+// GetParams returns the total set of rate limit middleware parameters.
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	return types.NewParams(k.GetRateLimits(ctx)...)
+}
+
+// SetParams sets the total set of rate limit middleware parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
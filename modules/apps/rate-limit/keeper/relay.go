@@ -0,0 +1,212 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+	transfertypes "github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibcexported "github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// GetCurrentFlow returns the flow of the rate limited denomination over the channel for the
+// current window. A new flow is returned if no flow is stored or the window of the stored flow
+// has elapsed. The channel value of a new flow is the current supply of the denomination.
+func (k Keeper) GetCurrentFlow(ctx sdk.Context, rateLimit types.RateLimit) types.Flow {
+	channelFlow, found := k.GetChannelFlow(ctx, rateLimit.ChannelId, rateLimit.Denom)
+	if found && !channelFlow.Flow.IsExpired(rateLimit.Quota, ctx.BlockTime()) {
+		return channelFlow.Flow
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, rateLimit.Denom)
+	return types.NewFlow(supply.Amount, ctx.BlockTime())
+}
+
+// OnSendPacket adds the amount of an outgoing ICS20 packet to the outflow of the denomination
+// over the source channel. An error is returned if the outflow exceeds the send quota. The
+// packet is recorded as pending so that the outflow can be reverted if the packet fails.
+// Packets which are not ICS20 packets or are not rate limited are ignored.
+func (k Keeper) OnSendPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return nil
+	}
+
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	rateLimit, found := k.GetRateLimit(ctx, packet.GetSourceChannel(), denom)
+	if !found {
+		return nil
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	flow := k.GetCurrentFlow(ctx, rateLimit)
+	if err := flow.AddOutflow(amount, rateLimit.Quota); err != nil {
+		return sdkerrors.Wrapf(err, "channel: %s, denom: %s", rateLimit.ChannelId, rateLimit.Denom)
+	}
+
+	k.SetChannelFlow(ctx, types.NewChannelFlow(rateLimit.ChannelId, rateLimit.Denom, flow))
+	k.SetPendingSendPacket(ctx, types.NewPendingSendPacket(
+		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(), flow.WindowStart,
+	))
+
+	return nil
+}
+
+// OnRecvPacket adds the amount of an incoming ICS20 packet to the inflow of the received
+// denomination over the destination channel. An error is returned if the inflow exceeds the
+// receive quota. The packet is recorded as pending so that the inflow can be reverted if its
+// acknowledgement is written asynchronously and is unsuccessful. Packets which are not rate
+// limited are ignored.
+func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data transfertypes.FungibleTokenPacketData) error {
+	denom := transfertypes.GetReceivedDenomTrace(
+		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), data.Denom,
+	).IBCDenom()
+
+	rateLimit, found := k.GetRateLimit(ctx, packet.GetDestChannel(), denom)
+	if !found {
+		return nil
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	flow := k.GetCurrentFlow(ctx, rateLimit)
+	if err := flow.AddInflow(amount, rateLimit.Quota); err != nil {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeQuotaExceeded,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+				sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.ChannelId),
+				sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+				sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+				sdk.NewAttribute(types.AttributeKeyDirection, types.AttributeValueDirectionRecv),
+			),
+		)
+
+		return sdkerrors.Wrapf(err, "channel: %s, denom: %s", rateLimit.ChannelId, rateLimit.Denom)
+	}
+
+	k.SetChannelFlow(ctx, types.NewChannelFlow(rateLimit.ChannelId, rateLimit.Denom, flow))
+	k.SetPendingRecvPacket(ctx, types.NewPendingRecvPacket(
+		packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), flow.WindowStart,
+	))
+
+	return nil
+}
+
+// OnWriteAcknowledgement removes the pending receive packet of an asynchronously acknowledged
+// packet. The inflow of the packet is reverted if the acknowledgement is unsuccessful.
+func (k Keeper) OnWriteAcknowledgement(ctx sdk.Context, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	if ack.Success() {
+		k.DeletePendingRecvPacket(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		return nil
+	}
+
+	return k.revertRecvPacket(ctx, packet)
+}
+
+// OnAcknowledgementPacket removes the pending send packet. The outflow of the packet is reverted
+// if the acknowledgement is an error acknowledgement.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	if ack.Success() {
+		k.DeletePendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+		return nil
+	}
+
+	return k.revertSendPacket(ctx, packet)
+}
+
+// OnTimeoutPacket removes the pending send packet and reverts its outflow.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	return k.revertSendPacket(ctx, packet)
+}
+
+// revertSendPacket subtracts the amount of a failed packet from the outflow of its denomination
+// over the source channel and removes the pending send packet. The outflow is only reverted if
+// the packet was sent within the current window of the flow. It is a no-op if the packet is not
+// pending.
+func (k Keeper) revertSendPacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	pendingSendPacket, found := k.GetPendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeletePendingSendPacket(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	denom := transfertypes.ParseDenomTrace(data.Denom).IBCDenom()
+	channelFlow, found := k.GetChannelFlow(ctx, packet.GetSourceChannel(), denom)
+	if !found || !channelFlow.Flow.WindowStart.Equal(pendingSendPacket.WindowStart) {
+		// the window in which the packet was sent has already elapsed
+		return nil
+	}
+
+	channelFlow.Flow.SubOutflow(amount)
+	k.SetChannelFlow(ctx, channelFlow)
+
+	k.Logger(ctx).Debug(
+		"reverted outflow of failed packet",
+		"channel", channelFlow.ChannelId, "denom", channelFlow.Denom, "amount", amount.String(), "sequence", packet.GetSequence(),
+	)
+
+	return nil
+}
+
+// revertRecvPacket subtracts the amount of a packet acknowledged with an error from the inflow of
+// the received denomination over the destination channel and removes the pending receive packet.
+// The inflow is only reverted if the packet was received within the current window of the flow.
+// It is a no-op if the packet is not pending.
+func (k Keeper) revertRecvPacket(ctx sdk.Context, packet ibcexported.PacketI) error {
+	pendingRecvPacket, found := k.GetPendingRecvPacket(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	if !found {
+		return nil
+	}
+
+	k.DeletePendingRecvPacket(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet data: %s", err.Error())
+	}
+
+	amount, ok := sdk.NewIntFromString(data.Amount)
+	if !ok {
+		return sdkerrors.Wrapf(transfertypes.ErrInvalidAmount, "unable to parse transfer amount (%s) into sdk.Int", data.Amount)
+	}
+
+	denom := transfertypes.GetReceivedDenomTrace(
+		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), data.Denom,
+	).IBCDenom()
+
+	channelFlow, found := k.GetChannelFlow(ctx, packet.GetDestChannel(), denom)
+	if !found || !channelFlow.Flow.WindowStart.Equal(pendingRecvPacket.WindowStart) {
+		// the window in which the packet was received has already elapsed
+		return nil
+	}
+
+	channelFlow.Flow.SubInflow(amount)
+	k.SetChannelFlow(ctx, channelFlow)
+
+	k.Logger(ctx).Debug(
+		"reverted inflow of failed packet",
+		"channel", channelFlow.ChannelId, "denom", channelFlow.Denom, "amount", amount.String(), "sequence", packet.GetSequence(),
+	)
+
+	return nil
+}
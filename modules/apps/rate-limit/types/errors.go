@@ -0,0 +1,12 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// rate limit middleware sentinel errors
+var (
+	ErrQuotaExceeded     = sdkerrors.Register(ModuleName, 2, "rate limit quota exceeded")
+	ErrInvalidRateLimit  = sdkerrors.Register(ModuleName, 3, "invalid rate limit")
+	ErrRateLimitNotFound = sdkerrors.Register(ModuleName, 4, "rate limit not found")
+)
@@ -0,0 +1,13 @@
+package types
+
+// rate limit middleware events
+const (
+	EventTypeQuotaExceeded = "rate_limit_quota_exceeded"
+
+	AttributeKeyChannel   = "channel"
+	AttributeKeyDenom     = "denom"
+	AttributeKeyAmount    = "amount"
+	AttributeKeyDirection = "direction"
+
+	AttributeValueDirectionRecv = "recv"
+)
@@ -0,0 +1,83 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// DefaultGenesis creates and returns the default rate limit middleware GenesisState
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// NewGenesisState creates and returns a new GenesisState instance from the provided params, flows and pending packets
+func NewGenesisState(
+	params Params, flows []ChannelFlow, pendingSendPackets []PendingSendPacket, pendingRecvPackets []PendingRecvPacket,
+) *GenesisState {
+	return &GenesisState{
+		Params:             params,
+		Flows:              flows,
+		PendingSendPackets: pendingSendPackets,
+		PendingRecvPackets: pendingRecvPackets,
+	}
+}
+
+// Validate performs basic validation of the rate limit middleware GenesisState
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	for _, channelFlow := range gs.Flows {
+		if err := host.ChannelIdentifierValidator(channelFlow.ChannelId); err != nil {
+			return err
+		}
+
+		if err := sdk.ValidateDenom(channelFlow.Denom); err != nil {
+			return err
+		}
+
+		flow := channelFlow.Flow
+		if flow.Inflow.IsNil() || flow.Outflow.IsNil() || flow.ChannelValue.IsNil() ||
+			flow.Inflow.IsNegative() || flow.Outflow.IsNegative() || flow.ChannelValue.IsNegative() {
+			return fmt.Errorf("invalid flow for channel %s and denom %s: amounts must be non-negative", channelFlow.ChannelId, channelFlow.Denom)
+		}
+	}
+
+	for _, pendingSendPacket := range gs.PendingSendPackets {
+		if err := host.PortIdentifierValidator(pendingSendPacket.PortId); err != nil {
+			return err
+		}
+
+		if err := host.ChannelIdentifierValidator(pendingSendPacket.ChannelId); err != nil {
+			return err
+		}
+
+		if pendingSendPacket.Sequence == 0 {
+			return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet sequence cannot be 0")
+		}
+	}
+
+	for _, pendingRecvPacket := range gs.PendingRecvPackets {
+		if err := host.PortIdentifierValidator(pendingRecvPacket.PortId); err != nil {
+			return err
+		}
+
+		if err := host.ChannelIdentifierValidator(pendingRecvPacket.ChannelId); err != nil {
+			return err
+		}
+
+		if pendingRecvPacket.Sequence == 0 {
+			return sdkerrors.Wrap(channeltypes.ErrInvalidPacket, "packet sequence cannot be 0")
+		}
+	}
+
+	return nil
+}
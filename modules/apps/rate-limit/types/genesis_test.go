@@ -0,0 +1,55 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	params := types.NewParams(types.NewRateLimit("channel-0", "stake", types.NewQuota(10, 10, nilInt, nilInt, time.Hour)))
+	flow := types.NewFlow(sdk.NewInt(1000), windowStart)
+	negativeFlow := types.NewFlow(sdk.NewInt(-1), windowStart)
+
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expPass  bool
+	}{
+		{"default genesis", types.DefaultGenesis(), true},
+		{
+			"valid genesis",
+			types.NewGenesisState(
+				params,
+				[]types.ChannelFlow{types.NewChannelFlow("channel-0", "stake", flow)},
+				[]types.PendingSendPacket{types.NewPendingSendPacket("transfer", "channel-0", 1, windowStart)},
+				[]types.PendingRecvPacket{types.NewPendingRecvPacket("transfer", "channel-0", 1, windowStart)},
+			),
+			true,
+		},
+		{"invalid params", types.NewGenesisState(types.NewParams(types.NewRateLimit("channel-0", "stake", types.Quota{})), nil, nil, nil), false},
+		{"invalid flow channel", types.NewGenesisState(params, []types.ChannelFlow{types.NewChannelFlow("", "stake", flow)}, nil, nil), false},
+		{"invalid flow denom", types.NewGenesisState(params, []types.ChannelFlow{types.NewChannelFlow("channel-0", "", flow)}, nil, nil), false},
+		{"negative flow", types.NewGenesisState(params, []types.ChannelFlow{types.NewChannelFlow("channel-0", "stake", negativeFlow)}, nil, nil), false},
+		{"empty flow", types.NewGenesisState(params, []types.ChannelFlow{types.NewChannelFlow("channel-0", "stake", types.Flow{})}, nil, nil), false},
+		{"invalid pending packet port", types.NewGenesisState(params, nil, []types.PendingSendPacket{types.NewPendingSendPacket("", "channel-0", 1, windowStart)}, nil), false},
+		{"invalid pending packet channel", types.NewGenesisState(params, nil, []types.PendingSendPacket{types.NewPendingSendPacket("transfer", "", 1, windowStart)}, nil), false},
+		{"zero pending packet sequence", types.NewGenesisState(params, nil, []types.PendingSendPacket{types.NewPendingSendPacket("transfer", "channel-0", 0, windowStart)}, nil), false},
+		{"invalid pending recv packet port", types.NewGenesisState(params, nil, nil, []types.PendingRecvPacket{types.NewPendingRecvPacket("", "channel-0", 1, windowStart)}), false},
+		{"invalid pending recv packet channel", types.NewGenesisState(params, nil, nil, []types.PendingRecvPacket{types.NewPendingRecvPacket("transfer", "", 1, windowStart)}), false},
+		{"zero pending recv packet sequence", types.NewGenesisState(params, nil, nil, []types.PendingRecvPacket{types.NewPendingRecvPacket("transfer", "channel-0", 0, windowStart)}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+)
+
+const (
+	// ModuleName defines the rate limit middleware module name
+	ModuleName = "ratelimit"
+
+	// StoreKey is the store key string for the rate limit middleware
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for the rate limit middleware
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the rate limit middleware
+	QuerierRoute = ModuleName
+)
+
+var (
+	// FlowKeyPrefix defines the key prefix used to store channel flows
+	FlowKeyPrefix = "flow"
+
+	// PendingSendPacketKeyPrefix defines the key prefix used to store pending send packets
+	PendingSendPacketKeyPrefix = "pendingSendPacket"
+
+	// PendingRecvPacketKeyPrefix defines the key prefix used to store pending receive packets
+	PendingRecvPacketKeyPrefix = "pendingRecvPacket"
+)
+
+// KeyFlow creates and returns a new key used for channel flow store operations
+func KeyFlow(channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", FlowKeyPrefix, channelID, denom))
+}
+
+// KeyPendingSendPacket creates and returns a new key used for pending send packet store operations
+func KeyPendingSendPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", PendingSendPacketKeyPrefix, portID, channelID, sequence))
+}
+
+// KeyPendingRecvPacket creates and returns a new key used for pending receive packet store operations
+func KeyPendingRecvPacket(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%d", PendingRecvPacketKeyPrefix, portID, channelID, sequence))
+}
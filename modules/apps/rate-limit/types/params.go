@@ -0,0 +1,79 @@
+package types
+
+import (
+	"fmt"
+
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+var (
+	// KeyRateLimits is store's key for RateLimits Params
+	KeyRateLimits = []byte("RateLimits")
+)
+
+// ParamKeyTable type declaration for parameters
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// NewParams creates a new parameter configuration for the rate limit middleware
+func NewParams(rateLimits ...RateLimit) Params {
+	return Params{
+		RateLimits: rateLimits,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the rate limit middleware.
+// No rate limits are applied by default.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate validates all rate limit middleware parameters
+func (p Params) Validate() error {
+	return validateRateLimits(p.RateLimits)
+}
+
+// GetRateLimit returns the rate limit of the denomination over the channel if it exists
+func (p Params) GetRateLimit(channelID, denom string) (RateLimit, bool) {
+	for _, rateLimit := range p.RateLimits {
+		if rateLimit.ChannelId == channelID && rateLimit.Denom == denom {
+			return rateLimit, true
+		}
+	}
+
+	return RateLimit{}, false
+}
+
+// ParamSetPairs implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyRateLimits, &p.RateLimits, validateRateLimitsParam),
+	}
+}
+
+func validateRateLimitsParam(i interface{}) error {
+	rateLimits, ok := i.([]RateLimit)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateRateLimits(rateLimits)
+}
+
+func validateRateLimits(rateLimits []RateLimit) error {
+	seen := make(map[string]bool)
+	for _, rateLimit := range rateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+
+		key := string(KeyFlow(rateLimit.ChannelId, rateLimit.Denom))
+		if seen[key] {
+			return fmt.Errorf("duplicate rate limit for channel %s and denom %s", rateLimit.ChannelId, rateLimit.Denom)
+		}
+		seen[key] = true
+	}
+
+	return nil
+}
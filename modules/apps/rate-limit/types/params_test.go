@@ -0,0 +1,42 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	quota := types.NewQuota(10, 10, nilInt, nilInt, time.Hour)
+
+	require.NoError(t, types.DefaultParams().Validate())
+	require.NoError(t, types.NewParams(
+		types.NewRateLimit("channel-0", "stake", quota),
+		types.NewRateLimit("channel-1", "stake", quota),
+		types.NewRateLimit("channel-0", "atom", quota),
+	).Validate())
+
+	// duplicate rate limit
+	require.Error(t, types.NewParams(
+		types.NewRateLimit("channel-0", "stake", quota),
+		types.NewRateLimit("channel-0", "stake", quota),
+	).Validate())
+
+	// invalid rate limit
+	require.Error(t, types.NewParams(types.NewRateLimit("channel-0", "stake", types.Quota{})).Validate())
+}
+
+func TestGetRateLimit(t *testing.T) {
+	rateLimit := types.NewRateLimit("channel-0", "stake", types.NewQuota(10, 10, nilInt, nilInt, time.Hour))
+	params := types.NewParams(rateLimit)
+
+	actual, found := params.GetRateLimit("channel-0", "stake")
+	require.True(t, found)
+	require.Equal(t, rateLimit, actual)
+
+	_, found = params.GetRateLimit("channel-1", "stake")
+	require.False(t, found)
+}
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewRateLimit creates a new RateLimit instance
+func NewRateLimit(channelID, denom string, quota Quota) RateLimit {
+	return RateLimit{
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+	}
+}
+
+// Validate performs a basic validation of the rate limit fields
+func (rl RateLimit) Validate() error {
+	if err := host.ChannelIdentifierValidator(rl.ChannelId); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "invalid channel: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(rl.Denom); err != nil {
+		return sdkerrors.Wrapf(ErrInvalidRateLimit, "invalid denom: %s", err.Error())
+	}
+
+	return rl.Quota.Validate()
+}
+
+// NewQuota creates a new Quota instance
+func NewQuota(maxPercentSend, maxPercentRecv uint64, maxAmountSend, maxAmountRecv sdk.Int, duration time.Duration) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		MaxAmountSend:  maxAmountSend,
+		MaxAmountRecv:  maxAmountRecv,
+		Duration:       duration,
+	}
+}
+
+// Validate performs a basic validation of the quota fields
+func (q Quota) Validate() error {
+	if q.MaxPercentSend > 100 || q.MaxPercentRecv > 100 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "quota percentage cannot be greater than 100")
+	}
+
+	if (!q.MaxAmountSend.IsNil() && q.MaxAmountSend.IsNegative()) || (!q.MaxAmountRecv.IsNil() && q.MaxAmountRecv.IsNegative()) {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "quota amount cannot be negative")
+	}
+
+	if q.Duration <= 0 {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "quota duration must be positive")
+	}
+
+	_, sendLimited := q.SendThreshold(sdk.ZeroInt())
+	_, recvLimited := q.RecvThreshold(sdk.ZeroInt())
+	if !sendLimited && !recvLimited {
+		return sdkerrors.Wrap(ErrInvalidRateLimit, "quota must limit sends or receives")
+	}
+
+	return nil
+}
+
+// SendThreshold returns the maximum outflow of a window for the provided channel value.
+// False is returned if sends are not limited.
+func (q Quota) SendThreshold(channelValue sdk.Int) (sdk.Int, bool) {
+	return threshold(q.MaxPercentSend, q.MaxAmountSend, channelValue)
+}
+
+// RecvThreshold returns the maximum inflow of a window for the provided channel value.
+// False is returned if receives are not limited.
+func (q Quota) RecvThreshold(channelValue sdk.Int) (sdk.Int, bool) {
+	return threshold(q.MaxPercentRecv, q.MaxAmountRecv, channelValue)
+}
+
+// threshold returns the lower of the percentage of the channel value and the absolute amount
+// which are set.
+func threshold(percent uint64, amount sdk.Int, channelValue sdk.Int) (sdk.Int, bool) {
+	var (
+		max     sdk.Int
+		limited bool
+	)
+
+	if percent > 0 {
+		max = channelValue.Mul(sdk.NewIntFromUint64(percent)).QuoRaw(100)
+		limited = true
+	}
+
+	if !amount.IsNil() && amount.IsPositive() {
+		if !limited || amount.LT(max) {
+			max = amount
+		}
+		limited = true
+	}
+
+	return max, limited
+}
+
+// NewFlow creates a new Flow instance for a window starting at the provided time
+func NewFlow(channelValue sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowStart:  windowStart,
+	}
+}
+
+// IsExpired returns true if the window of the flow has elapsed at the provided time
+func (f Flow) IsExpired(quota Quota, blockTime time.Time) bool {
+	return !blockTime.Before(f.WindowStart.Add(quota.Duration))
+}
+
+// AddOutflow adds the amount to the outflow. An error is returned and the flow is left
+// unchanged if the outflow would exceed the send quota.
+func (f *Flow) AddOutflow(amount sdk.Int, quota Quota) error {
+	outflow := f.Outflow.Add(amount)
+	if max, limited := quota.SendThreshold(f.ChannelValue); limited && outflow.GT(max) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "outflow %s exceeds send quota %s", outflow, max)
+	}
+
+	f.Outflow = outflow
+	return nil
+}
+
+// AddInflow adds the amount to the inflow. An error is returned and the flow is left
+// unchanged if the inflow would exceed the receive quota.
+func (f *Flow) AddInflow(amount sdk.Int, quota Quota) error {
+	inflow := f.Inflow.Add(amount)
+	if max, limited := quota.RecvThreshold(f.ChannelValue); limited && inflow.GT(max) {
+		return sdkerrors.Wrapf(ErrQuotaExceeded, "inflow %s exceeds receive quota %s", inflow, max)
+	}
+
+	f.Inflow = inflow
+	return nil
+}
+
+// SubOutflow subtracts the amount from the outflow. The outflow does not go below zero.
+func (f *Flow) SubOutflow(amount sdk.Int) {
+	f.Outflow = sdk.MaxInt(f.Outflow.Sub(amount), sdk.ZeroInt())
+}
+
+// SubInflow subtracts the amount from the inflow. The inflow does not go below zero.
+func (f *Flow) SubInflow(amount sdk.Int) {
+	f.Inflow = sdk.MaxInt(f.Inflow.Sub(amount), sdk.ZeroInt())
+}
+
+// RemainingSendQuota returns the amount which may still be sent within the window.
+// False is returned if sends are not limited.
+func (f Flow) RemainingSendQuota(quota Quota) (sdk.Int, bool) {
+	max, limited := quota.SendThreshold(f.ChannelValue)
+	if !limited {
+		return sdk.Int{}, false
+	}
+
+	return sdk.MaxInt(max.Sub(f.Outflow), sdk.ZeroInt()), true
+}
+
+// RemainingRecvQuota returns the amount which may still be received within the window.
+// False is returned if receives are not limited.
+func (f Flow) RemainingRecvQuota(quota Quota) (sdk.Int, bool) {
+	max, limited := quota.RecvThreshold(f.ChannelValue)
+	if !limited {
+		return sdk.Int{}, false
+	}
+
+	return sdk.MaxInt(max.Sub(f.Inflow), sdk.ZeroInt()), true
+}
+
+// NewChannelFlow creates a new ChannelFlow instance
+func NewChannelFlow(channelID, denom string, flow Flow) ChannelFlow {
+	return ChannelFlow{
+		ChannelId: channelID,
+		Denom:     denom,
+		Flow:      flow,
+	}
+}
+
+// NewPendingSendPacket creates a new PendingSendPacket instance
+func NewPendingSendPacket(portID, channelID string, sequence uint64, windowStart time.Time) PendingSendPacket {
+	return PendingSendPacket{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		WindowStart: windowStart,
+	}
+}
+
+// NewPendingRecvPacket creates a new PendingRecvPacket instance
+func NewPendingRecvPacket(portID, channelID string, sequence uint64, windowStart time.Time) PendingRecvPacket {
+	return PendingRecvPacket{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Sequence:    sequence,
+		WindowStart: windowStart,
+	}
+}
+
+// NewRateLimitStatus creates a new RateLimitStatus for the rate limit and the flow of the current window
+func NewRateLimitStatus(rateLimit RateLimit, flow Flow) RateLimitStatus {
+	status := RateLimitStatus{
+		RateLimit: rateLimit,
+		Flow:      flow,
+	}
+
+	if remaining, limited := flow.RemainingSendQuota(rateLimit.Quota); limited {
+		status.RemainingSendQuota = &remaining
+	}
+
+	if remaining, limited := flow.RemainingRecvQuota(rateLimit.Quota); limited {
+		status.RemainingRecvQuota = &remaining
+	}
+
+	return status
+}
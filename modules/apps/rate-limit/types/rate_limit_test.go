@@ -0,0 +1,143 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/rate-limit/types"
+)
+
+var (
+	nilInt      = sdk.Int{}
+	windowStart = time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	quota := types.NewQuota(10, 10, nilInt, nilInt, time.Hour)
+
+	testCases := []struct {
+		name      string
+		rateLimit types.RateLimit
+		expPass   bool
+	}{
+		{"valid rate limit", types.NewRateLimit("channel-0", "stake", quota), true},
+		{"valid voucher rate limit", types.NewRateLimit("channel-0", "ibc/7F1D3FCF4AE79E1554D670D1AD949A9BA4E4A3C76C63093E17E446A46061A7A2", quota), true},
+		{"invalid channel", types.NewRateLimit("", "stake", quota), false},
+		{"invalid denom", types.NewRateLimit("channel-0", "", quota), false},
+		{"invalid quota", types.NewRateLimit("channel-0", "stake", types.Quota{}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.rateLimit.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		quota   types.Quota
+		expPass bool
+	}{
+		{"percentage quota", types.NewQuota(10, 20, nilInt, nilInt, time.Hour), true},
+		{"amount quota", types.NewQuota(0, 0, sdk.NewInt(100), sdk.NewInt(200), time.Hour), true},
+		{"send only quota", types.NewQuota(10, 0, nilInt, nilInt, time.Hour), true},
+		{"receive only quota", types.NewQuota(0, 0, nilInt, sdk.NewInt(100), time.Hour), true},
+		{"percentage greater than 100", types.NewQuota(101, 0, nilInt, nilInt, time.Hour), false},
+		{"negative amount", types.NewQuota(10, 0, sdk.NewInt(-1), nilInt, time.Hour), false},
+		{"zero duration", types.NewQuota(10, 10, nilInt, nilInt, 0), false},
+		{"no limit", types.NewQuota(0, 0, sdk.ZeroInt(), nilInt, time.Hour), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.quota.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestQuotaThreshold(t *testing.T) {
+	channelValue := sdk.NewInt(1000)
+
+	testCases := []struct {
+		name       string
+		quota      types.Quota
+		expLimited bool
+		expSend    sdk.Int
+		expRecv    sdk.Int
+	}{
+		{"percentage", types.NewQuota(10, 20, nilInt, nilInt, time.Hour), true, sdk.NewInt(100), sdk.NewInt(200)},
+		{"amount", types.NewQuota(0, 0, sdk.NewInt(50), sdk.NewInt(60), time.Hour), true, sdk.NewInt(50), sdk.NewInt(60)},
+		{"lower amount", types.NewQuota(10, 20, sdk.NewInt(50), sdk.NewInt(500), time.Hour), true, sdk.NewInt(50), sdk.NewInt(200)},
+		{"unlimited", types.NewQuota(0, 0, nilInt, nilInt, time.Hour), false, nilInt, nilInt},
+	}
+
+	for _, tc := range testCases {
+		send, limited := tc.quota.SendThreshold(channelValue)
+		require.Equal(t, tc.expLimited, limited, tc.name)
+		require.Equal(t, tc.expSend, send, tc.name)
+
+		recv, limited := tc.quota.RecvThreshold(channelValue)
+		require.Equal(t, tc.expLimited, limited, tc.name)
+		require.Equal(t, tc.expRecv, recv, tc.name)
+	}
+}
+
+func TestFlow(t *testing.T) {
+	quota := types.NewQuota(10, 0, nilInt, sdk.NewInt(50), time.Hour)
+	flow := types.NewFlow(sdk.NewInt(1000), windowStart)
+
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(60), quota))
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(40), quota))
+	require.ErrorIs(t, flow.AddOutflow(sdk.NewInt(1), quota), types.ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), flow.Outflow)
+
+	remaining, limited := flow.RemainingSendQuota(quota)
+	require.True(t, limited)
+	require.Equal(t, sdk.ZeroInt(), remaining)
+
+	flow.SubOutflow(sdk.NewInt(30))
+	require.Equal(t, sdk.NewInt(70), flow.Outflow)
+	flow.SubOutflow(sdk.NewInt(100))
+	require.Equal(t, sdk.ZeroInt(), flow.Outflow)
+
+	require.ErrorIs(t, flow.AddInflow(sdk.NewInt(51), quota), types.ErrQuotaExceeded)
+	require.NoError(t, flow.AddInflow(sdk.NewInt(20), quota))
+
+	remaining, limited = flow.RemainingRecvQuota(quota)
+	require.True(t, limited)
+	require.Equal(t, sdk.NewInt(30), remaining)
+
+	flow.SubInflow(sdk.NewInt(5))
+	require.Equal(t, sdk.NewInt(15), flow.Inflow)
+	flow.SubInflow(sdk.NewInt(100))
+	require.Equal(t, sdk.ZeroInt(), flow.Inflow)
+
+	require.False(t, flow.IsExpired(quota, windowStart.Add(time.Hour-1)))
+	require.True(t, flow.IsExpired(quota, windowStart.Add(time.Hour)))
+}
+
+func TestNewRateLimitStatus(t *testing.T) {
+	rateLimit := types.NewRateLimit("channel-0", "stake", types.NewQuota(10, 0, nilInt, nilInt, time.Hour))
+	flow := types.NewFlow(sdk.NewInt(1000), windowStart)
+	require.NoError(t, flow.AddOutflow(sdk.NewInt(40), rateLimit.Quota))
+
+	status := types.NewRateLimitStatus(rateLimit, flow)
+	require.Equal(t, rateLimit, status.RateLimit)
+	require.Equal(t, flow, status.Flow)
+	require.NotNil(t, status.RemainingSendQuota)
+	require.Equal(t, sdk.NewInt(60), *status.RemainingSendQuota)
+
+	// receives are not limited
+	require.Nil(t, status.RemainingRecvQuota)
+}
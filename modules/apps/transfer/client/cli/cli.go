@@ -18,9 +18,14 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdQueryDenomTrace(),
 		GetCmdQueryDenomTraces(),
+		GetCmdQueryDenomTracesByBaseDenom(),
+		GetCmdQueryDenomTracesByChannel(),
 		GetCmdParams(),
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
+		GetCmdQuerySendAllowed(),
+		GetCmdQueryReceiveAllowed(),
+		GetCmdQueryTotalEscrowForDenom(),
 	)
 
 	return queryCmd
@@ -82,6 +82,86 @@ func GetCmdQueryDenomTraces() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryDenomTracesByBaseDenom defines the command to query the denomination traces of a base denomination.
+func GetCmdQueryDenomTracesByBaseDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-base-denom [base-denom]",
+		Short:   "Query the trace info for all token denominations with the given base denomination",
+		Long:    "Query the trace info for all token denominations with the given base denomination",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-base-denom uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByBaseDenomRequest{
+				BaseDenom:  args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByBaseDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by base denomination")
+
+	return cmd
+}
+
+// GetCmdQueryDenomTracesByChannel defines the command to query the denomination traces of the tokens
+// received through a channel.
+func GetCmdQueryDenomTracesByChannel() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-traces-by-channel [port-id] [channel-id]",
+		Short:   "Query the trace info for all token denominations received through the given channel",
+		Long:    "Query the trace info for all token denominations received through the given channel",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-traces-by-channel transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomTracesByChannelRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomTracesByChannel(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "denominations trace by channel")
+
+	return cmd
+}
+
 // GetCmdParams returns the command handler for ibc-transfer parameter querying.
 func GetCmdParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -167,3 +247,101 @@ func GetCmdQueryDenomHash() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQuerySendAllowed defines the command to query whether a denomination may be sent over a channel.
+func GetCmdQuerySendAllowed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "send-allowed [channel-id] [denom]",
+		Short:   "Query whether a denomination may be sent over a channel and why it is refused",
+		Long:    "Query whether a denomination may be sent over a channel and why it is refused. The denomination is the denomination on this chain, e.g. uatom or ibc/{hash}.",
+		Example: fmt.Sprintf("%s query ibc-transfer send-allowed [channel-id] [denom]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QuerySendAllowedRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			res, err := queryClient.SendAllowed(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryReceiveAllowed defines the command to query whether a denomination may be received over a channel.
+func GetCmdQueryReceiveAllowed() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "receive-allowed [channel-id] [denom]",
+		Short:   "Query whether a denomination may be received over a channel and why it is refused",
+		Long:    "Query whether a denomination may be received over a channel and why it is refused. The denomination is the denomination in the packet data, i.e. the full denomination trace on the sending chain.",
+		Example: fmt.Sprintf("%s query ibc-transfer receive-allowed [channel-id] [denom]", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryReceiveAllowedRequest{
+				ChannelId: args[0],
+				Denom:     args[1],
+			}
+
+			res, err := queryClient.ReceiveAllowed(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTotalEscrowForDenom defines the command to query the total amount of tokens in escrow for a denomination.
+func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "total-escrow [denom]",
+		Short:   "Query the total amount of tokens in escrow for a denom",
+		Long:    "Query the total amount of tokens in escrow across all channels for a denomination on this chain, e.g. uatom or ibc/{hash}.",
+		Example: fmt.Sprintf("%s query ibc-transfer total-escrow uatom", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTotalEscrowForDenomRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.TotalEscrowForDenom(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -29,6 +29,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 
 	k.SetParams(ctx, state.Params)
 
+	for _, coin := range state.TotalEscrowed {
+		k.SetTotalEscrowForDenom(ctx, coin)
+	}
+
+	for _, escrowAccount := range state.EscrowAccounts {
+		k.SetEscrowAccount(ctx, escrowAccount)
+	}
+
 	// check if the module account exists
 	moduleAcc := k.GetTransferAccount(ctx)
 	if moduleAcc == nil {
@@ -36,11 +44,14 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 	}
 }
 
-// ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
+// ExportGenesis exports ibc-transfer module's portID, denom trace info, total escrow
+// amounts and escrow accounts into its genesis state.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		PortId:      k.GetPort(ctx),
-		DenomTraces: k.GetAllDenomTraces(ctx),
-		Params:      k.GetParams(ctx),
+		PortId:         k.GetPort(ctx),
+		DenomTraces:    k.GetAllDenomTraces(ctx),
+		Params:         k.GetParams(ctx),
+		TotalEscrowed:  k.GetAllTotalEscrowed(ctx),
+		EscrowAccounts: k.GetAllEscrowAccounts(ctx),
 	}
 }
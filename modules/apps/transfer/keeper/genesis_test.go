@@ -3,13 +3,16 @@ package keeper_test
 import (
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
 )
 
 func (suite *KeeperTestSuite) TestGenesis() {
 	var (
-		path   string
-		traces types.Traces
+		path          string
+		traces        types.Traces
+		totalEscrowed sdk.Coins
 	)
 
 	for i := 0; i < 5; i++ {
@@ -26,14 +29,32 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		}
 		traces = append(types.Traces{denomTrace}, traces...)
 		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+
+		escrow := sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(int64(i+1)))
+		totalEscrowed = totalEscrowed.Add(escrow)
+		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), escrow)
 	}
 
+	escrowAccounts := []types.EscrowAccount{types.NewEscrowAccount(types.PortID, "channel-0")}
+	suite.chainA.GetSimApp().TransferKeeper.SetEscrowAccount(suite.chainA.GetContext(), escrowAccounts[0])
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(traces.Sort(), genesis.DenomTraces)
+	suite.Require().Equal(totalEscrowed.String(), genesis.TotalEscrowed.String())
+	suite.Require().Equal(escrowAccounts, genesis.EscrowAccounts)
+
+	// clear the total escrow amounts to check that they are imported
+	for _, escrow := range totalEscrowed {
+		suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(escrow.Denom, sdk.ZeroInt()))
+	}
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
 	})
+
+	for _, escrow := range totalEscrowed {
+		suite.Require().Equal(escrow.String(), suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), escrow.Denom).String())
+	}
 }
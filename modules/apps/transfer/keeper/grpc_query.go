@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -12,6 +13,8 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -72,6 +75,79 @@ func (q Keeper) DenomTraces(c context.Context, req *types.QueryDenomTracesReques
 	}, nil
 }
 
+// DenomTracesByBaseDenom implements the Query/DenomTracesByBaseDenom gRPC method
+func (q Keeper) DenomTracesByBaseDenom(c context.Context, req *types.QueryDenomTracesByBaseDenomRequest) (*types.QueryDenomTracesByBaseDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if strings.TrimSpace(req.BaseDenom) == "" {
+		return nil, status.Error(codes.InvalidArgument, "base denomination cannot be blank")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomTraceByBaseDenomPrefixKey(req.BaseDenom))
+
+	traces, pageRes, err := q.paginateDenomTraceIndex(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByBaseDenomResponse{
+		DenomTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+// DenomTracesByChannel implements the Query/DenomTracesByChannel gRPC method
+func (q Keeper) DenomTracesByChannel(c context.Context, req *types.QueryDenomTracesByChannelRequest) (*types.QueryDenomTracesByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.PortIdentifierValidator(req.PortId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := prefix.NewStore(ctx.KVStore(q.storeKey), types.DenomTraceByChannelPrefixKey(req.PortId, req.ChannelId))
+
+	traces, pageRes, err := q.paginateDenomTraceIndex(ctx, store, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomTracesByChannelResponse{
+		DenomTraces: traces.Sort(),
+		Pagination:  pageRes,
+	}, nil
+}
+
+// paginateDenomTraceIndex paginates over a denomination trace index store whose keys
+// are the hashes of the indexed denomination traces.
+func (q Keeper) paginateDenomTraceIndex(ctx sdk.Context, store prefix.Store, pagination *query.PageRequest) (types.Traces, *query.PageResponse, error) {
+	traces := types.Traces{}
+	pageRes, err := query.Paginate(store, pagination, func(key, _ []byte) error {
+		denomTrace, found := q.GetDenomTrace(ctx, key)
+		if !found {
+			return sdkerrors.Wrapf(types.ErrTraceNotFound, "indexed denomination trace %X", key)
+		}
+
+		traces = append(traces, denomTrace)
+		return nil
+	})
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return traces, pageRes, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (q Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
@@ -108,3 +184,101 @@ func (q Keeper) DenomHash(c context.Context, req *types.QueryDenomHashRequest) (
 		Hash: denomHash.String(),
 	}, nil
 }
+
+// SendAllowed implements the Query/SendAllowed gRPC method
+func (q Keeper) SendAllowed(c context.Context, req *types.QuerySendAllowedRequest) (*types.QuerySendAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	fullDenomPath := req.Denom
+	if strings.HasPrefix(req.Denom, "ibc/") {
+		var err error
+		if fullDenomPath, err = q.DenomPathFromHash(ctx, req.Denom); err != nil {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+	}
+
+	denomTrace := types.ParseDenomTrace(fullDenomPath)
+	if err := denomTrace.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	res := &types.QuerySendAllowedResponse{
+		Allowed:    true,
+		DenomTrace: denomTrace,
+	}
+
+	if err := q.GetParams(ctx).CheckSend(req.ChannelId, denomTrace); err != nil {
+		res.Allowed = false
+		res.Reason = err.Error()
+	}
+
+	return res, nil
+}
+
+// ReceiveAllowed implements the Query/ReceiveAllowed gRPC method
+func (q Keeper) ReceiveAllowed(c context.Context, req *types.QueryReceiveAllowedRequest) (*types.QueryReceiveAllowedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := types.ValidatePrefixedDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	portID := q.GetPort(ctx)
+	channel, found := q.channelKeeper.GetChannel(ctx, portID, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			sdkerrors.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, req.ChannelId).Error(),
+		)
+	}
+
+	denomTrace := types.GetReceivedDenomTrace(
+		channel.GetCounterparty().GetPortID(), channel.GetCounterparty().GetChannelID(), portID, req.ChannelId, req.Denom,
+	)
+
+	res := &types.QueryReceiveAllowedResponse{
+		Allowed:    true,
+		DenomTrace: denomTrace,
+	}
+
+	if err := q.GetParams(ctx).CheckReceive(req.ChannelId, denomTrace); err != nil {
+		res.Allowed = false
+		res.Reason = err.Error()
+	}
+
+	return res, nil
+}
+
+// TotalEscrowForDenom implements the Query/TotalEscrowForDenom gRPC method
+func (q Keeper) TotalEscrowForDenom(c context.Context, req *types.QueryTotalEscrowForDenomRequest) (*types.QueryTotalEscrowForDenomResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	amount := q.GetTotalEscrowForDenom(ctx, req.Denom)
+
+	return &types.QueryTotalEscrowForDenomResponse{
+		Amount: amount,
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
 )
 
 func (suite *KeeperTestSuite) TestQueryDenomTrace() {
@@ -135,6 +136,184 @@ func (suite *KeeperTestSuite) TestQueryDenomTraces() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestQueryDenomTracesByBaseDenom() {
+	var (
+		req       *types.QueryDenomTracesByBaseDenomRequest
+		expTraces types.Traces
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"blank base denomination",
+			func() {
+				req = &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: " "}
+			},
+			false,
+		},
+		{
+			"no traces for base denomination",
+			func() {
+				req = &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "uatom"}
+			},
+			true,
+		},
+		{
+			"success",
+			func() {
+				expTraces = append(expTraces, types.DenomTrace{Path: "", BaseDenom: "uatom"})
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channelToB", BaseDenom: "uatom"})
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channelToA/transfer/channelToB", BaseDenom: "uatom"})
+
+				for _, trace := range expTraces {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+				}
+
+				// traces of other base denominations are not returned
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), types.DenomTrace{Path: "transfer/channelToB", BaseDenom: "uatom2"})
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), types.DenomTrace{Path: "transfer/channelToB", BaseDenom: "uosmo"})
+
+				req = &types.QueryDenomTracesByBaseDenomRequest{
+					BaseDenom: "uatom",
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expTraces = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTracesByBaseDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTracesByChannel() {
+	var (
+		req       *types.QueryDenomTracesByChannelRequest
+		expTraces types.Traces
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid port ID",
+			func() {
+				req = &types.QueryDenomTracesByChannelRequest{PortId: "", ChannelId: "channel-0"}
+			},
+			false,
+		},
+		{
+			"invalid channel ID",
+			func() {
+				req = &types.QueryDenomTracesByChannelRequest{PortId: types.PortID, ChannelId: ""}
+			},
+			false,
+		},
+		{
+			"success",
+			func() {
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"})
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uosmo"})
+				expTraces = append(expTraces, types.DenomTrace{Path: "transfer/channel-1/transfer/channel-5", BaseDenom: "uatom"})
+
+				for _, trace := range expTraces {
+					suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+				}
+
+				// native traces and traces received through other channels are not returned
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), types.DenomTrace{Path: "", BaseDenom: "uatom"})
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), types.DenomTrace{Path: "transfer/channel-10", BaseDenom: "uatom"})
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), types.DenomTrace{Path: "transfer/channel-5/transfer/channel-1", BaseDenom: "uatom"})
+
+				req = &types.QueryDenomTracesByChannelRequest{
+					PortId:    types.PortID,
+					ChannelId: "channel-1",
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expTraces = nil
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.DenomTracesByChannel(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expTraces.Sort(), res.DenomTraces)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomTracesByChannelPagination() {
+	traces := types.Traces{}
+	for i := 0; i < 5; i++ {
+		trace := types.DenomTrace{Path: "transfer/channel-0", BaseDenom: fmt.Sprintf("denom%d", i)}
+		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), trace)
+		traces = append(traces, trace)
+	}
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	req := &types.QueryDenomTracesByChannelRequest{
+		PortId:     types.PortID,
+		ChannelId:  "channel-0",
+		Pagination: &query.PageRequest{Limit: 3, CountTotal: true},
+	}
+
+	res, err := suite.queryClient.DenomTracesByChannel(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(res.DenomTraces, 3)
+	suite.Require().Equal(uint64(5), res.Pagination.Total)
+	suite.Require().NotNil(res.Pagination.NextKey)
+
+	req.Pagination = &query.PageRequest{Key: res.Pagination.NextKey}
+	nextRes, err := suite.queryClient.DenomTracesByChannel(ctx, req)
+	suite.Require().NoError(err)
+	suite.Require().Len(nextRes.DenomTraces, 2)
+	suite.Require().Nil(nextRes.Pagination.NextKey)
+
+	suite.Require().ElementsMatch(traces, append(res.DenomTraces, nextRes.DenomTraces...))
+}
+
 func (suite *KeeperTestSuite) TestQueryParams() {
 	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
 	expParams := types.DefaultParams()
@@ -208,3 +387,282 @@ func (suite *KeeperTestSuite) TestQueryDenomHash() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQuerySendAllowed() {
+	var (
+		req        *types.QuerySendAllowedRequest
+		expRes     *types.QuerySendAllowedResponse
+		voucher    = types.ParseDenomTrace("transfer/channel-1/uatom")
+		blockAtoms = types.NewTransferFilter("", false, nil, []string{"uatom"})
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid channel",
+			func() {
+				req = &types.QuerySendAllowedRequest{ChannelId: "channel", Denom: sdk.DefaultBondDenom}
+			},
+			false,
+		},
+		{
+			"denom trace not found",
+			func() {
+				req = &types.QuerySendAllowedRequest{ChannelId: ibctesting.FirstChannelID, Denom: types.ParseDenomTrace("transfer/channel-2/uatom").IBCDenom()}
+			},
+			false,
+		},
+		{
+			"allowed",
+			func() {
+				req = &types.QuerySendAllowedRequest{ChannelId: ibctesting.FirstChannelID, Denom: sdk.DefaultBondDenom}
+				expRes = &types.QuerySendAllowedResponse{Allowed: true, DenomTrace: types.ParseDenomTrace(sdk.DefaultBondDenom)}
+			},
+			true,
+		},
+		{
+			"sends disabled",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true, nil, nil, true))
+
+				req = &types.QuerySendAllowedRequest{ChannelId: ibctesting.FirstChannelID, Denom: sdk.DefaultBondDenom}
+				expRes = &types.QuerySendAllowedResponse{Allowed: false, Reason: types.ErrSendDisabled.Error(), DenomTrace: types.ParseDenomTrace(sdk.DefaultBondDenom)}
+			},
+			true,
+		},
+		{
+			"voucher blocked by ibc denom",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), voucher)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, []types.TransferFilter{blockAtoms}, nil, true))
+
+				req = &types.QuerySendAllowedRequest{ChannelId: ibctesting.FirstChannelID, Denom: voucher.IBCDenom()}
+				expRes = &types.QuerySendAllowedResponse{
+					Allowed:    false,
+					Reason:     blockAtoms.Check(ibctesting.FirstChannelID, voucher).Error(),
+					DenomTrace: voucher,
+				}
+			},
+			true,
+		},
+		{
+			"voucher blocked by full denom trace",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, []types.TransferFilter{blockAtoms}, nil, true))
+
+				req = &types.QuerySendAllowedRequest{ChannelId: ibctesting.FirstChannelID, Denom: voucher.GetFullDenomPath()}
+				expRes = &types.QuerySendAllowedResponse{
+					Allowed:    false,
+					Reason:     blockAtoms.Check(ibctesting.FirstChannelID, voucher).Error(),
+					DenomTrace: voucher,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.SendAllowed(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryReceiveAllowed() {
+	var (
+		path   *ibctesting.Path
+		req    *types.QueryReceiveAllowedRequest
+		expRes *types.QueryReceiveAllowedResponse
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"invalid channel",
+			func() {
+				req = &types.QueryReceiveAllowedRequest{ChannelId: "channel", Denom: sdk.DefaultBondDenom}
+			},
+			false,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryReceiveAllowedRequest{ChannelId: path.EndpointA.ChannelID, Denom: "transfer/uatom"}
+			},
+			false,
+		},
+		{
+			"channel not found",
+			func() {
+				req = &types.QueryReceiveAllowedRequest{ChannelId: "channel-100", Denom: sdk.DefaultBondDenom}
+			},
+			false,
+		},
+		{
+			"voucher allowed",
+			func() {
+				req = &types.QueryReceiveAllowedRequest{ChannelId: path.EndpointA.ChannelID, Denom: sdk.DefaultBondDenom}
+				expRes = &types.QueryReceiveAllowedResponse{
+					Allowed:    true,
+					DenomTrace: types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)),
+				}
+			},
+			true,
+		},
+		{
+			"voucher not allowed on channel",
+			func() {
+				filter := types.NewTransferFilter(path.EndpointA.ChannelID, false, []string{"uatom"}, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, nil, []types.TransferFilter{filter}, true))
+
+				denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+				req = &types.QueryReceiveAllowedRequest{ChannelId: path.EndpointA.ChannelID, Denom: sdk.DefaultBondDenom}
+				expRes = &types.QueryReceiveAllowedResponse{
+					Allowed:    false,
+					Reason:     filter.Check(path.EndpointA.ChannelID, denomTrace).Error(),
+					DenomTrace: denomTrace,
+				}
+			},
+			true,
+		},
+		{
+			"returning tokens blocked",
+			func() {
+				filter := types.NewTransferFilter("", false, nil, []string{sdk.DefaultBondDenom})
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, nil, []types.TransferFilter{filter}, true))
+
+				denomTrace := types.ParseDenomTrace(sdk.DefaultBondDenom)
+				req = &types.QueryReceiveAllowedRequest{
+					ChannelId: path.EndpointA.ChannelID,
+					Denom:     types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom),
+				}
+				expRes = &types.QueryReceiveAllowedResponse{
+					Allowed:    false,
+					Reason:     filter.Check(path.EndpointA.ChannelID, denomTrace).Error(),
+					DenomTrace: denomTrace,
+				}
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ReceiveAllowed(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expRes, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
+	var (
+		req       *types.QueryTotalEscrowForDenomRequest
+		expEscrow sdk.Coin
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"valid native denom with escrow amount",
+			func() {
+				expEscrow = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), expEscrow)
+
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+			},
+			true,
+		},
+		{
+			"valid ibc denom with escrow amount",
+			func() {
+				denomTrace := types.DenomTrace{
+					Path:      "transfer/channel-0",
+					BaseDenom: sdk.DefaultBondDenom,
+				}
+
+				expEscrow = sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(100))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), expEscrow)
+
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: denomTrace.IBCDenom(),
+				}
+			},
+			true,
+		},
+		{
+			"valid denom without escrow amount",
+			func() {
+				expEscrow = sdk.NewCoin("uatom", sdk.ZeroInt())
+
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "uatom",
+				}
+			},
+			true,
+		},
+		{
+			"invalid denom",
+			func() {
+				req = &types.QueryTotalEscrowForDenomRequest{
+					Denom: "0uatom",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+
+			res, err := suite.queryClient.TotalEscrowForDenom(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expEscrow.String(), res.Amount.String())
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
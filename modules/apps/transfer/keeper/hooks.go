@@ -2,10 +2,83 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
-// AfterEpochEnd executes the indicated hook after epochs ends
-func (k Keeper) AfterTransferEnd(ctx sdk.Context, packet types.FungibleTokenPacketData, base_denom string) {
-	k.hooks.AfterTransferEnd(ctx, packet, base_denom)
+// BeforeSendTransfer executes the indicated hook before the tokens of an outgoing
+// transfer are escrowed or burned. An error returned by the hook aborts the transfer.
+func (k Keeper) BeforeSendTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, trace types.DenomTrace, denom string) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return k.hooks.BeforeSendTransfer(ctx, packet, data, trace, denom)
+}
+
+// AfterRecvTransfer executes the indicated hook after the tokens of an incoming
+// transfer are minted or unescrowed. An error returned by the hook, or a panic
+// raised by it, is returned so that it results in an error acknowledgement.
+func (k Keeper) AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, trace types.DenomTrace, denom string) error {
+	if k.hooks == nil {
+		return nil
+	}
+
+	return types.ApplyHookWithCache(ctx, func(cacheCtx sdk.Context) error {
+		return k.hooks.AfterRecvTransfer(cacheCtx, packet, data, trace, denom)
+	})
+}
+
+// AfterTransferEnd executes the indicated hook after a successful acknowledgement
+// of an outgoing transfer. A hook failure is isolated and reported through an event.
+func (k Keeper) AfterTransferEnd(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, trace types.DenomTrace, denom string) {
+	if k.hooks == nil {
+		return
+	}
+
+	err := types.ApplyHookWithCache(ctx, func(cacheCtx sdk.Context) error {
+		return k.hooks.AfterTransferEnd(cacheCtx, packet, data, trace, denom)
+	})
+	k.handleHookError(ctx, "AfterTransferEnd", packet, err)
+}
+
+// AfterRefundTransfer executes the indicated hook after the sender of an outgoing
+// transfer is refunded due to an error acknowledgement. A hook failure is isolated
+// and reported through an event.
+func (k Keeper) AfterRefundTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, trace types.DenomTrace, denom string) {
+	if k.hooks == nil {
+		return
+	}
+
+	err := types.ApplyHookWithCache(ctx, func(cacheCtx sdk.Context) error {
+		return k.hooks.AfterRefundTransfer(cacheCtx, packet, data, trace, denom)
+	})
+	k.handleHookError(ctx, "AfterRefundTransfer", packet, err)
+}
+
+// AfterTimeoutTransfer executes the indicated hook after the sender of an outgoing
+// transfer is refunded due to a timeout. A hook failure is isolated and reported
+// through an event.
+func (k Keeper) AfterTimeoutTransfer(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, trace types.DenomTrace, denom string) {
+	if k.hooks == nil {
+		return
+	}
+
+	err := types.ApplyHookWithCache(ctx, func(cacheCtx sdk.Context) error {
+		return k.hooks.AfterTimeoutTransfer(cacheCtx, packet, data, trace, denom)
+	})
+	k.handleHookError(ctx, "AfterTimeoutTransfer", packet, err)
+}
+
+// handleHookError logs and emits an event for a failed hook execution. It is a no-op
+// if the hook returned without error.
+func (k Keeper) handleHookError(ctx sdk.Context, hook string, packet channeltypes.Packet, err error) {
+	if err == nil {
+		return
+	}
+
+	k.Logger(ctx).Error("transfer hook failed", "hook", hook, "port-id", packet.GetSourcePort(), "channel-id", packet.GetSourceChannel(), "sequence", packet.GetSequence(), "error", err.Error())
+
+	ctx.EventManager().EmitEvent(types.NewHookErrorEvent(hook, packet, err))
 }
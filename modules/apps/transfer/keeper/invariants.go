@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// RegisterInvariants registers all transfer invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "voucher-denom-traces",
+		VoucherDenomTracesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom",
+		TotalEscrowPerDenomInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "escrow-account-channels",
+		EscrowAccountChannelsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the transfer module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := VoucherDenomTracesInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		res, stop = TotalEscrowPerDenomInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return EscrowAccountChannelsInvariant(k)(ctx)
+	}
+}
+
+// VoucherDenomTracesInvariant checks that a denomination trace is stored for every
+// IBC voucher denomination which has a supply.
+func VoucherDenomTracesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.bankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			if !strings.HasPrefix(coin.Denom, types.DenomPrefix+"/") {
+				return false
+			}
+
+			hash, err := types.ParseHexHash(coin.Denom[len(types.DenomPrefix+"/"):])
+			if err != nil || !k.HasDenomTrace(ctx, hash) {
+				count++
+				msg += fmt.Sprintf("\tno denomination trace found for voucher supply %s\n", coin)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "voucher denom traces",
+			fmt.Sprintf("found %d voucher denominations without a denomination trace\n%s", count, msg),
+		), broken
+	}
+}
+
+// TotalEscrowPerDenomInvariant checks that the total amount of tokens in escrow tracked for
+// each denomination, i.e. the amount of vouchers outstanding on the counterparty chains, is
+// covered by the balances of the escrow accounts of the transfer channels. The balances may
+// exceed the tracked amounts since tokens can be sent directly to an escrow account.
+func TotalEscrowPerDenomInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		expectedTotalEscrowed := k.GetAllTotalEscrowed(ctx)
+		actualTotalEscrowed := k.getEscrowBalances(ctx)
+
+		broken := !actualTotalEscrowed.IsAllGTE(expectedTotalEscrowed)
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "total escrow per denom",
+			fmt.Sprintf(
+				"\tsum of escrow account balances: %v\n\ttracked total escrow: %v\n",
+				actualTotalEscrowed, expectedTotalEscrowed,
+			),
+		), broken
+	}
+}
+
+// EscrowAccountChannelsInvariant checks that every escrow account which has been used to
+// escrow tokens is the escrow address of an existing channel.
+func EscrowAccountChannelsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		k.IterateEscrowAccounts(ctx, func(escrowAccount types.EscrowAccount) bool {
+			if err := escrowAccount.Validate(); err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s\n", err)
+				return false
+			}
+
+			if _, found := k.channelKeeper.GetChannel(ctx, escrowAccount.PortId, escrowAccount.ChannelId); !found {
+				count++
+				msg += fmt.Sprintf(
+					"\tescrow address %s does not map to an existing channel: port ID (%s) channel ID (%s)\n",
+					escrowAccount.Address, escrowAccount.PortId, escrowAccount.ChannelId,
+				)
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "escrow account channels",
+			fmt.Sprintf("found %d escrow accounts without a channel\n%s", count, msg),
+		), broken
+	}
+}
+
+// getEscrowBalances returns the sum of the balances of the escrow accounts of all channels
+// bound to the transfer port.
+func (k Keeper) getEscrowBalances(ctx sdk.Context) sdk.Coins {
+	portID := k.GetPort(ctx)
+
+	var balances sdk.Coins
+	for _, channel := range k.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		balances = balances.Add(k.bankKeeper.GetAllBalances(ctx, escrowAddress)...)
+	}
+
+	return balances
+}
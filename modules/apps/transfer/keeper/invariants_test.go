@@ -0,0 +1,199 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success: tokens escrowed by transfer",
+			func() {},
+			false,
+		},
+		{
+			"success: escrow balance exceeds total escrow",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, coins))
+			},
+			false,
+		},
+		{
+			"failure: total escrow exceeds escrow balance",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(101)))
+			},
+			true,
+		},
+		{
+			"failure: total escrow tracked for denom without escrow balance",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin("uatom", sdk.NewInt(1)))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+			)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg, broken := keeper.TotalEscrowPerDenomInvariant(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVoucherDenomTracesInvariant() {
+	denomTrace := types.ParseDenomTrace(types.GetPrefixedDenom("transfer", "channel-0", sdk.DefaultBondDenom))
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success: no voucher supply",
+			func() {},
+			false,
+		},
+		{
+			"success: voucher supply with denomination trace",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+				suite.mintCoins(sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(100)))
+			},
+			false,
+		},
+		{
+			"failure: voucher supply without denomination trace",
+			func() {
+				suite.mintCoins(sdk.NewCoin(denomTrace.IBCDenom(), sdk.NewInt(100)))
+			},
+			true,
+		},
+		{
+			"failure: voucher supply with invalid hash",
+			func() {
+				suite.mintCoins(sdk.NewCoin("ibc/invalidhash", sdk.NewInt(100)))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+
+			msg, broken := keeper.VoucherDenomTracesInvariant(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken, msg)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEscrowAccountChannelsInvariant() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		msg       string
+		malleate  func()
+		expBroken bool
+	}{
+		{
+			"success: escrow account of existing channel",
+			func() {},
+			false,
+		},
+		{
+			"failure: escrow account of non existent channel",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetEscrowAccount(suite.chainA.GetContext(), types.NewEscrowAccount(ibctesting.TransferPort, "channel-100"))
+			},
+			true,
+		},
+		{
+			"failure: escrow account with address of another channel",
+			func() {
+				escrowAccount := types.NewEscrowAccount(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				escrowAccount.Address = types.GetEscrowAddress(ibctesting.TransferPort, "channel-100").String()
+				suite.chainA.GetSimApp().TransferKeeper.SetEscrowAccount(suite.chainA.GetContext(), escrowAccount)
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			path = NewTransferPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			err := suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)),
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "",
+			)
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			msg, broken := keeper.EscrowAccountChannelsInvariant(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken, msg)
+
+			_, broken = keeper.AllInvariants(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+			suite.Require().Equal(tc.expBroken, broken)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestInvariantsRegistered() {
+	var routes []string
+	for _, route := range suite.chainA.GetSimApp().CrisisKeeper.Routes() {
+		if route.ModuleName == types.ModuleName {
+			routes = append(routes, route.Route)
+		}
+	}
+
+	suite.Require().Equal([]string{"voucher-denom-traces", "total-escrow-per-denom", "escrow-account-channels"}, routes)
+}
+
+// mintCoins mints the coins on chainA and sends them to the sender account.
+func (suite *KeeperTestSuite) mintCoins(coin sdk.Coin) {
+	coins := sdk.NewCoins(coin)
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.MintCoins(suite.chainA.GetContext(), minttypes.ModuleName, coins))
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), minttypes.ModuleName, suite.chainA.SenderAccount.GetAddress(), coins))
+}
@@ -113,11 +113,29 @@ func (k Keeper) HasDenomTrace(ctx sdk.Context, denomTraceHash tmbytes.HexBytes)
 	return store.Has(denomTraceHash)
 }
 
-// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store.
+// SetDenomTrace sets a new {trace hash -> denom trace} pair to the store and
+// indexes the trace by its base denomination and the channel the tokens were
+// received through.
 func (k Keeper) SetDenomTrace(ctx sdk.Context, denomTrace types.DenomTrace) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.DenomTraceKey)
 	bz := k.MustMarshalDenomTrace(denomTrace)
 	store.Set(denomTrace.Hash(), bz)
+
+	k.setDenomTraceIndexes(ctx, denomTrace)
+}
+
+// setDenomTraceIndexes sets the index entries of the denomination trace by its base
+// denomination and, if the trace path is not empty, by the channel the tokens were
+// received through.
+func (k Keeper) setDenomTraceIndexes(ctx sdk.Context, denomTrace types.DenomTrace) {
+	store := ctx.KVStore(k.storeKey)
+	traceHash := denomTrace.Hash()
+
+	store.Set(types.DenomTraceByBaseDenomKey(denomTrace.BaseDenom, traceHash), []byte{})
+
+	if portID, channelID, found := denomTrace.GetLastHop(); found {
+		store.Set(types.DenomTraceByChannelKey(portID, channelID, traceHash), []byte{})
+	}
 }
 
 // GetAllDenomTraces returns the trace information for all the denominations.
@@ -147,6 +165,107 @@ func (k Keeper) IterateDenomTraces(ctx sdk.Context, cb func(denomTrace types.Den
 	}
 }
 
+// GetTotalEscrowForDenom gets the total amount of source chain tokens that
+// are in escrow, keyed by the denomination.
+func (k Keeper) GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.TotalEscrowForDenomKey(denom))
+	if bz == nil {
+		return sdk.NewCoin(denom, sdk.ZeroInt())
+	}
+
+	var amount sdk.IntProto
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(denom, amount.Int)
+}
+
+// SetTotalEscrowForDenom stores the total amount of source chain tokens that are in escrow.
+// The store entry is removed if the amount is zero.
+func (k Keeper) SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.TotalEscrowForDenomKey(coin.Denom)
+
+	if coin.Amount.IsZero() {
+		store.Delete(key)
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	store.Set(key, bz)
+}
+
+// GetAllTotalEscrowed returns the escrow information for all the denominations.
+func (k Keeper) GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins {
+	var escrows sdk.Coins
+	k.IterateTokensInEscrow(ctx, func(coin sdk.Coin) bool {
+		escrows = escrows.Add(coin)
+		return false
+	})
+
+	return escrows
+}
+
+// IterateTokensInEscrow iterates over the denomination escrows in the store
+// and performs a callback function.
+func (k Keeper) IterateTokensInEscrow(ctx sdk.Context, cb func(coin sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.TotalEscrowPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.TotalEscrowPrefix):])
+
+		var amount sdk.IntProto
+		k.cdc.MustUnmarshal(iterator.Value(), &amount)
+
+		if cb(sdk.NewCoin(denom, amount.Int)) {
+			break
+		}
+	}
+}
+
+// HasEscrowAccount checks if the escrow account with the given escrow address exists on the store.
+func (k Keeper) HasEscrowAccount(ctx sdk.Context, escrowAddress sdk.AccAddress) bool {
+	store := ctx.KVStore(k.storeKey)
+	return store.Has(types.EscrowAccountKey(escrowAddress))
+}
+
+// SetEscrowAccount stores the escrow account of a channel which has been used to escrow tokens.
+func (k Keeper) SetEscrowAccount(ctx sdk.Context, escrowAccount types.EscrowAccount) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&escrowAccount)
+	store.Set(types.EscrowAccountKey(types.GetEscrowAddress(escrowAccount.PortId, escrowAccount.ChannelId)), bz)
+}
+
+// GetAllEscrowAccounts returns all the escrow accounts which have been used to escrow tokens.
+func (k Keeper) GetAllEscrowAccounts(ctx sdk.Context) []types.EscrowAccount {
+	escrowAccounts := []types.EscrowAccount{}
+	k.IterateEscrowAccounts(ctx, func(escrowAccount types.EscrowAccount) bool {
+		escrowAccounts = append(escrowAccounts, escrowAccount)
+		return false
+	})
+
+	return escrowAccounts
+}
+
+// IterateEscrowAccounts iterates over the escrow accounts in the store
+// and performs a callback function.
+func (k Keeper) IterateEscrowAccounts(ctx sdk.Context, cb func(escrowAccount types.EscrowAccount) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.EscrowAccountPrefix)
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var escrowAccount types.EscrowAccount
+		k.cdc.MustUnmarshal(iterator.Value(), &escrowAccount)
+
+		if cb(escrowAccount) {
+			break
+		}
+	}
+}
+
 // AuthenticateCapability wraps the scopedKeeper's AuthenticateCapability function
 func (k Keeper) AuthenticateCapability(ctx sdk.Context, cap *capabilitytypes.Capability, name string) bool {
 	return k.scopedKeeper.AuthenticateCapability(ctx, cap, name)
@@ -158,7 +277,7 @@ func (k Keeper) ClaimCapability(ctx sdk.Context, cap *capabilitytypes.Capability
 	return k.scopedKeeper.ClaimCapability(ctx, cap, name)
 }
 
-// SetHooks set the epoch hooks
+// SetHooks sets the transfer hooks
 func (k *Keeper) SetHooks(eh types.TransferHooks) *Keeper {
 	if k.hooks != nil {
 		panic("cannot set transfer hooks twice")
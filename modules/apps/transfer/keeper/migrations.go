@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// MigrateTotalEscrowForDenom migrates from version 1 to 2.
+// This migration sets the total amount of tokens in escrow for each denomination
+// to the sum of the balances of the escrow accounts of all transfer channels.
+func (m Migrator) MigrateTotalEscrowForDenom(ctx sdk.Context) error {
+	for _, coin := range m.keeper.getEscrowBalances(ctx) {
+		m.keeper.SetTotalEscrowForDenom(ctx, coin)
+	}
+
+	return nil
+}
+
+// MigrateEscrowAccounts migrates from version 2 to 3.
+// This migration stores the escrow account of every transfer channel whose escrow
+// address holds tokens.
+func (m Migrator) MigrateEscrowAccounts(ctx sdk.Context) error {
+	portID := m.keeper.GetPort(ctx)
+
+	for _, channel := range m.keeper.channelKeeper.GetAllChannels(ctx) {
+		if channel.PortId != portID {
+			continue
+		}
+
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		if m.keeper.bankKeeper.GetAllBalances(ctx, escrowAddress).IsZero() {
+			continue
+		}
+
+		m.keeper.SetEscrowAccount(ctx, types.NewEscrowAccount(channel.PortId, channel.ChannelId))
+	}
+
+	return nil
+}
+
+// MigrateDenomMetadata migrates from version 3 to 4.
+// This migration sets the RegisterDenomMetadata parameter to its default value and,
+// if it is enabled, registers the bank metadata of the IBC vouchers of all stored
+// denomination traces which have no metadata.
+func (m Migrator) MigrateDenomMetadata(ctx sdk.Context) error {
+	if !m.keeper.paramSpace.Has(ctx, types.KeyRegisterDenomMetadata) {
+		m.keeper.paramSpace.Set(ctx, types.KeyRegisterDenomMetadata, types.DefaultRegisterDenomMetadata)
+	}
+
+	if !m.keeper.GetRegisterDenomMetadata(ctx) {
+		return nil
+	}
+
+	m.keeper.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+		m.keeper.setDenomMetadata(ctx, denomTrace)
+		return false
+	})
+
+	return nil
+}
+
+// MigrateDenomTraceIndexes migrates from version 4 to 5.
+// This migration indexes all stored denomination traces by their base denomination
+// and the channel the tokens were received through.
+func (m Migrator) MigrateDenomTraceIndexes(ctx sdk.Context) error {
+	m.keeper.IterateDenomTraces(ctx, func(denomTrace types.DenomTrace) bool {
+		m.keeper.setDenomTraceIndexes(ctx, denomTrace)
+		return false
+	})
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	"github.com/cosmos/ibc-go/v3/testing/simapp"
+)
+
+func (suite *KeeperTestSuite) TestMigrateTotalEscrowForDenom() {
+	path1 := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path1)
+
+	path2 := NewTransferPath(suite.chainA, suite.chainC)
+	suite.coordinator.Setup(path2)
+
+	voucherDenom := types.ParseDenomTrace(types.GetPrefixedDenom(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, "uatom")).IBCDenom()
+
+	escrow1 := types.GetEscrowAddress(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID)
+	escrow1Coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)), sdk.NewCoin(voucherDenom, sdk.NewInt(50)))
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow1, escrow1Coins))
+
+	escrow2 := types.GetEscrowAddress(path2.EndpointA.ChannelConfig.PortID, path2.EndpointA.ChannelID)
+	escrow2Coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(200)))
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow2, escrow2Coins))
+
+	suite.Require().Empty(suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(suite.chainA.GetContext()))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.MigrateTotalEscrowForDenom(suite.chainA.GetContext()))
+
+	expTotalEscrowed := escrow1Coins.Add(escrow2Coins...)
+	suite.Require().Equal(expTotalEscrowed.String(), suite.chainA.GetSimApp().TransferKeeper.GetAllTotalEscrowed(suite.chainA.GetContext()).String())
+
+	_, broken := keeper.TotalEscrowPerDenomInvariant(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestMigrateEscrowAccounts() {
+	path1 := NewTransferPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path1)
+
+	path2 := NewTransferPath(suite.chainA, suite.chainC)
+	suite.coordinator.Setup(path2)
+
+	// only the escrow address of the first channel holds tokens
+	escrow1 := types.GetEscrowAddress(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID)
+	suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow1, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100)))))
+
+	suite.Require().Empty(suite.chainA.GetSimApp().TransferKeeper.GetAllEscrowAccounts(suite.chainA.GetContext()))
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.MigrateEscrowAccounts(suite.chainA.GetContext()))
+
+	expEscrowAccounts := []types.EscrowAccount{types.NewEscrowAccount(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID)}
+	suite.Require().Equal(expEscrowAccounts, suite.chainA.GetSimApp().TransferKeeper.GetAllEscrowAccounts(suite.chainA.GetContext()))
+
+	_, broken := keeper.EscrowAccountChannelsInvariant(suite.chainA.GetSimApp().TransferKeeper)(suite.chainA.GetContext())
+	suite.Require().False(broken)
+}
+
+func (suite *KeeperTestSuite) TestMigrateDenomMetadata() {
+	var (
+		denomTraces      []types.DenomTrace
+		existingMetadata = types.NewDenomMetadata(types.DenomTrace{BaseDenom: "uosmo", Path: "transfer/channel-1"})
+	)
+
+	testCases := []struct {
+		msg         string
+		malleate    func()
+		expRegister bool
+	}{
+		{
+			"success: parameter is not set",
+			func() {
+				// remove the parameter as it is not set before the migration
+				store := prefix.NewStore(suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(paramstypes.StoreKey)), append([]byte(types.ModuleName), '/'))
+				store.Delete(types.KeyRegisterDenomMetadata)
+				suite.Require().False(suite.chainA.GetSimApp().TransferKeeper.GetRegisterDenomMetadata(suite.chainA.GetContext()))
+			},
+			true,
+		},
+		{
+			"success: parameter is enabled",
+			func() {},
+			true,
+		},
+		{
+			"success: parameter is disabled",
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, nil, nil, false))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.msg, func() {
+			suite.SetupTest() // reset
+
+			denomTraces = []types.DenomTrace{
+				{BaseDenom: "uatom", Path: "transfer/channel-0"},
+				{BaseDenom: "uatom", Path: "transfer/channel-0/transfer/channel-5"},
+				{BaseDenom: "uosmo", Path: "transfer/channel-1"},
+			}
+			for _, denomTrace := range denomTraces {
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+			}
+
+			existingMetadata.Name = "custom name"
+			suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), existingMetadata)
+
+			tc.malleate()
+
+			migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			suite.Require().NoError(migrator.MigrateDenomMetadata(suite.chainA.GetContext()))
+
+			suite.Require().Equal(tc.expRegister, suite.chainA.GetSimApp().TransferKeeper.GetRegisterDenomMetadata(suite.chainA.GetContext()))
+
+			for _, denomTrace := range denomTraces {
+				metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denomTrace.IBCDenom())
+
+				switch {
+				case denomTrace.IBCDenom() == existingMetadata.Base:
+					suite.Require().Equal(existingMetadata, metadata)
+				case tc.expRegister:
+					suite.Require().True(found)
+					suite.Require().Equal(types.NewDenomMetadata(denomTrace), metadata)
+				default:
+					suite.Require().False(found)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMigrateDenomTraceIndexes() {
+	denomTraces := types.Traces{
+		{BaseDenom: "uatom", Path: ""},
+		{BaseDenom: "uatom", Path: "transfer/channel-0"},
+		{BaseDenom: "uatom", Path: "transfer/channel-0/transfer/channel-5"},
+		{BaseDenom: "uosmo", Path: "transfer/channel-1"},
+	}
+	for _, denomTrace := range denomTraces {
+		suite.chainA.GetSimApp().TransferKeeper.SetDenomTrace(suite.chainA.GetContext(), denomTrace)
+	}
+
+	// remove the index entries as they are not set before the migration
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	for _, indexPrefix := range [][]byte{types.DenomTraceByBaseDenomPrefix, types.DenomTraceByChannelPrefix} {
+		indexStore := prefix.NewStore(store, indexPrefix)
+		iterator := indexStore.Iterator(nil, nil)
+		var keys [][]byte
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+
+		suite.Require().NotEmpty(keys)
+		for _, key := range keys {
+			indexStore.Delete(key)
+		}
+	}
+
+	migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.MigrateDenomTraceIndexes(suite.chainA.GetContext()))
+
+	ctx := sdk.WrapSDKContext(suite.chainA.GetContext())
+	byBaseDenom, err := suite.chainA.GetSimApp().TransferKeeper.DenomTracesByBaseDenom(ctx, &types.QueryDenomTracesByBaseDenomRequest{BaseDenom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Traces{denomTraces[0], denomTraces[1], denomTraces[2]}.Sort(), byBaseDenom.DenomTraces)
+
+	byChannel, err := suite.chainA.GetSimApp().TransferKeeper.DenomTracesByChannel(ctx, &types.QueryDenomTracesByChannelRequest{PortId: types.PortID, ChannelId: "channel-0"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.Traces{denomTraces[1], denomTraces[2]}.Sort(), byChannel.DenomTraces)
+}
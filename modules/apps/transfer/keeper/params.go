@@ -20,9 +20,32 @@ func (k Keeper) GetReceiveEnabled(ctx sdk.Context) bool {
 	return res
 }
 
+// GetSendFilters retrieves the send filters from the paramstore
+func (k Keeper) GetSendFilters(ctx sdk.Context) []types.TransferFilter {
+	var res []types.TransferFilter
+	k.paramSpace.GetIfExists(ctx, types.KeySendFilters, &res)
+	return res
+}
+
+// GetReceiveFilters retrieves the receive filters from the paramstore
+func (k Keeper) GetReceiveFilters(ctx sdk.Context) []types.TransferFilter {
+	var res []types.TransferFilter
+	k.paramSpace.GetIfExists(ctx, types.KeyReceiveFilters, &res)
+	return res
+}
+
+// GetRegisterDenomMetadata retrieves the register denom metadata boolean from the paramstore
+func (k Keeper) GetRegisterDenomMetadata(ctx sdk.Context) bool {
+	var res bool
+	k.paramSpace.GetIfExists(ctx, types.KeyRegisterDenomMetadata, &res)
+	return res
+}
+
 // GetParams returns the total set of ibc-transfer parameters.
 func (k Keeper) GetParams(ctx sdk.Context) types.Params {
-	return types.NewParams(k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx))
+	return types.NewParams(
+		k.GetSendEnabled(ctx), k.GetReceiveEnabled(ctx), k.GetSendFilters(ctx), k.GetReceiveFilters(ctx), k.GetRegisterDenomMetadata(ctx),
+	)
 }
 
 // SetParams sets the total set of ibc-transfer parameters.
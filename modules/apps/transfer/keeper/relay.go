@@ -57,6 +57,7 @@ func (k Keeper) SendTransfer(
 	receiver string,
 	timeoutHeight clienttypes.Height,
 	timeoutTimestamp uint64,
+	memo string,
 ) error {
 
 	if !k.GetSendEnabled(ctx) {
@@ -101,6 +102,10 @@ func (k Keeper) SendTransfer(
 		}
 	}
 
+	if err := types.CheckTransferFilters(k.GetSendFilters(ctx), sourceChannel, types.ParseDenomTrace(fullDenomPath)); err != nil {
+		return err
+	}
+
 	labels := []metrics.Label{
 		telemetry.NewLabel(coretypes.LabelDestinationPort, destinationPort),
 		telemetry.NewLabel(coretypes.LabelDestinationChannel, destinationChannel),
@@ -109,17 +114,36 @@ func (k Keeper) SendTransfer(
 	// NOTE: SendTransfer simply sends the denomination as it exists on its own
 	// chain inside the packet data. The receiving chain will perform denom
 	// prefixing as necessary.
+	packetData := types.NewFungibleTokenPacketData(
+		fullDenomPath, token.Amount.String(), sender.String(), receiver, memo,
+	)
+
+	packet := channeltypes.NewPacket(
+		packetData.GetBytes(),
+		sequence,
+		sourcePort,
+		sourceChannel,
+		destinationPort,
+		destinationChannel,
+		timeoutHeight,
+		timeoutTimestamp,
+	)
+
+	if err := k.BeforeSendTransfer(ctx, packet, packetData, types.ParseDenomTrace(fullDenomPath), token.Denom); err != nil {
+		return err
+	}
 
 	if types.SenderChainIsSource(sourcePort, sourceChannel, fullDenomPath) {
 		labels = append(labels, telemetry.NewLabel(coretypes.LabelSource, "true"))
 
 		// create the escrow address for the tokens
 		escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
+		if !k.HasEscrowAccount(ctx, escrowAddress) {
+			k.SetEscrowAccount(ctx, types.NewEscrowAccount(sourcePort, sourceChannel))
+		}
 
 		// escrow source tokens. It fails if balance insufficient.
-		if err := k.bankKeeper.SendCoins(
-			ctx, sender, escrowAddress, sdk.NewCoins(token),
-		); err != nil {
+		if err := k.escrowToken(ctx, sender, escrowAddress, token); err != nil {
 			return err
 		}
 
@@ -143,21 +167,6 @@ func (k Keeper) SendTransfer(
 		}
 	}
 
-	packetData := types.NewFungibleTokenPacketData(
-		fullDenomPath, token.Amount.String(), sender.String(), receiver,
-	)
-
-	packet := channeltypes.NewPacket(
-		packetData.GetBytes(),
-		sequence,
-		sourcePort,
-		sourceChannel,
-		destinationPort,
-		destinationChannel,
-		timeoutHeight,
-		timeoutTimestamp,
-	)
-
 	if err := k.ics4Wrapper.SendPacket(ctx, channelCap, packet); err != nil {
 		return err
 	}
@@ -185,7 +194,9 @@ func (k Keeper) SendTransfer(
 // sender chain is the source of minted tokens then vouchers will be minted
 // and sent to the receiving address. Otherwise if the sender chain is sending
 // back tokens this chain originally transferred to it, the tokens are
-// unescrowed and sent to the receiving address.
+// unescrowed and sent to the receiving address. An error returned by the
+// AfterRecvTransfer hook is returned so that an error acknowledgement is
+// written and the state changes of the receive are reverted.
 func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
@@ -196,6 +207,13 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return types.ErrReceiveDisabled
 	}
 
+	receivedDenomTrace := types.GetReceivedDenomTrace(
+		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), data.Denom,
+	)
+	if err := types.CheckTransferFilters(k.GetReceiveFilters(ctx), packet.GetDestChannel(), receivedDenomTrace); err != nil {
+		return err
+	}
+
 	// decode the receiver address
 	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
 	if err != nil {
@@ -245,7 +263,7 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 		// unescrow tokens
 		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+		if err := k.unescrowToken(ctx, escrowAddress, receiver, token); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
@@ -253,6 +271,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 			return sdkerrors.Wrap(err, "unable to unescrow tokens, this may be caused by a malicious counterparty module or a bug: please open an issue on counterparty module")
 		}
 
+		if err := k.AfterRecvTransfer(ctx, packet, data, denomTrace, denom); err != nil {
+			return err
+		}
+
 		defer func() {
 			if transferAmount.IsInt64() {
 				telemetry.SetGaugeWithLabels(
@@ -287,6 +309,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 	traceHash := denomTrace.Hash()
 	if !k.HasDenomTrace(ctx, traceHash) {
 		k.SetDenomTrace(ctx, denomTrace)
+
+		if k.GetRegisterDenomMetadata(ctx) {
+			k.setDenomMetadata(ctx, denomTrace)
+		}
 	}
 
 	voucherDenom := denomTrace.IBCDenom()
@@ -313,6 +339,10 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 		return err
 	}
 
+	if err := k.AfterRecvTransfer(ctx, packet, data, denomTrace, voucherDenom); err != nil {
+		return err
+	}
+
 	defer func() {
 		if transferAmount.IsInt64() {
 			telemetry.SetGaugeWithLabels(
@@ -336,17 +366,25 @@ func (k Keeper) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, data t
 
 // OnAcknowledgementPacket responds to the the success or failure of a packet
 // acknowledgement written on the receiving chain. If the acknowledgement
-// was a success then nothing occurs. If the acknowledgement failed, then
-// the sender is refunded their tokens using the refundPacketToken function.
+// was a success then only the AfterTransferEnd hook is executed. If the
+// acknowledgement failed, then the sender is refunded their tokens using the
+// refundPacketToken function and the AfterRefundTransfer hook is executed.
+// A failure of either hook does not fail the acknowledgement.
 func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	trace := types.ParseDenomTrace(data.Denom)
+
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Error:
-		return k.refundPacketToken(ctx, packet, data)
+		if err := k.refundPacketToken(ctx, packet, data); err != nil {
+			return err
+		}
+
+		k.AfterRefundTransfer(ctx, packet, data, trace, trace.IBCDenom())
+		return nil
 	default:
 		// the acknowledgement succeeded on the receiving chain so nothing
 		// needs to be executed and no error needs to be returned
-		denom := types.ParseDenomTrace(data.Denom)
-		k.AfterTransferEnd(ctx, data, denom.BaseDenom)
+		k.AfterTransferEnd(ctx, packet, data, trace, trace.IBCDenom())
 		return nil
 	}
 }
@@ -354,7 +392,14 @@ func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Pac
 // OnTimeoutPacket refunds the sender since the original packet sent was
 // never received and has been timed out.
 func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, data types.FungibleTokenPacketData) error {
-	return k.refundPacketToken(ctx, packet, data)
+	if err := k.refundPacketToken(ctx, packet, data); err != nil {
+		return err
+	}
+
+	trace := types.ParseDenomTrace(data.Denom)
+	k.AfterTimeoutTransfer(ctx, packet, data, trace, trace.IBCDenom())
+
+	return nil
 }
 
 // refundPacketToken will unescrow and send back the tokens back to sender
@@ -383,7 +428,7 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	if types.SenderChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
 		// unescrow tokens back to sender
 		escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-		if err := k.bankKeeper.SendCoins(ctx, escrowAddress, sender, sdk.NewCoins(token)); err != nil {
+		if err := k.unescrowToken(ctx, escrowAddress, sender, token); err != nil {
 			// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 			// counterparty module. The bug may occur in bank or any part of the code that allows
 			// the escrow address to be drained. A malicious counterparty module could drain the
@@ -408,6 +453,50 @@ func (k Keeper) refundPacketToken(ctx sdk.Context, packet channeltypes.Packet, d
 	return nil
 }
 
+// setDenomMetadata registers the bank metadata of the IBC voucher of the given denomination
+// trace. Metadata which has already been registered for the voucher is not overwritten.
+func (k Keeper) setDenomMetadata(ctx sdk.Context, denomTrace types.DenomTrace) {
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denomTrace.IBCDenom()); found {
+		return
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, types.NewDenomMetadata(denomTrace))
+}
+
+// escrowToken sends the token to the escrow address and increases the total amount
+// of tokens in escrow for its denomination.
+func (k Keeper) escrowToken(ctx sdk.Context, sender, escrowAddress sdk.AccAddress, token sdk.Coin) error {
+	if err := k.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+	k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Add(token))
+
+	return nil
+}
+
+// unescrowToken sends the token from the escrow address to the receiver and decreases
+// the total amount of tokens in escrow for its denomination. An error is returned if
+// the amount unescrowed exceeds the tracked total amount in escrow.
+func (k Keeper) unescrowToken(ctx sdk.Context, escrowAddress, receiver sdk.AccAddress, token sdk.Coin) error {
+	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(token)); err != nil {
+		return err
+	}
+
+	currentTotalEscrow := k.GetTotalEscrowForDenom(ctx, token.GetDenom())
+	if currentTotalEscrow.Amount.LT(token.Amount) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInsufficientFunds,
+			"total escrow %s is less than the unescrowed amount %s", currentTotalEscrow, token,
+		)
+	}
+
+	k.SetTotalEscrowForDenom(ctx, currentTotalEscrow.Sub(token))
+
+	return nil
+}
+
 // DenomPathFromHash returns the full denomination path prefix from an ibc denom with a hash
 // component.
 func (k Keeper) DenomPathFromHash(ctx sdk.Context, denom string) (string, error) {
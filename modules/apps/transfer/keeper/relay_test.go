@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"errors"
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -19,6 +20,7 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 	var (
 		amount sdk.Coin
 		path   *ibctesting.Path
+		hooks  *mockTransferHooks
 		err    error
 	)
 
@@ -82,6 +84,49 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.chainA.GetSimApp().ScopedTransferKeeper.ReleaseCapability(suite.chainA.GetContext(), cap)
 				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
 			}, true, false},
+		{"transfer vetoed by before send hook",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				hooks.sendErr = errors.New("transfer vetoed")
+			}, true, false},
+
+		// send filters
+		{"successful transfer with filter on another channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				filter := types.NewTransferFilter("channel-100", true, nil, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, []types.TransferFilter{filter}, nil, true))
+			}, true, true},
+		{"successful transfer with denom allowed on channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				filter := types.NewTransferFilter(path.EndpointA.ChannelID, false, []string{sdk.DefaultBondDenom}, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, []types.TransferFilter{filter}, nil, true))
+			}, true, true},
+		{"sends disabled on channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				filter := types.NewTransferFilter(path.EndpointA.ChannelID, true, nil, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, []types.TransferFilter{filter}, nil, true))
+			}, true, false},
+		{"denom not allowed on channel",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
+				filter := types.NewTransferFilter(path.EndpointA.ChannelID, false, []string{"uatom"}, nil)
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, []types.TransferFilter{filter}, nil, true))
+			}, true, false},
+		{"base denom of voucher blocked on all channels",
+			func() {
+				suite.coordinator.CreateTransferChannels(path)
+				amount = types.GetTransferCoin(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, sdk.NewInt(100))
+				filter := types.NewTransferFilter("", false, nil, []string{sdk.DefaultBondDenom})
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(true, true, []types.TransferFilter{filter}, nil, true))
+			}, false, false},
 	}
 
 	for _, tc := range testCases {
@@ -92,17 +137,20 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			path = NewTransferPath(suite.chainA, suite.chainB)
 			suite.coordinator.SetupConnections(path)
 
+			hooks = &mockTransferHooks{}
+			suite.chainA.GetSimApp().TransferKeeper.SetHooks(hooks)
+
 			tc.malleate()
 
 			if !tc.sendFromSource {
 				// send coin from chainB to chainA
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				_, err = suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
 				// receive coin on chainA from chainB
-				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String())
+				fungibleTokenPacket := types.NewFungibleTokenPacketData(coinFromBToA.Denom, coinFromBToA.Amount.String(), suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), "")
 				packet := channeltypes.NewPacket(fungibleTokenPacket.GetBytes(), 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.NewHeight(0, 110), 0)
 
 				// get proof of packet commitment from chainB
@@ -116,16 +164,31 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 				suite.Require().NoError(err) // message committed
 			}
 
+			totalEscrow := suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), amount.Denom)
+
 			err = suite.chainA.GetSimApp().TransferKeeper.SendTransfer(
 				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, amount,
-				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0,
+				suite.chainA.SenderAccount.GetAddress(), suite.chainB.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "memo",
 			)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+				suite.Require().Equal([]string{"BeforeSendTransfer"}, hooks.calls)
+				suite.Require().Equal(amount.Denom, hooks.denom)
+				suite.Require().Equal("memo", hooks.memo)
+
+				// the total escrow only increases if the tokens are escrowed
+				if tc.sendFromSource {
+					totalEscrow = totalEscrow.Add(amount)
+				}
+
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().Equal(tc.sendFromSource, suite.chainA.GetSimApp().TransferKeeper.HasEscrowAccount(suite.chainA.GetContext(), escrowAddress))
 			} else {
 				suite.Require().Error(err)
 			}
+
+			suite.Require().Equal(totalEscrow.String(), suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), amount.Denom).String())
 		})
 	}
 }
@@ -139,6 +202,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		trace    types.DenomTrace
 		amount   sdk.Int
 		receiver string
+		hooks    *mockTransferHooks
 	)
 
 	testCases := []struct {
@@ -177,6 +241,46 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 		{"failure: receive on module account on source chain", func() {
 			receiver = suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName).String()
 		}, true, false},
+
+		// - hooks failing after the tokens have been minted or unescrowed
+		{"failure: after recv hook returns error on mint", func() {
+			hooks.afterErr = errors.New("hook failed")
+		}, false, false},
+		{"failure: after recv hook returns error on unescrow", func() {
+			hooks.afterErr = errors.New("hook failed")
+		}, true, false},
+		{"failure: after recv hook panics", func() {
+			hooks.afterPanic = "hook panicked"
+		}, false, false},
+
+		// receive filters
+		{"success: full denom trace allowed on channel", func() {
+			filter := types.NewTransferFilter(ibctesting.FirstChannelID, false, []string{types.GetPrefixedDenom(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom)}, nil)
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, nil, []types.TransferFilter{filter}, true))
+		}, false, true},
+		{"failure: receives disabled on channel", func() {
+			filter := types.NewTransferFilter(ibctesting.FirstChannelID, true, nil, nil)
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, nil, []types.TransferFilter{filter}, true))
+		}, false, false},
+		{"failure: voucher not allowed on channel", func() {
+			filter := types.NewTransferFilter(ibctesting.FirstChannelID, false, []string{"uatom"}, nil)
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, nil, []types.TransferFilter{filter}, true))
+		}, false, false},
+		{"failure: base denom blocked on all channels on source chain", func() {
+			filter := types.NewTransferFilter("", false, nil, []string{sdk.DefaultBondDenom})
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, nil, []types.TransferFilter{filter}, true))
+		}, true, false},
+
+		// denom metadata registration
+		{"success: denom metadata not registered if disabled", func() {
+			suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, true, nil, nil, false))
+		}, false, true},
+		{"success: existing denom metadata is not overwritten", func() {
+			voucherTrace := types.ParseDenomTrace(types.GetPrefixedDenom(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom))
+			metadata := types.NewDenomMetadata(voucherTrace)
+			metadata.Name = "custom name"
+			suite.chainB.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainB.GetContext(), metadata)
+		}, false, true},
 	}
 
 	for _, tc := range testCases {
@@ -195,7 +299,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			if tc.recvIsSource {
 				// send coin from chainB to chainA, receive them, acknowledge them, and send back to chainB
 				coinFromBToA := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(100))
-				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0)
+				transferMsg := types.NewMsgTransfer(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, coinFromBToA, suite.chainB.SenderAccount.GetAddress().String(), suite.chainA.SenderAccount.GetAddress().String(), clienttypes.NewHeight(0, 110), 0, "")
 				res, err := suite.chainB.SendMsgs(transferMsg)
 				suite.Require().NoError(err) // message committed
 
@@ -214,21 +318,59 @@ func (suite *KeeperTestSuite) TestOnRecvPacket() {
 			}
 
 			// send coin from chainA to chainB
-			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0)
+			transferMsg := types.NewMsgTransfer(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(trace.IBCDenom(), amount), suite.chainA.SenderAccount.GetAddress().String(), receiver, clienttypes.NewHeight(0, 110), 0, "")
 			_, err := suite.chainA.SendMsgs(transferMsg)
 			suite.Require().NoError(err) // message committed
 
+			hooks = &mockTransferHooks{}
+			suite.chainB.GetSimApp().TransferKeeper.SetHooks(hooks)
+
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver)
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), receiver, "")
 			packet := channeltypes.NewPacket(data.GetBytes(), seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
+			totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom)
+
+			voucherTrace := types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, trace.GetFullDenomPath()))
+			existingMetadata, hasMetadata := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherTrace.IBCDenom())
+
 			err = suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
 
 			if tc.expPass {
 				suite.Require().NoError(err)
+				suite.Require().Equal([]string{"AfterRecvTransfer"}, hooks.calls)
+
+				expDenom := voucherTrace.IBCDenom()
+				if tc.recvIsSource {
+					expDenom = sdk.DefaultBondDenom
+
+					// the unescrowed tokens are no longer in escrow
+					totalEscrow = totalEscrow.Sub(sdk.NewCoin(sdk.DefaultBondDenom, amount))
+				}
+				suite.Require().Equal(expDenom, hooks.denom)
+				suite.Require().Equal(totalEscrow.String(), suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), sdk.DefaultBondDenom).String())
+
+				// the bank metadata of new vouchers is registered if enabled
+				if !tc.recvIsSource {
+					metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), voucherTrace.IBCDenom())
+					switch {
+					case hasMetadata:
+						suite.Require().Equal(existingMetadata, metadata)
+					case suite.chainB.GetSimApp().TransferKeeper.GetRegisterDenomMetadata(suite.chainB.GetContext()):
+						suite.Require().True(found)
+						suite.Require().Equal(types.NewDenomMetadata(voucherTrace), metadata)
+					default:
+						suite.Require().False(found)
+					}
+				}
 			} else {
 				suite.Require().Error(err)
+				if hooks.afterErr != nil || hooks.afterPanic != "" {
+					suite.Require().ErrorIs(err, types.ErrTransferHook)
+				} else {
+					suite.Require().Empty(hooks.calls)
+				}
 			}
 		})
 	}
@@ -245,6 +387,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 		trace      types.DenomTrace
 		amount     sdk.Int
 		path       *ibctesting.Path
+		hooks      *mockTransferHooks
 	)
 
 	testCases := []struct {
@@ -254,6 +397,19 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 		success  bool // success of ack
 		expPass  bool
 	}{
+		{"success ack with failing hook is isolated", successAck, func() {
+			trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
+			hooks.afterErr = errors.New("hook failed")
+		}, true, true},
+		{"refund with panicking hook is isolated", failedAck, func() {
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			hooks.afterPanic = "hook panicked"
+		}, false, true},
 		{"success ack causes no-op", successAck, func() {
 			trace = types.ParseDenomTrace(types.GetPrefixedDenom(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sdk.DefaultBondDenom))
 		}, true, true},
@@ -263,11 +419,21 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
 
 			suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+			suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
 		}, false, true},
 		{"unsuccessful refund from source", failedAck,
 			func() {
 				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
 			}, false, false},
+		{"unsuccessful refund from source, total escrow lower than refunded amount", failedAck,
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				coin := sdk.NewCoin(sdk.DefaultBondDenom, amount)
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, amount.SubRaw(1)))
+			}, false, false},
 		{"successful refund from with coin from external chain", failedAck,
 			func() {
 				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
@@ -287,14 +453,18 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 			suite.coordinator.Setup(path)
 			amount = sdk.NewInt(100) // must be explicitly changed
 
+			hooks = &mockTransferHooks{}
+			suite.chainA.GetSimApp().TransferKeeper.SetHooks(hooks)
+
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().TransferKeeper.OnAcknowledgementPacket(ctx, packet, data, tc.ack)
 			if tc.expPass {
 				suite.Require().NoError(err)
 				postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
@@ -302,12 +472,24 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 				if tc.success {
 					suite.Require().Equal(int64(0), deltaAmount.Int64(), "successful ack changed balance")
+					suite.Require().Equal([]string{"AfterTransferEnd"}, hooks.calls)
 				} else {
 					suite.Require().Equal(amount, deltaAmount, "failed ack did not trigger refund")
+					suite.Require().Equal([]string{"AfterRefundTransfer"}, hooks.calls)
 				}
 
+				// the refunded tokens are no longer in escrow
+				suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom()).IsZero())
+				suite.Require().Equal(trace, hooks.trace)
+				suite.Require().Equal(trace.IBCDenom(), hooks.denom)
+
+				hookFailed := hooks.afterErr != nil || hooks.afterPanic != ""
+				suite.Require().Equal(hookFailed, hasEvent(ctx, types.EventTypeHookError))
+				suite.Require().Equal(!hookFailed, hasEvent(ctx, mockHookEventType), "events of failed hook must be discarded")
+
 			} else {
 				suite.Require().Error(err)
+				suite.Require().Empty(hooks.calls)
 			}
 		})
 	}
@@ -323,6 +505,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 		path   *ibctesting.Path
 		amount sdk.Int
 		sender string
+		hooks  *mockTransferHooks
 	)
 
 	testCases := []struct {
@@ -337,6 +520,17 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				coin := sdk.NewCoin(trace.IBCDenom(), amount)
 
 				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+			}, true},
+		{"successful timeout with failing hook",
+			func() {
+				escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				trace = types.ParseDenomTrace(sdk.DefaultBondDenom)
+				coin := sdk.NewCoin(trace.IBCDenom(), amount)
+
+				suite.Require().NoError(simapp.FundAccount(suite.chainA.GetSimApp(), suite.chainA.GetContext(), escrow, sdk.NewCoins(coin)))
+				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+				hooks.afterErr = errors.New("hook failed")
 			}, true},
 		{"successful timeout from external chain",
 			func() {
@@ -373,14 +567,18 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			amount = sdk.NewInt(100) // must be explicitly changed
 			sender = suite.chainA.SenderAccount.GetAddress().String()
 
+			hooks = &mockTransferHooks{}
+			suite.chainA.GetSimApp().TransferKeeper.SetHooks(hooks)
+
 			tc.malleate()
 
-			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String())
+			data := types.NewFungibleTokenPacketData(trace.GetFullDenomPath(), amount.String(), sender, suite.chainB.SenderAccount.GetAddress().String(), "")
 			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(0, 100), 0)
 
 			preCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 
-			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+			ctx := suite.chainA.GetContext()
+			err := suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(ctx, packet, data)
 
 			postCoin := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), trace.IBCDenom())
 			deltaAmount := postCoin.Amount.Sub(preCoin.Amount)
@@ -388,9 +586,86 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 			if tc.expPass {
 				suite.Require().NoError(err)
 				suite.Require().Equal(amount.Int64(), deltaAmount.Int64(), "successful timeout did not trigger refund")
+				suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainA.GetContext(), trace.IBCDenom()).IsZero())
+				suite.Require().Equal([]string{"AfterTimeoutTransfer"}, hooks.calls)
+				suite.Require().Equal(trace.IBCDenom(), hooks.denom)
+				suite.Require().Equal(hooks.afterErr != nil, hasEvent(ctx, types.EventTypeHookError))
 			} else {
 				suite.Require().Error(err)
+				suite.Require().Empty(hooks.calls)
 			}
 		})
 	}
 }
+
+var _ types.TransferHooks = &mockTransferHooks{}
+
+// mockTransferHooks records the transfer hooks which have been called along
+// with the denomination trace and local denomination they were called with.
+// The hooks executed after a packet was sent emit a mock event and then fail
+// with afterErr or panic with afterPanic if these are set.
+type mockTransferHooks struct {
+	sendErr    error
+	afterErr   error
+	afterPanic string
+
+	calls []string
+	trace types.DenomTrace
+	denom string
+	memo  string
+}
+
+const mockHookEventType = "mock_hook"
+
+func (h *mockTransferHooks) record(ctx sdk.Context, name string, trace types.DenomTrace, denom string) error {
+	h.calls = append(h.calls, name)
+	h.trace = trace
+	h.denom = denom
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(mockHookEventType))
+
+	if h.afterPanic != "" {
+		panic(h.afterPanic)
+	}
+
+	return h.afterErr
+}
+
+func (h *mockTransferHooks) BeforeSendTransfer(ctx sdk.Context, _ channeltypes.Packet, data types.FungibleTokenPacketData, trace types.DenomTrace, denom string) error {
+	if h.sendErr != nil {
+		return h.sendErr
+	}
+
+	h.calls = append(h.calls, "BeforeSendTransfer")
+	h.trace = trace
+	h.denom = denom
+	h.memo = data.Memo
+	return nil
+}
+
+func (h *mockTransferHooks) AfterRecvTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, trace types.DenomTrace, denom string) error {
+	return h.record(ctx, "AfterRecvTransfer", trace, denom)
+}
+
+func (h *mockTransferHooks) AfterTransferEnd(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, trace types.DenomTrace, denom string) error {
+	return h.record(ctx, "AfterTransferEnd", trace, denom)
+}
+
+func (h *mockTransferHooks) AfterRefundTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, trace types.DenomTrace, denom string) error {
+	return h.record(ctx, "AfterRefundTransfer", trace, denom)
+}
+
+func (h *mockTransferHooks) AfterTimeoutTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, trace types.DenomTrace, denom string) error {
+	return h.record(ctx, "AfterTimeoutTransfer", trace, denom)
+}
+
+// hasEvent returns true if an event of the given type has been emitted on the context.
+func hasEvent(ctx sdk.Context, eventType string) bool {
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == eventType {
+			return true
+		}
+	}
+
+	return false
+}
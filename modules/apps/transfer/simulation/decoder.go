@@ -2,8 +2,10 @@ package simulation
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -15,7 +17,8 @@ type TransferUnmarshaler interface {
 }
 
 // NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
-// Value to the corresponding DenomTrace type.
+// Value to the corresponding DenomTrace, total escrow or EscrowAccount type. For the
+// denomination trace index entries the indexed trace hash is decoded from the key.
 func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 	return func(kvA, kvB kv.Pair) string {
 		switch {
@@ -27,8 +30,41 @@ func NewDecodeStore(cdc TransferUnmarshaler) func(kvA, kvB kv.Pair) string {
 			denomTraceB := cdc.MustUnmarshalDenomTrace(kvB.Value)
 			return fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", denomTraceA.IBCDenom(), denomTraceB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.TotalEscrowPrefix):
+			var amountA, amountB sdk.IntProto
+			mustUnmarshal(kvA.Value, &amountA)
+			mustUnmarshal(kvB.Value, &amountB)
+			return fmt.Sprintf("TotalEscrow A: %s\nTotalEscrow B: %s", amountA.Int, amountB.Int)
+
+		case bytes.Equal(kvA.Key[:1], types.EscrowAccountPrefix):
+			var escrowAccountA, escrowAccountB types.EscrowAccount
+			mustUnmarshal(kvA.Value, &escrowAccountA)
+			mustUnmarshal(kvB.Value, &escrowAccountB)
+			return fmt.Sprintf("EscrowAccount A: %v\nEscrowAccount B: %v", escrowAccountA, escrowAccountB)
+
+		case bytes.Equal(kvA.Key[:1], types.DenomTraceByBaseDenomPrefix):
+			return fmt.Sprintf("DenomTraceByBaseDenom A: %X\nDenomTraceByBaseDenom B: %X", indexedTraceHash(kvA.Key), indexedTraceHash(kvB.Key))
+
+		case bytes.Equal(kvA.Key[:1], types.DenomTraceByChannelPrefix):
+			return fmt.Sprintf("DenomTraceByChannel A: %X\nDenomTraceByChannel B: %X", indexedTraceHash(kvA.Key), indexedTraceHash(kvB.Key))
+
 		default:
 			panic(fmt.Sprintf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
 	}
 }
+
+func mustUnmarshal(bz []byte, msg interface{ Unmarshal([]byte) error }) {
+	if err := msg.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+}
+
+// indexedTraceHash returns the denomination trace hash suffix of a denomination trace index key.
+func indexedTraceHash(key []byte) []byte {
+	if len(key) < sha256.Size {
+		return key
+	}
+
+	return key[len(key)-sha256.Size:]
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/stretchr/testify/require"
 
@@ -21,6 +22,14 @@ func TestDecodeStore(t *testing.T) {
 		Path:      "transfer/channelToA",
 	}
 
+	totalEscrow := sdk.IntProto{Int: sdk.NewInt(100)}
+	totalEscrowBz, err := totalEscrow.Marshal()
+	require.NoError(t, err)
+
+	escrowAccount := types.NewEscrowAccount(types.PortID, "channel-0")
+	escrowAccountBz, err := escrowAccount.Marshal()
+	require.NoError(t, err)
+
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{
@@ -31,6 +40,22 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.DenomTraceKey,
 				Value: app.TransferKeeper.MustMarshalDenomTrace(trace),
 			},
+			{
+				Key:   types.TotalEscrowForDenomKey("uatom"),
+				Value: totalEscrowBz,
+			},
+			{
+				Key:   types.EscrowAccountKey(types.GetEscrowAddress(types.PortID, "channel-0")),
+				Value: escrowAccountBz,
+			},
+			{
+				Key:   types.DenomTraceByBaseDenomKey(trace.BaseDenom, trace.Hash()),
+				Value: []byte{},
+			},
+			{
+				Key:   types.DenomTraceByChannelKey(types.PortID, "channelToA", trace.Hash()),
+				Value: []byte{},
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -43,6 +68,10 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"PortID", fmt.Sprintf("Port A: %s\nPort B: %s", types.PortID, types.PortID)},
 		{"DenomTrace", fmt.Sprintf("DenomTrace A: %s\nDenomTrace B: %s", trace.IBCDenom(), trace.IBCDenom())},
+		{"TotalEscrow", "TotalEscrow A: 100\nTotalEscrow B: 100"},
+		{"EscrowAccount", fmt.Sprintf("EscrowAccount A: %v\nEscrowAccount B: %v", escrowAccount, escrowAccount)},
+		{"DenomTraceByBaseDenom", fmt.Sprintf("DenomTraceByBaseDenom A: %X\nDenomTraceByBaseDenom B: %X", trace.Hash(), trace.Hash())},
+		{"DenomTraceByChannel", fmt.Sprintf("DenomTraceByChannel A: %X\nDenomTraceByChannel B: %X", trace.Hash(), trace.Hash())},
 		{"other", ""},
 	}
 
@@ -43,7 +43,7 @@ func RandomizedGenState(simState *module.SimulationState) {
 	transferGenesis := types.GenesisState{
 		PortId:      portID,
 		DenomTraces: types.Traces{},
-		Params:      types.NewParams(sendEnabled, receiveEnabled),
+		Params:      types.NewParams(sendEnabled, receiveEnabled, nil, nil, types.DefaultRegisterDenomMetadata),
 	}
 
 	bz, err := json.MarshalIndent(&transferGenesis, "", " ")
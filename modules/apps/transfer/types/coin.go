@@ -40,6 +40,19 @@ func GetPrefixedDenom(portID, channelID, baseDenom string) string {
 	return fmt.Sprintf("%s/%s/%s", portID, channelID, baseDenom)
 }
 
+// GetReceivedDenomTrace returns the denomination trace on the receiving chain of the
+// tokens of a packet sent from the source port and channel to the destination port and
+// channel, given the denomination as it is set in the packet data.
+func GetReceivedDenomTrace(sourcePort, sourceChannel, destPort, destChannel, denom string) DenomTrace {
+	if ReceiverChainIsSource(sourcePort, sourceChannel, denom) {
+		// remove prefix added by sender chain
+		voucherPrefix := GetDenomPrefix(sourcePort, sourceChannel)
+		return ParseDenomTrace(denom[len(voucherPrefix):])
+	}
+
+	return ParseDenomTrace(GetPrefixedDenom(destPort, destChannel, denom))
+}
+
 // GetTransferCoin creates a transfer coin with the port ID and channel ID
 // prefixed to the base denom.
 func GetTransferCoin(portID, channelID, baseDenom string, amount sdk.Int) sdk.Coin {
@@ -0,0 +1,27 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+func TestGetReceivedDenomTrace(t *testing.T) {
+	testCases := []struct {
+		name     string
+		denom    string
+		expTrace types.DenomTrace
+	}{
+		{"native denom of sender chain", "uatom", types.DenomTrace{Path: "transfer/channel-1", BaseDenom: "uatom"}},
+		{"voucher of sender chain", "transfer/channel-5/uatom", types.DenomTrace{Path: "transfer/channel-1/transfer/channel-5", BaseDenom: "uatom"}},
+		{"native denom of receiver chain", "transfer/channel-0/uatom", types.DenomTrace{Path: "", BaseDenom: "uatom"}},
+		{"voucher returning to receiver chain", "transfer/channel-0/transfer/channel-5/uatom", types.DenomTrace{Path: "transfer/channel-5", BaseDenom: "uatom"}},
+	}
+
+	for _, tc := range testCases {
+		trace := types.GetReceivedDenomTrace("transfer", "channel-0", "transfer", "channel-1", tc.denom)
+		require.Equal(t, tc.expTrace, trace, tc.name)
+	}
+}
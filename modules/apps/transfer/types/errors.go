@@ -14,4 +14,11 @@ var (
 	ErrSendDisabled            = sdkerrors.Register(ModuleName, 7, "fungible token transfers from this chain are disabled")
 	ErrReceiveDisabled         = sdkerrors.Register(ModuleName, 8, "fungible token transfers to this chain are disabled")
 	ErrMaxTransferChannels     = sdkerrors.Register(ModuleName, 9, "max transfer channels")
+	ErrTransferHook            = sdkerrors.Register(ModuleName, 10, "transfer hook failed")
+	ErrInvalidMemo             = sdkerrors.Register(ModuleName, 11, "invalid memo")
+	ErrInvalidTransferFilter   = sdkerrors.Register(ModuleName, 12, "invalid transfer filter")
+	ErrChannelDisabled         = sdkerrors.Register(ModuleName, 13, "fungible token transfers over this channel are disabled")
+	ErrDenomNotAllowed         = sdkerrors.Register(ModuleName, 14, "denomination is not allowed")
+	ErrDenomBlocked            = sdkerrors.Register(ModuleName, 15, "denomination is blocked")
+	ErrInvalidEscrowAccount    = sdkerrors.Register(ModuleName, 16, "invalid escrow account")
 )
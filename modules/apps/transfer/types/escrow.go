@@ -0,0 +1,37 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewEscrowAccount creates a new EscrowAccount instance for the escrow address of the given channel.
+func NewEscrowAccount(portID, channelID string) EscrowAccount {
+	return EscrowAccount{
+		Address:   GetEscrowAddress(portID, channelID).String(),
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs a basic validation of the escrow account fields. The address must be
+// the escrow address of the channel.
+func (ea EscrowAccount) Validate() error {
+	if err := host.PortIdentifierValidator(ea.PortId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEscrowAccount, err.Error())
+	}
+
+	if err := host.ChannelIdentifierValidator(ea.ChannelId); err != nil {
+		return sdkerrors.Wrap(ErrInvalidEscrowAccount, err.Error())
+	}
+
+	if expAddress := GetEscrowAddress(ea.PortId, ea.ChannelId).String(); ea.Address != expAddress {
+		return sdkerrors.Wrapf(
+			ErrInvalidEscrowAccount,
+			"address %s is not the escrow address %s of port ID (%s) channel ID (%s)", ea.Address, expAddress, ea.PortId, ea.ChannelId,
+		)
+	}
+
+	return nil
+}
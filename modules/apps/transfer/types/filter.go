@@ -0,0 +1,110 @@
+package types
+
+import (
+	"strings"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// NewTransferFilter creates a new TransferFilter instance
+func NewTransferFilter(channelID string, disabled bool, allowedDenoms, blockedDenoms []string) TransferFilter {
+	return TransferFilter{
+		ChannelId:     channelID,
+		Disabled:      disabled,
+		AllowedDenoms: allowedDenoms,
+		BlockedDenoms: blockedDenoms,
+	}
+}
+
+// Validate performs a basic validation of the transfer filter fields. The channel identifier
+// may be empty, in which case the filter applies to all channels.
+func (f TransferFilter) Validate() error {
+	if f.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(f.ChannelId); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTransferFilter, "invalid channel: %s", err.Error())
+		}
+	}
+
+	if !f.Disabled && len(f.AllowedDenoms) == 0 && len(f.BlockedDenoms) == 0 {
+		return sdkerrors.Wrapf(ErrInvalidTransferFilter, "filter for channel %q has no effect", f.ChannelId)
+	}
+
+	if err := validateFilterDenoms(f.AllowedDenoms); err != nil {
+		return err
+	}
+
+	if err := validateFilterDenoms(f.BlockedDenoms); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// AppliesTo returns true if the filter applies to transfers over the channel.
+func (f TransferFilter) AppliesTo(channelID string) bool {
+	return f.ChannelId == "" || f.ChannelId == channelID
+}
+
+// Check returns an error describing why the filter refuses the transfer of tokens of the
+// denomination trace over the channel. Nil is returned if the filter does not refuse it.
+func (f TransferFilter) Check(channelID string, denomTrace DenomTrace) error {
+	if !f.AppliesTo(channelID) {
+		return nil
+	}
+
+	if f.Disabled {
+		return sdkerrors.Wrapf(ErrChannelDisabled, "channel: %s", channelID)
+	}
+
+	if matchDenom(f.BlockedDenoms, denomTrace) {
+		return sdkerrors.Wrapf(ErrDenomBlocked, "denom: %s, channel: %s", denomTrace.GetFullDenomPath(), channelID)
+	}
+
+	if len(f.AllowedDenoms) > 0 && !matchDenom(f.AllowedDenoms, denomTrace) {
+		return sdkerrors.Wrapf(ErrDenomNotAllowed, "denom: %s, channel: %s", denomTrace.GetFullDenomPath(), channelID)
+	}
+
+	return nil
+}
+
+// CheckTransferFilters returns the error of the first filter refusing the transfer of tokens
+// of the denomination trace over the channel. Nil is returned if no filter refuses it.
+func CheckTransferFilters(filters []TransferFilter, channelID string, denomTrace DenomTrace) error {
+	for _, filter := range filters {
+		if err := filter.Check(channelID, denomTrace); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// matchDenom returns true if any of the denominations is either the base denomination or
+// the full denomination path of the denomination trace.
+func matchDenom(denoms []string, denomTrace DenomTrace) bool {
+	for _, denom := range denoms {
+		if denom == denomTrace.BaseDenom || denom == denomTrace.GetFullDenomPath() {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateFilterDenoms validates that the denominations are base denominations or full
+// denomination traces.
+func validateFilterDenoms(denoms []string) error {
+	for _, denom := range denoms {
+		if strings.TrimSpace(denom) == "" {
+			return sdkerrors.Wrap(ErrInvalidTransferFilter, "denomination cannot be blank")
+		}
+
+		if err := ParseDenomTrace(denom).Validate(); err != nil {
+			return sdkerrors.Wrapf(ErrInvalidTransferFilter, "invalid denomination %s: %s", denom, err.Error())
+		}
+	}
+
+	return nil
+}
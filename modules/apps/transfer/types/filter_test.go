@@ -0,0 +1,80 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransferFilterValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		filter  TransferFilter
+		expPass bool
+	}{
+		{"channel disabled", NewTransferFilter("channel-0", true, nil, nil), true},
+		{"all channels", NewTransferFilter("", false, nil, []string{"uatom"}), true},
+		{"base and full trace denoms", NewTransferFilter("channel-0", false, []string{"uatom", "transfer/channel-1/uosmo"}, []string{"transfer/channel-0/uatom"}), true},
+		{"invalid channel", NewTransferFilter("channel", true, nil, nil), false},
+		{"no effect", NewTransferFilter("channel-0", false, nil, nil), false},
+		{"blank allowed denom", NewTransferFilter("channel-0", false, []string{" "}, nil), false},
+		{"invalid blocked denom", NewTransferFilter("channel-0", false, nil, []string{"transfer/channel-0/"}), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.filter.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestTransferFilterCheck(t *testing.T) {
+	native := ParseDenomTrace("uatom")
+	voucher := ParseDenomTrace("transfer/channel-1/uatom")
+	otherVoucher := ParseDenomTrace("transfer/channel-2/uatom")
+
+	testCases := []struct {
+		name       string
+		filter     TransferFilter
+		channelID  string
+		denomTrace DenomTrace
+		expErr     error
+	}{
+		{"other channel", NewTransferFilter("channel-1", true, nil, nil), "channel-0", native, nil},
+		{"channel disabled", NewTransferFilter("channel-0", true, nil, nil), "channel-0", native, ErrChannelDisabled},
+		{"all channels disabled", NewTransferFilter("", true, nil, nil), "channel-0", native, ErrChannelDisabled},
+		{"base denom blocked", NewTransferFilter("channel-0", false, nil, []string{"uatom"}), "channel-0", voucher, ErrDenomBlocked},
+		{"full trace blocked", NewTransferFilter("channel-0", false, nil, []string{"transfer/channel-1/uatom"}), "channel-0", voucher, ErrDenomBlocked},
+		{"other trace not blocked", NewTransferFilter("channel-0", false, nil, []string{"transfer/channel-1/uatom"}), "channel-0", otherVoucher, nil},
+		{"native denom not blocked by full trace", NewTransferFilter("channel-0", false, nil, []string{"transfer/channel-1/uatom"}), "channel-0", native, nil},
+		{"base denom allowed", NewTransferFilter("channel-0", false, []string{"uatom"}, nil), "channel-0", voucher, nil},
+		{"full trace allowed", NewTransferFilter("channel-0", false, []string{"transfer/channel-1/uatom"}, nil), "channel-0", voucher, nil},
+		{"other trace not allowed", NewTransferFilter("channel-0", false, []string{"transfer/channel-1/uatom"}, nil), "channel-0", otherVoucher, ErrDenomNotAllowed},
+		{"blocked takes precedence over allowed", NewTransferFilter("channel-0", false, []string{"uatom"}, []string{"transfer/channel-2/uatom"}), "channel-0", otherVoucher, ErrDenomBlocked},
+	}
+
+	for _, tc := range testCases {
+		err := tc.filter.Check(tc.channelID, tc.denomTrace)
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestCheckTransferFilters(t *testing.T) {
+	filters := []TransferFilter{
+		NewTransferFilter("", false, nil, []string{"uosmo"}),
+		NewTransferFilter("channel-0", false, []string{"uatom"}, nil),
+	}
+
+	require.NoError(t, CheckTransferFilters(nil, "channel-0", ParseDenomTrace("uosmo")))
+	require.NoError(t, CheckTransferFilters(filters, "channel-0", ParseDenomTrace("uatom")))
+	require.NoError(t, CheckTransferFilters(filters, "channel-1", ParseDenomTrace("ujuno")))
+	require.ErrorIs(t, CheckTransferFilters(filters, "channel-0", ParseDenomTrace("ujuno")), ErrDenomNotAllowed)
+	require.ErrorIs(t, CheckTransferFilters(filters, "channel-1", ParseDenomTrace("transfer/channel-1/uosmo")), ErrDenomBlocked)
+}
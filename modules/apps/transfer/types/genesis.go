@@ -1,24 +1,31 @@
 package types
 
 import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
-func NewGenesisState(portID string, denomTraces Traces, params Params) *GenesisState {
+func NewGenesisState(portID string, denomTraces Traces, params Params, totalEscrowed sdk.Coins, escrowAccounts []EscrowAccount) *GenesisState {
 	return &GenesisState{
-		PortId:      portID,
-		DenomTraces: denomTraces,
-		Params:      params,
+		PortId:         portID,
+		DenomTraces:    denomTraces,
+		Params:         params,
+		TotalEscrowed:  totalEscrowed,
+		EscrowAccounts: escrowAccounts,
 	}
 }
 
 // DefaultGenesisState returns a GenesisState with "transfer" as the default PortID.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		PortId:      PortID,
-		DenomTraces: Traces{},
-		Params:      DefaultParams(),
+		PortId:         PortID,
+		DenomTraces:    Traces{},
+		Params:         DefaultParams(),
+		TotalEscrowed:  sdk.Coins{},
+		EscrowAccounts: []EscrowAccount{},
 	}
 }
 
@@ -31,5 +38,24 @@ func (gs GenesisState) Validate() error {
 	if err := gs.DenomTraces.Validate(); err != nil {
 		return err
 	}
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.TotalEscrowed.Validate(); err != nil {
+		return sdkerrors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	seenAddresses := make(map[string]bool)
+	for i, escrowAccount := range gs.EscrowAccounts {
+		if err := escrowAccount.Validate(); err != nil {
+			return sdkerrors.Wrapf(err, "invalid escrow account %d", i)
+		}
+
+		if seenAddresses[escrowAccount.Address] {
+			return sdkerrors.Wrapf(ErrInvalidEscrowAccount, "duplicate escrow account %s", escrowAccount.Address)
+		}
+		seenAddresses[escrowAccount.Address] = true
+	}
+
+	return nil
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -28,6 +30,12 @@ type GenesisState struct {
 	PortId      string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
 	DenomTraces Traces `protobuf:"bytes,2,rep,name=denom_traces,json=denomTraces,proto3,castrepeated=Traces" json:"denom_traces" yaml:"denom_traces"`
 	Params      Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// total_escrowed contains the total amount of tokens escrowed
+	// by the transfer module for each denomination.
+	TotalEscrowed github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_escrowed,json=totalEscrowed,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_escrowed" yaml:"total_escrowed"`
+	// escrow_accounts contains the escrow accounts which have been used to
+	// escrow tokens.
+	EscrowAccounts []EscrowAccount `protobuf:"bytes,5,rep,name=escrow_accounts,json=escrowAccounts,proto3" json:"escrow_accounts" yaml:"escrow_accounts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -84,8 +92,88 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetTotalEscrowed() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalEscrowed
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrowAccounts() []EscrowAccount {
+	if m != nil {
+		return m.EscrowAccounts
+	}
+	return nil
+}
+
+// EscrowAccount defines the escrow address of a transfer channel which has
+// been used to escrow tokens.
+type EscrowAccount struct {
+	// escrow address derived from the port and channel identifiers.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// port identifier of the channel.
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel identifier of the channel.
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+}
+
+func (m *EscrowAccount) Reset()         { *m = EscrowAccount{} }
+func (m *EscrowAccount) String() string { return proto.CompactTextString(m) }
+func (*EscrowAccount) ProtoMessage()    {}
+func (*EscrowAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a4f788affd5bea89, []int{1}
+}
+func (m *EscrowAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EscrowAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EscrowAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EscrowAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EscrowAccount.Merge(m, src)
+}
+func (m *EscrowAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *EscrowAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_EscrowAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EscrowAccount proto.InternalMessageInfo
+
+func (m *EscrowAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *EscrowAccount) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *EscrowAccount) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.applications.transfer.v1.GenesisState")
+	proto.RegisterType((*EscrowAccount)(nil), "ibc.applications.transfer.v1.EscrowAccount")
 }
 
 func init() {
@@ -93,28 +181,39 @@ func init() {
 }
 
 var fileDescriptor_a4f788affd5bea89 = []byte{
-	// 324 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xc1, 0x4a, 0xc3, 0x30,
-	0x1c, 0xc6, 0x1b, 0x27, 0x15, 0xbb, 0xe1, 0xa1, 0x7a, 0x18, 0x43, 0xda, 0x51, 0x14, 0x8a, 0xc3,
-	0x84, 0x6d, 0x07, 0xc1, 0x63, 0x11, 0xc4, 0x9b, 0x4e, 0x4f, 0x5e, 0x46, 0x9a, 0xc6, 0x1a, 0x58,
-	0x9b, 0x92, 0x7f, 0x36, 0xd8, 0x5b, 0xf8, 0x1c, 0x3e, 0xc9, 0x8e, 0x3b, 0x7a, 0x9a, 0xb2, 0xbd,
-	0xc1, 0x7c, 0x01, 0x49, 0x37, 0xc7, 0x4e, 0xbd, 0x7d, 0x24, 0xbf, 0xef, 0xfb, 0xfe, 0x7c, 0xce,
-	0x95, 0x88, 0x19, 0xa1, 0x45, 0x31, 0x12, 0x8c, 0x6a, 0x21, 0x73, 0x20, 0x5a, 0xd1, 0x1c, 0xde,
-	0xb8, 0x22, 0x93, 0x2e, 0x49, 0x79, 0xce, 0x41, 0x00, 0x2e, 0x94, 0xd4, 0xd2, 0x3d, 0x17, 0x31,
-	0xc3, 0xfb, 0x2c, 0xfe, 0x67, 0xf1, 0xa4, 0xdb, 0xea, 0x54, 0x26, 0xed, 0xc8, 0x32, 0xaa, 0x75,
-	0x96, 0xca, 0x54, 0x96, 0x92, 0x18, 0xb5, 0x79, 0x0d, 0x7e, 0x91, 0xd3, 0xb8, 0xdf, 0x54, 0x3e,
-	0x6b, 0xaa, 0xb9, 0xdb, 0x71, 0x8e, 0x0a, 0xa9, 0xf4, 0x50, 0x24, 0x4d, 0xd4, 0x46, 0xe1, 0x71,
-	0xe4, 0xae, 0x17, 0xfe, 0xc9, 0x94, 0x66, 0xa3, 0xdb, 0x60, 0xfb, 0x11, 0x0c, 0x6c, 0xa3, 0x1e,
-	0x12, 0x57, 0x39, 0x8d, 0x84, 0xe7, 0x32, 0x1b, 0x6a, 0x45, 0x19, 0x87, 0xe6, 0x41, 0xbb, 0x16,
-	0xd6, 0x7b, 0x21, 0xae, 0xba, 0x1a, 0xdf, 0x19, 0xc7, 0x8b, 0x31, 0x44, 0x97, 0xb3, 0x85, 0x6f,
-	0xad, 0x17, 0xfe, 0xe9, 0x26, 0x7f, 0x3f, 0x2b, 0xf8, 0xfc, 0xf6, 0xed, 0x92, 0x82, 0x41, 0x3d,
-	0xd9, 0x59, 0xc0, 0x8d, 0x1c, 0xbb, 0xa0, 0x8a, 0x66, 0xd0, 0xac, 0xb5, 0x51, 0x58, 0xef, 0x5d,
-	0x54, 0xb7, 0x3d, 0x96, 0x6c, 0x74, 0x68, 0x9a, 0x06, 0x5b, 0x67, 0xf4, 0x34, 0x5b, 0x7a, 0x68,
-	0xbe, 0xf4, 0xd0, 0xcf, 0xd2, 0x43, 0x1f, 0x2b, 0xcf, 0x9a, 0xaf, 0x3c, 0xeb, 0x6b, 0xe5, 0x59,
-	0xaf, 0x37, 0xa9, 0xd0, 0xef, 0xe3, 0x18, 0x33, 0x99, 0x11, 0x26, 0x21, 0x93, 0x40, 0x44, 0xcc,
-	0xae, 0x53, 0x49, 0x26, 0x7d, 0x92, 0xc9, 0x64, 0x3c, 0xe2, 0x60, 0x26, 0xdf, 0x9b, 0x5a, 0x4f,
-	0x0b, 0x0e, 0xb1, 0x5d, 0xee, 0xd9, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x49, 0x1b, 0x71, 0xa7,
-	0xde, 0x01, 0x00, 0x00,
+	// 498 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0x6d, 0xd6, 0xd1, 0x69, 0xee, 0x56, 0x44, 0x60, 0x28, 0x4c, 0x28, 0xad, 0x22, 0x90, 0x2a,
+	0xaa, 0xd9, 0xea, 0x86, 0x84, 0xc4, 0x8d, 0x00, 0x42, 0xbd, 0x41, 0xe0, 0xc4, 0xa5, 0x72, 0x6c,
+	0x93, 0x59, 0x24, 0x71, 0x94, 0xcf, 0x2d, 0xda, 0x6f, 0x80, 0x03, 0xbf, 0x83, 0x5f, 0xb2, 0xe3,
+	0x8e, 0x9c, 0x0a, 0x6a, 0xff, 0x41, 0x2f, 0x5c, 0x51, 0xec, 0x6c, 0x64, 0x20, 0x95, 0x53, 0x3e,
+	0xe7, 0x7b, 0xef, 0xf9, 0xf9, 0xf9, 0x33, 0x7a, 0x24, 0x63, 0x46, 0x68, 0x51, 0xa4, 0x92, 0x51,
+	0x2d, 0x55, 0x0e, 0x44, 0x97, 0x34, 0x87, 0x0f, 0xa2, 0x24, 0xf3, 0x31, 0x49, 0x44, 0x2e, 0x40,
+	0x02, 0x2e, 0x4a, 0xa5, 0x95, 0x7b, 0x5f, 0xc6, 0x0c, 0x37, 0xb1, 0xf8, 0x12, 0x8b, 0xe7, 0xe3,
+	0xc3, 0xd1, 0x46, 0xa5, 0x2b, 0xa4, 0x91, 0x3a, 0xbc, 0x93, 0xa8, 0x44, 0x99, 0x92, 0x54, 0x55,
+	0xfd, 0xd7, 0x67, 0x0a, 0x32, 0x05, 0x24, 0xa6, 0x20, 0xc8, 0x7c, 0x1c, 0x0b, 0x4d, 0xc7, 0x84,
+	0x29, 0x99, 0xdb, 0x7e, 0xf0, 0xab, 0x8d, 0xf6, 0x5e, 0x59, 0x4b, 0x6f, 0x35, 0xd5, 0xc2, 0x1d,
+	0xa1, 0x9d, 0x42, 0x95, 0x7a, 0x2a, 0xb9, 0xe7, 0x0c, 0x9c, 0xe1, 0x6e, 0xe8, 0xae, 0x17, 0xfd,
+	0xde, 0x19, 0xcd, 0xd2, 0xa7, 0x41, 0xdd, 0x08, 0xa2, 0x4e, 0x55, 0x4d, 0xb8, 0x5b, 0xa2, 0x3d,
+	0x2e, 0x72, 0x95, 0x4d, 0x75, 0x49, 0x99, 0x00, 0x6f, 0x6b, 0xd0, 0x1e, 0x76, 0x8f, 0x87, 0x78,
+	0xd3, 0xa9, 0xf0, 0x8b, 0x8a, 0xf1, 0xae, 0x22, 0x84, 0x0f, 0xcf, 0x17, 0xfd, 0xd6, 0x7a, 0xd1,
+	0xbf, 0x6d, 0xf5, 0x9b, 0x5a, 0xc1, 0xb7, 0x1f, 0xfd, 0x8e, 0x41, 0x41, 0xd4, 0xe5, 0x57, 0x14,
+	0x70, 0x43, 0xd4, 0x29, 0x68, 0x49, 0x33, 0xf0, 0xda, 0x03, 0x67, 0xd8, 0x3d, 0x7e, 0xb0, 0x79,
+	0xb7, 0xd7, 0x06, 0x1b, 0x6e, 0x57, 0x3b, 0x45, 0x35, 0xd3, 0xfd, 0xec, 0xa0, 0x9e, 0x56, 0x9a,
+	0xa6, 0x53, 0x01, 0xac, 0x54, 0x9f, 0x04, 0xf7, 0xb6, 0x8d, 0xf5, 0x7b, 0xd8, 0xe6, 0x85, 0xab,
+	0xbc, 0x70, 0x9d, 0x17, 0x7e, 0xae, 0x64, 0x1e, 0x4e, 0x6a, 0xaf, 0x07, 0xd6, 0xeb, 0x75, 0x7a,
+	0xe5, 0x76, 0x98, 0x48, 0x7d, 0x3a, 0x8b, 0x31, 0x53, 0x19, 0xa9, 0x53, 0xb7, 0x9f, 0x23, 0xe0,
+	0x1f, 0x89, 0x3e, 0x2b, 0x04, 0x18, 0x25, 0x88, 0xf6, 0x0d, 0xf9, 0x65, 0xcd, 0x75, 0x35, 0xba,
+	0x69, 0x75, 0xa6, 0x94, 0x31, 0x35, 0xcb, 0x35, 0x78, 0x37, 0x8c, 0x9b, 0xd1, 0xe6, 0xa3, 0x59,
+	0x81, 0x67, 0x96, 0x13, 0xfa, 0xb5, 0xbf, 0xbb, 0xd6, 0xdf, 0x5f, 0x8a, 0x41, 0xd4, 0x13, 0x4d,
+	0x38, 0x04, 0x5f, 0x1c, 0xb4, 0x7f, 0x4d, 0xc1, 0xf5, 0xd0, 0x0e, 0xe5, 0xbc, 0x14, 0x00, 0xf6,
+	0xea, 0xa3, 0xcb, 0x65, 0x73, 0x28, 0xb6, 0xfe, 0x3b, 0x14, 0x8f, 0x11, 0x62, 0xa7, 0x34, 0xcf,
+	0x45, 0x5a, 0xe1, 0xdb, 0x06, 0x7f, 0xb0, 0x5e, 0xf4, 0x6f, 0x59, 0xfc, 0x9f, 0x5e, 0x10, 0xed,
+	0xd6, 0x8b, 0x09, 0x0f, 0xdf, 0x9c, 0x2f, 0x7d, 0xe7, 0x62, 0xe9, 0x3b, 0x3f, 0x97, 0xbe, 0xf3,
+	0x75, 0xe5, 0xb7, 0x2e, 0x56, 0x7e, 0xeb, 0xfb, 0xca, 0x6f, 0xbd, 0x7f, 0xf2, 0x6f, 0xae, 0x32,
+	0x66, 0x47, 0x89, 0x22, 0xf3, 0x13, 0x92, 0x29, 0x3e, 0x4b, 0x05, 0x54, 0xaf, 0xa4, 0xf1, 0x3a,
+	0x4c, 0xd8, 0x71, 0xc7, 0x8c, 0xf8, 0xc9, 0xef, 0x01, 0x00, 0x58, 0x5b, 0x05, 0xd9, 0x91, 0x03,
+	0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -137,6 +236,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.EscrowAccounts) > 0 {
+		for iNdEx := len(m.EscrowAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.EscrowAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TotalEscrowed) > 0 {
+		for iNdEx := len(m.TotalEscrowed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalEscrowed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -171,6 +298,50 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EscrowAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EscrowAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EscrowAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -200,6 +371,39 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.TotalEscrowed) > 0 {
+		for _, e := range m.TotalEscrowed {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.EscrowAccounts) > 0 {
+		for _, e := range m.EscrowAccounts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EscrowAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -337,6 +541,220 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalEscrowed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalEscrowed = append(m.TotalEscrowed, types.Coin{})
+			if err := m.TotalEscrowed[len(m.TotalEscrowed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EscrowAccounts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EscrowAccounts = append(m.EscrowAccounts, EscrowAccount{})
+			if err := m.EscrowAccounts[len(m.EscrowAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EscrowAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EscrowAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EscrowAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
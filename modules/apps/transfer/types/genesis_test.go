@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
@@ -26,6 +27,64 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"valid total escrow",
+			&types.GenesisState{
+				PortId:        "portidone",
+				TotalEscrowed: sdk.NewCoins(sdk.NewInt64Coin("stake", 100)),
+			},
+			true,
+		},
+		{
+			"invalid total escrow, unsorted denominations",
+			&types.GenesisState{
+				PortId:        "portidone",
+				TotalEscrowed: sdk.Coins{sdk.NewInt64Coin("uatom", 100), sdk.NewInt64Coin("stake", 100)},
+			},
+			false,
+		},
+		{
+			"invalid total escrow, zero amount",
+			&types.GenesisState{
+				PortId:        "portidone",
+				TotalEscrowed: sdk.Coins{sdk.NewInt64Coin("stake", 0)},
+			},
+			false,
+		},
+		{
+			"valid escrow accounts",
+			&types.GenesisState{
+				PortId:         "portidone",
+				EscrowAccounts: []types.EscrowAccount{types.NewEscrowAccount("transfer", "channel-0"), types.NewEscrowAccount("transfer", "channel-1")},
+			},
+			true,
+		},
+		{
+			"invalid escrow account, address of another channel",
+			&types.GenesisState{
+				PortId: "portidone",
+				EscrowAccounts: []types.EscrowAccount{
+					{Address: types.GetEscrowAddress("transfer", "channel-1").String(), PortId: "transfer", ChannelId: "channel-0"},
+				},
+			},
+			false,
+		},
+		{
+			"invalid escrow account, invalid channel identifier",
+			&types.GenesisState{
+				PortId:         "portidone",
+				EscrowAccounts: []types.EscrowAccount{types.NewEscrowAccount("transfer", "(channel-0)")},
+			},
+			false,
+		},
+		{
+			"invalid escrow accounts, duplicate escrow account",
+			&types.GenesisState{
+				PortId:         "portidone",
+				EscrowAccounts: []types.EscrowAccount{types.NewEscrowAccount("transfer", "channel-0"), types.NewEscrowAccount("transfer", "channel-0")},
+			},
+			false,
+		},
 		{
 			"invalid client",
 			&types.GenesisState{
@@ -1,23 +1,189 @@
 package types
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
 )
 
+// TransferHooks defines the callbacks which are invoked by the transfer keeper
+// during the lifecycle of an ICS20 packet. Every callback is given the packet,
+// its decoded packet data, the denomination trace resolved on this chain and
+// the local denomination (native denom or ibc voucher denom) of the token.
+//
+// The error returned by a hook is handled depending on the lifecycle point:
+//
+// - BeforeSendTransfer: the error is returned by SendTransfer and the transfer is aborted.
+// - AfterRecvTransfer: the error (or a panic) results in an error acknowledgement, all state
+// changes of the receive, including the minted or unescrowed tokens, are reverted.
+// - AfterTransferEnd, AfterRefundTransfer and AfterTimeoutTransfer: the hook is executed in
+// a cache context. On error (or panic) only the state changes of the hook are discarded and
+// an EventTypeHookError event is emitted, the packet lifecycle itself completes as usual.
 type TransferHooks interface {
-	AfterTransferEnd(ctx sdk.Context, data FungibleTokenPacketData, base_denom string)
+	// BeforeSendTransfer is called in SendTransfer before the tokens are escrowed
+	// or burned. Returning an error aborts the transfer.
+	BeforeSendTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error
+	// AfterRecvTransfer is called in OnRecvPacket after the vouchers have been
+	// minted or the tokens have been unescrowed to the receiver. Returning an
+	// error results in an error acknowledgement.
+	AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error
+	// AfterTransferEnd is called in OnAcknowledgementPacket when the counterparty
+	// chain returned a successful acknowledgement.
+	AfterTransferEnd(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error
+	// AfterRefundTransfer is called in OnAcknowledgementPacket after the sender
+	// has been refunded due to an error acknowledgement.
+	AfterRefundTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error
+	// AfterTimeoutTransfer is called in OnTimeoutPacket after the sender has been
+	// refunded due to a packet timeout.
+	AfterTimeoutTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error
 }
 
 var _ TransferHooks = MultiTransferHooks{}
 
+// MultiTransferHooks combines multiple transfer hooks, all hook functions are
+// run in array sequence. With the exception of BeforeSendTransfer, each hook is
+// run in its own cache context such that a failing hook neither discards the
+// state changes of the other hooks nor prevents them from being run.
 type MultiTransferHooks []TransferHooks
 
+// NewMultiTransferHooks returns a MultiTransferHooks for the given hooks.
 func NewMultiTransferHooks(hooks ...TransferHooks) MultiTransferHooks {
 	return hooks
 }
 
-func (h MultiTransferHooks) AfterTransferEnd(ctx sdk.Context, data FungibleTokenPacketData, base_denom string) {
+// BeforeSendTransfer runs the BeforeSendTransfer hook of each of the hooks in order.
+// The first error returned aborts the execution of the remaining hooks.
+func (h MultiTransferHooks) BeforeSendTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error {
 	for i := range h {
-		h[i].AfterTransferEnd(ctx, data, base_denom)
+		if err := h[i].BeforeSendTransfer(ctx, packet, data, trace, denom); err != nil {
+			return err
+		}
 	}
+
+	return nil
+}
+
+// AfterRecvTransfer runs the AfterRecvTransfer hook of each of the hooks in order, each
+// in its own cache context. A failing hook is reported through an EventTypeHookError event
+// and does not prevent the remaining hooks from being run. The error of the first failing
+// hook is returned so that the packet results in an error acknowledgement.
+func (h MultiTransferHooks) AfterRecvTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error {
+	return h.applyHooks(ctx, "AfterRecvTransfer", packet, func(hooks TransferHooks, cacheCtx sdk.Context) error {
+		return hooks.AfterRecvTransfer(cacheCtx, packet, data, trace, denom)
+	})
+}
+
+// AfterTransferEnd runs the AfterTransferEnd hook of each of the hooks in order, each in
+// its own cache context. A failing hook is reported through an EventTypeHookError event
+// and does not prevent the remaining hooks from being run. No error is returned as the
+// failures are isolated.
+func (h MultiTransferHooks) AfterTransferEnd(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error {
+	_ = h.applyHooks(ctx, "AfterTransferEnd", packet, func(hooks TransferHooks, cacheCtx sdk.Context) error {
+		return hooks.AfterTransferEnd(cacheCtx, packet, data, trace, denom)
+	})
+
+	return nil
+}
+
+// AfterRefundTransfer runs the AfterRefundTransfer hook of each of the hooks in order, each
+// in its own cache context. A failing hook is reported through an EventTypeHookError event
+// and does not prevent the remaining hooks from being run. No error is returned as the
+// failures are isolated.
+func (h MultiTransferHooks) AfterRefundTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error {
+	_ = h.applyHooks(ctx, "AfterRefundTransfer", packet, func(hooks TransferHooks, cacheCtx sdk.Context) error {
+		return hooks.AfterRefundTransfer(cacheCtx, packet, data, trace, denom)
+	})
+
+	return nil
+}
+
+// AfterTimeoutTransfer runs the AfterTimeoutTransfer hook of each of the hooks in order, each
+// in its own cache context. A failing hook is reported through an EventTypeHookError event
+// and does not prevent the remaining hooks from being run. No error is returned as the
+// failures are isolated.
+func (h MultiTransferHooks) AfterTimeoutTransfer(ctx sdk.Context, packet channeltypes.Packet, data FungibleTokenPacketData, trace DenomTrace, denom string) error {
+	_ = h.applyHooks(ctx, "AfterTimeoutTransfer", packet, func(hooks TransferHooks, cacheCtx sdk.Context) error {
+		return hooks.AfterTimeoutTransfer(cacheCtx, packet, data, trace, denom)
+	})
+
+	return nil
+}
+
+// applyHooks runs the provided hook function for each of the hooks in order, each within
+// its own cache context. An EventTypeHookError event is emitted for every failing hook and
+// the error of the first failing hook is returned once all the hooks have been run.
+func (h MultiTransferHooks) applyHooks(ctx sdk.Context, name string, packet channeltypes.Packet, hookFn func(hooks TransferHooks, cacheCtx sdk.Context) error) error {
+	var firstErr error
+	for i := range h {
+		hooks := h[i]
+		err := ApplyHookWithCache(ctx, func(cacheCtx sdk.Context) error {
+			return hookFn(hooks, cacheCtx)
+		})
+		if err == nil {
+			continue
+		}
+
+		ctx.EventManager().EmitEvent(
+			NewHookErrorEvent(name, packet, err).AppendAttributes(
+				sdk.NewAttribute(AttributeKeyHookIndex, fmt.Sprintf("%d", i)),
+			),
+		)
+
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	return firstErr
+}
+
+// NewHookErrorEvent returns the EventTypeHookError event reporting the failure of the
+// provided hook for the given packet.
+func NewHookErrorEvent(hook string, packet channeltypes.Packet, err error) sdk.Event {
+	return sdk.NewEvent(
+		EventTypeHookError,
+		sdk.NewAttribute(sdk.AttributeKeyModule, ModuleName),
+		sdk.NewAttribute(AttributeKeyHook, hook),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcPort, packet.GetSourcePort()),
+		sdk.NewAttribute(channeltypes.AttributeKeySrcChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(channeltypes.AttributeKeySequence, fmt.Sprintf("%d", packet.GetSequence())),
+		sdk.NewAttribute(AttributeKeyAckError, err.Error()),
+	)
+}
+
+// ApplyHookWithCache executes the provided hook within a cache context. The state changes
+// and events of the hook are only committed if it returns without error, the EventTypeHookError
+// events reporting the failures of combined hooks are however kept. A panic raised by the hook
+// is recovered and returned as an error, with the exception of out of gas panics which are
+// propagated to abort the transaction.
+func ApplyHookWithCache(ctx sdk.Context, hook func(cacheCtx sdk.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if _, ok := r.(sdk.ErrorOutOfGas); ok {
+				panic(r)
+			}
+
+			err = sdkerrors.Wrapf(ErrTransferHook, "recovered from panic: %v", r)
+		}
+	}()
+
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err := hook(cacheCtx); err != nil {
+		for _, event := range cacheCtx.EventManager().Events() {
+			if event.Type == EventTypeHookError {
+				ctx.EventManager().EmitEvent(event)
+			}
+		}
+
+		return sdkerrors.Wrap(ErrTransferHook, err.Error())
+	}
+
+	writeFn()
+	// NOTE: The context returned by CacheContext() refers to a new EventManager, so it needs to explicitly set events to the original context.
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+
+	return nil
 }
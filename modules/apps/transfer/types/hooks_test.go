@@ -0,0 +1,168 @@
+package types_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+var storeKey = sdk.NewKVStoreKey("hooks")
+
+// countingHooks counts the number of times each hook was called, writes its
+// key into the store and fails every hook with err if it is set.
+type countingHooks struct {
+	key   []byte
+	err   error
+	count int
+}
+
+func (h *countingHooks) call(ctx sdk.Context) error {
+	h.count++
+	ctx.KVStore(storeKey).Set(h.key, []byte{1})
+	return h.err
+}
+
+func (h *countingHooks) BeforeSendTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, _ types.DenomTrace, _ string) error {
+	return h.call(ctx)
+}
+
+func (h *countingHooks) AfterRecvTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, _ types.DenomTrace, _ string) error {
+	return h.call(ctx)
+}
+
+func (h *countingHooks) AfterTransferEnd(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, _ types.DenomTrace, _ string) error {
+	return h.call(ctx)
+}
+
+func (h *countingHooks) AfterRefundTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, _ types.DenomTrace, _ string) error {
+	return h.call(ctx)
+}
+
+func (h *countingHooks) AfterTimeoutTransfer(ctx sdk.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketData, _ types.DenomTrace, _ string) error {
+	return h.call(ctx)
+}
+
+// panickingHooks panics in every hook.
+type panickingHooks struct {
+	countingHooks
+}
+
+func (h *panickingHooks) AfterRecvTransfer(sdk.Context, channeltypes.Packet, types.FungibleTokenPacketData, types.DenomTrace, string) error {
+	panic("hook panicked")
+}
+
+func (h *panickingHooks) AfterTransferEnd(sdk.Context, channeltypes.Packet, types.FungibleTokenPacketData, types.DenomTrace, string) error {
+	panic("hook panicked")
+}
+
+func (h *panickingHooks) AfterRefundTransfer(sdk.Context, channeltypes.Packet, types.FungibleTokenPacketData, types.DenomTrace, string) error {
+	panic("hook panicked")
+}
+
+func (h *panickingHooks) AfterTimeoutTransfer(sdk.Context, channeltypes.Packet, types.FungibleTokenPacketData, types.DenomTrace, string) error {
+	panic("hook panicked")
+}
+
+func TestMultiTransferHooks(t *testing.T) {
+	var (
+		packet channeltypes.Packet
+		data   types.FungibleTokenPacketData
+		trace  = types.ParseDenomTrace("transfer/channel-0/uatom")
+		denom  = trace.IBCDenom()
+	)
+
+	hookFns := map[string]func(sdk.Context, types.MultiTransferHooks) error{
+		"AfterRecvTransfer": func(ctx sdk.Context, h types.MultiTransferHooks) error {
+			return h.AfterRecvTransfer(ctx, packet, data, trace, denom)
+		},
+		"AfterTransferEnd": func(ctx sdk.Context, h types.MultiTransferHooks) error {
+			return h.AfterTransferEnd(ctx, packet, data, trace, denom)
+		},
+		"AfterRefundTransfer": func(ctx sdk.Context, h types.MultiTransferHooks) error {
+			return h.AfterRefundTransfer(ctx, packet, data, trace, denom)
+		},
+		"AfterTimeoutTransfer": func(ctx sdk.Context, h types.MultiTransferHooks) error {
+			return h.AfterTimeoutTransfer(ctx, packet, data, trace, denom)
+		},
+	}
+
+	hookErrorEvents := func(ctx sdk.Context) []sdk.Event {
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeHookError {
+				events = append(events, event)
+			}
+		}
+
+		return events
+	}
+
+	for name, fn := range hookFns {
+		// all hooks are run in order
+		ctx := testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_hooks"))
+		first, second := &countingHooks{key: []byte("first")}, &countingHooks{key: []byte("second")}
+		require.NoError(t, fn(ctx, types.NewMultiTransferHooks(first, second)), name)
+		require.Equal(t, 1, first.count, name)
+		require.Equal(t, 1, second.count, name)
+		require.True(t, ctx.KVStore(storeKey).Has(first.key), name)
+		require.True(t, ctx.KVStore(storeKey).Has(second.key), name)
+		require.Empty(t, hookErrorEvents(ctx), name)
+
+		// a failing hook does not prevent the remaining hooks from being run and only its state changes are discarded
+		ctx = testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_hooks"))
+		first, second = &countingHooks{key: []byte("first"), err: errors.New("hook failed")}, &countingHooks{key: []byte("second")}
+		failing := &panickingHooks{countingHooks{key: []byte("panicking")}}
+		err := fn(ctx, types.NewMultiTransferHooks(first, second, failing))
+		if name == "AfterRecvTransfer" {
+			require.ErrorIs(t, err, types.ErrTransferHook, name)
+		} else {
+			require.NoError(t, err, name)
+		}
+
+		require.Equal(t, 1, first.count, name)
+		require.Equal(t, 1, second.count, name)
+		require.False(t, ctx.KVStore(storeKey).Has(first.key), name)
+		require.True(t, ctx.KVStore(storeKey).Has(second.key), name)
+
+		// an event is emitted for each failing hook
+		events := hookErrorEvents(ctx)
+		require.Len(t, events, 2, name)
+		for i, expIndex := range []string{"0", "2"} {
+			attributes := make(map[string]string)
+			for _, attr := range events[i].Attributes {
+				attributes[string(attr.Key)] = string(attr.Value)
+			}
+
+			require.Equal(t, name, attributes[types.AttributeKeyHook], name)
+			require.Equal(t, expIndex, attributes[types.AttributeKeyHookIndex], name)
+		}
+	}
+}
+
+func TestMultiTransferHooksBeforeSendTransfer(t *testing.T) {
+	var (
+		ctx    = testutil.DefaultContext(storeKey, sdk.NewTransientStoreKey("transient_hooks"))
+		packet channeltypes.Packet
+		data   types.FungibleTokenPacketData
+		trace  = types.ParseDenomTrace("transfer/channel-0/uatom")
+		denom  = trace.IBCDenom()
+	)
+
+	// all hooks are run in order
+	first, second := &countingHooks{key: []byte("first")}, &countingHooks{key: []byte("second")}
+	require.NoError(t, types.NewMultiTransferHooks(first, second).BeforeSendTransfer(ctx, packet, data, trace, denom))
+	require.Equal(t, 1, first.count)
+	require.Equal(t, 1, second.count)
+
+	// the first error aborts the execution of the remaining hooks
+	first, second = &countingHooks{key: []byte("first"), err: errors.New("hook failed")}, &countingHooks{key: []byte("second")}
+	require.Error(t, types.NewMultiTransferHooks(first, second).BeforeSendTransfer(ctx, packet, data, trace, denom))
+	require.Equal(t, 1, first.count)
+	require.Equal(t, 0, second.count)
+}
@@ -36,8 +36,52 @@ var (
 	PortKey = []byte{0x01}
 	// DenomTraceKey defines the key to store the denomination trace info in store
 	DenomTraceKey = []byte{0x02}
+	// TotalEscrowPrefix defines the prefix to store the total amount of tokens in escrow per denomination
+	TotalEscrowPrefix = []byte{0x03}
+	// EscrowAccountPrefix defines the prefix to store the escrow accounts which have been used to escrow tokens
+	EscrowAccountPrefix = []byte{0x04}
+	// DenomTraceByBaseDenomPrefix defines the prefix of the index of the denomination traces by base denomination
+	DenomTraceByBaseDenomPrefix = []byte{0x05}
+	// DenomTraceByChannelPrefix defines the prefix of the index of the denomination traces by the channel
+	// the tokens were received through
+	DenomTraceByChannelPrefix = []byte{0x06}
 )
 
+// TotalEscrowForDenomKey returns the store key of the total amount of tokens in escrow for the given denomination.
+func TotalEscrowForDenomKey(denom string) []byte {
+	return append(append([]byte{}, TotalEscrowPrefix...), []byte(denom)...)
+}
+
+// EscrowAccountKey returns the store key of the escrow account with the given escrow address.
+func EscrowAccountKey(escrowAddress sdk.AccAddress) []byte {
+	return append(append([]byte{}, EscrowAccountPrefix...), escrowAddress...)
+}
+
+// DenomTraceByBaseDenomPrefixKey returns the store key prefix of the index entries of the denomination
+// traces of the given base denomination. The base denomination is hashed so that the prefix of one base
+// denomination is never the prefix of another.
+func DenomTraceByBaseDenomPrefixKey(baseDenom string) []byte {
+	hash := sha256.Sum256([]byte(baseDenom))
+	return append(append([]byte{}, DenomTraceByBaseDenomPrefix...), hash[:]...)
+}
+
+// DenomTraceByBaseDenomKey returns the store key of the index entry of a denomination trace by its base denomination.
+func DenomTraceByBaseDenomKey(baseDenom string, traceHash []byte) []byte {
+	return append(DenomTraceByBaseDenomPrefixKey(baseDenom), traceHash...)
+}
+
+// DenomTraceByChannelPrefixKey returns the store key prefix of the index entries of the denomination
+// traces of the tokens received through the given channel.
+func DenomTraceByChannelPrefixKey(portID, channelID string) []byte {
+	return append(append([]byte{}, DenomTraceByChannelPrefix...), []byte(fmt.Sprintf("%s/%s/", portID, channelID))...)
+}
+
+// DenomTraceByChannelKey returns the store key of the index entry of a denomination trace by the channel
+// the tokens were received through.
+func DenomTraceByChannelKey(portID, channelID string, traceHash []byte) []byte {
+	return append(DenomTraceByChannelPrefixKey(portID, channelID), traceHash...)
+}
+
 // GetEscrowAddress returns the escrow address for the specified channel.
 // The escrow address follows the format as outlined in ADR 028:
 // https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
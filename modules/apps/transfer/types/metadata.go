@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// NewDenomMetadata returns the bank metadata of the IBC voucher of the given denomination trace.
+// The IBC denomination (ibc/{hash}) is used as the base and display denomination since the bank
+// metadata is keyed by the base denomination, the base denomination of the trace is added as an
+// alias if it is a valid denomination. The name and symbol are derived from the trace and the
+// description contains the full denomination path.
+func NewDenomMetadata(denomTrace DenomTrace) banktypes.Metadata {
+	voucherDenom := denomTrace.IBCDenom()
+	fullDenomPath := denomTrace.GetFullDenomPath()
+
+	denomUnit := &banktypes.DenomUnit{
+		Denom:    voucherDenom,
+		Exponent: 0,
+	}
+
+	if denomTrace.BaseDenom != voucherDenom && sdk.ValidateDenom(denomTrace.BaseDenom) == nil {
+		denomUnit.Aliases = []string{denomTrace.BaseDenom}
+	}
+
+	return banktypes.Metadata{
+		Description: fmt.Sprintf("IBC token from %s", fullDenomPath),
+		DenomUnits:  []*banktypes.DenomUnit{denomUnit},
+		Base:        voucherDenom,
+		Display:     voucherDenom,
+		Name:        fmt.Sprintf("%s IBC token", fullDenomPath),
+		Symbol:      strings.ToUpper(denomTrace.BaseDenom),
+	}
+}
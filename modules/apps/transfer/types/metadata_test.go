@@ -0,0 +1,36 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v3/modules/apps/transfer/types"
+)
+
+func TestNewDenomMetadata(t *testing.T) {
+	testCases := []struct {
+		name       string
+		denomTrace types.DenomTrace
+		expAliases []string
+	}{
+		{"single trace", types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1"}, []string{"uatom"}},
+		{"multiple traces", types.DenomTrace{BaseDenom: "uatom", Path: "transfer/channel-1/transfer/channel-52"}, []string{"uatom"}},
+		{"base denomination is not a valid sdk denomination", types.DenomTrace{BaseDenom: "0x85bcBCd7e79Ec36f4fBBDc54F90C643d921151AA", Path: "transfer/channel-1"}, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			metadata := types.NewDenomMetadata(tc.denomTrace)
+			require.NoError(t, metadata.Validate())
+
+			require.Equal(t, tc.denomTrace.IBCDenom(), metadata.Base)
+			require.Equal(t, tc.denomTrace.IBCDenom(), metadata.Display)
+			require.Equal(t, tc.expAliases, metadata.DenomUnits[0].Aliases)
+			require.Contains(t, metadata.Name, tc.denomTrace.GetFullDenomPath())
+			require.Contains(t, metadata.Description, tc.denomTrace.GetFullDenomPath())
+		})
+	}
+}
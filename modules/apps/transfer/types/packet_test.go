@@ -1,6 +1,7 @@
 package types
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -20,15 +21,18 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		packetData FungibleTokenPacketData
 		expPass    bool
 	}{
-		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2), true},
-		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2), true},
-		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2), false},
-		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2), false},
-		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2), false},
-		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2), false},
-		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2), false},
-		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2), false},
-		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr), false},
+		{"valid packet", NewFungibleTokenPacketData(denom, amount, addr1, addr2, ""), true},
+		{"valid packet with large amount", NewFungibleTokenPacketData(denom, largeAmount, addr1, addr2, ""), true},
+		{"invalid denom", NewFungibleTokenPacketData("", amount, addr1, addr2, ""), false},
+		{"invalid empty amount", NewFungibleTokenPacketData(denom, "", addr1, addr2, ""), false},
+		{"invalid zero amount", NewFungibleTokenPacketData(denom, "0", addr1, addr2, ""), false},
+		{"invalid negative amount", NewFungibleTokenPacketData(denom, "-1", addr1, addr2, ""), false},
+		{"invalid large amount", NewFungibleTokenPacketData(denom, invalidLargeAmount, addr1, addr2, ""), false},
+		{"missing sender address", NewFungibleTokenPacketData(denom, amount, emptyAddr, addr2, ""), false},
+		{"missing recipient address", NewFungibleTokenPacketData(denom, amount, addr1, emptyAddr, ""), false},
+		{"valid packet with memo", NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo"), true},
+		{"valid packet with memo of maximum length", NewFungibleTokenPacketData(denom, amount, addr1, addr2, strings.Repeat("m", MaximumMemoLength)), true},
+		{"memo too long", NewFungibleTokenPacketData(denom, amount, addr1, addr2, strings.Repeat("m", MaximumMemoLength+1)), false},
 	}
 
 	for i, tc := range testCases {
@@ -40,3 +44,20 @@ func TestFungibleTokenPacketDataValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+// TestFungibleTokenPacketDataGetBytes tests that the memo is only encoded if it is set,
+// so that the packet data remains backwards compatible.
+func TestFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := NewFungibleTokenPacketData(denom, amount, addr1, addr2, "")
+	expected := `{"amount":"100","denom":"transfer/gaiachannel/atom","receiver":"` + addr2 + `","sender":"` + addr1 + `"}`
+	require.Equal(t, expected, string(packetData.GetBytes()))
+
+	packetData = NewFungibleTokenPacketData(denom, amount, addr1, addr2, "memo")
+	expected = `{"amount":"100","denom":"transfer/gaiachannel/atom","memo":"memo","receiver":"` + addr2 + `","sender":"` + addr1 + `"}`
+	require.Equal(t, expected, string(packetData.GetBytes()))
+
+	// packet data encoded with the memo must decode into the same packet data
+	var decoded FungibleTokenPacketData
+	require.NoError(t, ModuleCdc.UnmarshalJSON(packetData.GetBytes(), &decoded))
+	require.Equal(t, packetData, decoded)
+}
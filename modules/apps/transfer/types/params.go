@@ -3,6 +3,7 @@ package types
 import (
 	"fmt"
 
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -11,6 +12,8 @@ const (
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// DefaultRegisterDenomMetadata enabled
+	DefaultRegisterDenomMetadata = true
 )
 
 var (
@@ -18,6 +21,12 @@ var (
 	KeySendEnabled = []byte("SendEnabled")
 	// KeyReceiveEnabled is store's key for ReceiveEnabled Params
 	KeyReceiveEnabled = []byte("ReceiveEnabled")
+	// KeySendFilters is store's key for SendFilters Params
+	KeySendFilters = []byte("SendFilters")
+	// KeyReceiveFilters is store's key for ReceiveFilters Params
+	KeyReceiveFilters = []byte("ReceiveFilters")
+	// KeyRegisterDenomMetadata is store's key for RegisterDenomMetadata Params
+	KeyRegisterDenomMetadata = []byte("RegisterDenomMetadata")
 )
 
 // ParamKeyTable type declaration for parameters
@@ -26,16 +35,19 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new parameter configuration for the ibc transfer module
-func NewParams(enableSend, enableReceive bool) Params {
+func NewParams(enableSend, enableReceive bool, sendFilters, receiveFilters []TransferFilter, registerDenomMetadata bool) Params {
 	return Params{
-		SendEnabled:    enableSend,
-		ReceiveEnabled: enableReceive,
+		SendEnabled:           enableSend,
+		ReceiveEnabled:        enableReceive,
+		SendFilters:           sendFilters,
+		ReceiveFilters:        receiveFilters,
+		RegisterDenomMetadata: registerDenomMetadata,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the ibc-transfer module
 func DefaultParams() Params {
-	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
+	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled, nil, nil, DefaultRegisterDenomMetadata)
 }
 
 // Validate all ibc-transfer module parameters
@@ -44,7 +56,40 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateEnabled(p.ReceiveEnabled)
+	if err := validateEnabled(p.ReceiveEnabled); err != nil {
+		return err
+	}
+
+	if err := validateFilters(p.SendFilters); err != nil {
+		return err
+	}
+
+	if err := validateFilters(p.ReceiveFilters); err != nil {
+		return err
+	}
+
+	return validateEnabled(p.RegisterDenomMetadata)
+}
+
+// CheckSend returns an error describing why sending tokens of the denomination trace
+// over the channel is refused. Nil is returned if the tokens may be sent.
+func (p Params) CheckSend(channelID string, denomTrace DenomTrace) error {
+	if !p.SendEnabled {
+		return ErrSendDisabled
+	}
+
+	return CheckTransferFilters(p.SendFilters, channelID, denomTrace)
+}
+
+// CheckReceive returns an error describing why receiving tokens of the denomination trace
+// over the channel is refused. The denomination trace is the trace of the tokens on this
+// chain. Nil is returned if the tokens may be received.
+func (p Params) CheckReceive(channelID string, denomTrace DenomTrace) error {
+	if !p.ReceiveEnabled {
+		return ErrReceiveDisabled
+	}
+
+	return CheckTransferFilters(p.ReceiveFilters, channelID, denomTrace)
 }
 
 // ParamSetPairs implements params.ParamSet
@@ -52,6 +97,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeySendEnabled, p.SendEnabled, validateEnabled),
 		paramtypes.NewParamSetPair(KeyReceiveEnabled, p.ReceiveEnabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeySendFilters, p.SendFilters, validateFiltersParam),
+		paramtypes.NewParamSetPair(KeyReceiveFilters, p.ReceiveFilters, validateFiltersParam),
+		paramtypes.NewParamSetPair(KeyRegisterDenomMetadata, p.RegisterDenomMetadata, validateEnabled),
 	}
 }
 
@@ -63,3 +111,28 @@ func validateEnabled(i interface{}) error {
 
 	return nil
 }
+
+func validateFiltersParam(i interface{}) error {
+	filters, ok := i.([]TransferFilter)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return validateFilters(filters)
+}
+
+func validateFilters(filters []TransferFilter) error {
+	channels := make(map[string]bool)
+	for _, filter := range filters {
+		if err := filter.Validate(); err != nil {
+			return err
+		}
+
+		if channels[filter.ChannelId] {
+			return sdkerrors.Wrapf(ErrInvalidTransferFilter, "duplicate filter for channel %q", filter.ChannelId)
+		}
+		channels[filter.ChannelId] = true
+	}
+
+	return nil
+}
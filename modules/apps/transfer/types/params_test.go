@@ -8,5 +8,27 @@ import (
 
 func TestValidateParams(t *testing.T) {
 	require.NoError(t, DefaultParams().Validate())
-	require.NoError(t, NewParams(true, false).Validate())
+	require.NoError(t, NewParams(true, false, nil, nil, true).Validate())
+	require.NoError(t, NewParams(true, true, nil, nil, false).Validate())
+	require.NoError(t, NewParams(true, true, []TransferFilter{NewTransferFilter("channel-0", true, nil, nil)}, []TransferFilter{NewTransferFilter("", false, nil, []string{"uatom"})}, true).Validate())
+
+	// invalid filter
+	require.Error(t, NewParams(true, true, []TransferFilter{NewTransferFilter("channel-0", false, nil, nil)}, nil, true).Validate())
+	// duplicate channel filters
+	require.Error(t, NewParams(true, true, nil, []TransferFilter{NewTransferFilter("channel-0", true, nil, nil), NewTransferFilter("channel-0", false, nil, []string{"uatom"})}, true).Validate())
+}
+
+func TestCheckSendAndReceive(t *testing.T) {
+	denomTrace := ParseDenomTrace("uatom")
+
+	require.NoError(t, DefaultParams().CheckSend("channel-0", denomTrace))
+	require.NoError(t, DefaultParams().CheckReceive("channel-0", denomTrace))
+
+	require.ErrorIs(t, NewParams(false, true, nil, nil, true).CheckSend("channel-0", denomTrace), ErrSendDisabled)
+	require.ErrorIs(t, NewParams(true, false, nil, nil, true).CheckReceive("channel-0", denomTrace), ErrReceiveDisabled)
+
+	filters := []TransferFilter{NewTransferFilter("channel-0", true, nil, nil)}
+	require.ErrorIs(t, NewParams(true, true, filters, nil, true).CheckSend("channel-0", denomTrace), ErrChannelDisabled)
+	require.NoError(t, NewParams(true, true, filters, nil, true).CheckReceive("channel-0", denomTrace))
+	require.ErrorIs(t, NewParams(true, true, nil, filters, true).CheckReceive("channel-0", denomTrace), ErrChannelDisabled)
 }
@@ -70,6 +70,18 @@ func (dt DenomTrace) GetFullDenomPath() string {
 	return dt.GetPrefix() + dt.BaseDenom
 }
 
+// GetLastHop returns the port and channel identifiers of the last hop of the tokens, i.e. the
+// leftmost identifiers of the trace path, which identify the channel on this chain the tokens
+// were received through. False is returned if the trace path is empty.
+func (dt DenomTrace) GetLastHop() (portID, channelID string, found bool) {
+	identifiers := strings.Split(dt.Path, "/")
+	if dt.Path == "" || len(identifiers) < 2 {
+		return "", "", false
+	}
+
+	return identifiers[0], identifiers[1], true
+}
+
 func validateTraceIdentifiers(identifiers []string) error {
 	if len(identifiers) == 0 || len(identifiers)%2 != 0 {
 		return fmt.Errorf("trace info must come in pairs of port and channel identifiers '{portID}/{channelID}', got the identifiers: %s", identifiers)
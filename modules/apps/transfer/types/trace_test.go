@@ -42,6 +42,27 @@ func TestDenomTrace_IBCDenom(t *testing.T) {
 	}
 }
 
+func TestDenomTrace_GetLastHop(t *testing.T) {
+	testCases := []struct {
+		name         string
+		trace        DenomTrace
+		expPortID    string
+		expChannelID string
+		expFound     bool
+	}{
+		{"base denom", DenomTrace{BaseDenom: "uatom"}, "", "", false},
+		{"single hop", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA"}, "transfer", "channelToA", true},
+		{"multiple hops", DenomTrace{BaseDenom: "uatom", Path: "transfer/channelToA/transfer/channelToB"}, "transfer", "channelToA", true},
+	}
+
+	for _, tc := range testCases {
+		portID, channelID, found := tc.trace.GetLastHop()
+		require.Equal(t, tc.expFound, found, tc.name)
+		require.Equal(t, tc.expPortID, portID, tc.name)
+		require.Equal(t, tc.expChannelID, channelID, tc.name)
+	}
+}
+
 func TestDenomTrace_Validate(t *testing.T) {
 	testCases := []struct {
 		name     string
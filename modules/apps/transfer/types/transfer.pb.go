@@ -81,9 +81,8 @@ func (m *DenomTrace) GetBaseDenom() string {
 }
 
 // Params defines the set of IBC transfer parameters.
-// NOTE: To prevent a single token from being transferred, set the
-// TransfersEnabled parameter to true and then set the bank module's SendEnabled
-// parameter for the denomination to false.
+// NOTE: To prevent a single token from being transferred, add a send or receive
+// filter blocking its denomination.
 type Params struct {
 	// send_enabled enables or disables all cross-chain token transfers from this
 	// chain.
@@ -91,6 +90,15 @@ type Params struct {
 	// receive_enabled enables or disables all cross-chain token transfers to this
 	// chain.
 	ReceiveEnabled bool `protobuf:"varint,2,opt,name=receive_enabled,json=receiveEnabled,proto3" json:"receive_enabled,omitempty" yaml:"receive_enabled"`
+	// send_filters restrict the channels and denominations of cross-chain token
+	// transfers from this chain.
+	SendFilters []TransferFilter `protobuf:"bytes,3,rep,name=send_filters,json=sendFilters,proto3" json:"send_filters" yaml:"send_filters"`
+	// receive_filters restrict the channels and denominations of cross-chain
+	// token transfers to this chain.
+	ReceiveFilters []TransferFilter `protobuf:"bytes,4,rep,name=receive_filters,json=receiveFilters,proto3" json:"receive_filters" yaml:"receive_filters"`
+	// register_denom_metadata enables or disables the registration of the bank
+	// metadata of new IBC vouchers received by this chain.
+	RegisterDenomMetadata bool `protobuf:"varint,5,opt,name=register_denom_metadata,json=registerDenomMetadata,proto3" json:"register_denom_metadata,omitempty" yaml:"register_denom_metadata"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -140,9 +148,113 @@ func (m *Params) GetReceiveEnabled() bool {
 	return false
 }
 
+func (m *Params) GetSendFilters() []TransferFilter {
+	if m != nil {
+		return m.SendFilters
+	}
+	return nil
+}
+
+func (m *Params) GetReceiveFilters() []TransferFilter {
+	if m != nil {
+		return m.ReceiveFilters
+	}
+	return nil
+}
+
+func (m *Params) GetRegisterDenomMetadata() bool {
+	if m != nil {
+		return m.RegisterDenomMetadata
+	}
+	return false
+}
+
+// TransferFilter restricts the cross-chain token transfers over a channel in
+// one direction. A transfer is refused if any filter applying to its channel
+// refuses it. A denomination in the allowed or blocked denominations is either
+// a base denomination (e.g. uatom), which matches the tokens of that base
+// denomination with any trace, or a full denomination trace (e.g.
+// transfer/channel-0/uatom), which only matches that trace. Denominations are
+// matched as they are represented on this chain.
+type TransferFilter struct {
+	// channel_id of the transfer channel the filter applies to. The filter
+	// applies to all channels if it is empty.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// disabled disables all transfers over the channel.
+	Disabled bool `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// allowed_denoms, if not empty, are the only denominations which may be
+	// transferred over the channel.
+	AllowedDenoms []string `protobuf:"bytes,3,rep,name=allowed_denoms,json=allowedDenoms,proto3" json:"allowed_denoms,omitempty" yaml:"allowed_denoms"`
+	// blocked_denoms are the denominations which may not be transferred over the
+	// channel.
+	BlockedDenoms []string `protobuf:"bytes,4,rep,name=blocked_denoms,json=blockedDenoms,proto3" json:"blocked_denoms,omitempty" yaml:"blocked_denoms"`
+}
+
+func (m *TransferFilter) Reset()         { *m = TransferFilter{} }
+func (m *TransferFilter) String() string { return proto.CompactTextString(m) }
+func (*TransferFilter) ProtoMessage()    {}
+func (*TransferFilter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5041673e96e97901, []int{2}
+}
+func (m *TransferFilter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TransferFilter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TransferFilter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TransferFilter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferFilter.Merge(m, src)
+}
+func (m *TransferFilter) XXX_Size() int {
+	return m.Size()
+}
+func (m *TransferFilter) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferFilter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferFilter proto.InternalMessageInfo
+
+func (m *TransferFilter) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *TransferFilter) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *TransferFilter) GetAllowedDenoms() []string {
+	if m != nil {
+		return m.AllowedDenoms
+	}
+	return nil
+}
+
+func (m *TransferFilter) GetBlockedDenoms() []string {
+	if m != nil {
+		return m.BlockedDenoms
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*DenomTrace)(nil), "ibc.applications.transfer.v1.DenomTrace")
 	proto.RegisterType((*Params)(nil), "ibc.applications.transfer.v1.Params")
+	proto.RegisterType((*TransferFilter)(nil), "ibc.applications.transfer.v1.TransferFilter")
 }
 
 func init() {
@@ -150,26 +262,38 @@ func init() {
 }
 
 var fileDescriptor_5041673e96e97901 = []byte{
-	// 300 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4a, 0x2b, 0x31,
-	0x14, 0x86, 0x9b, 0x72, 0x29, 0xb7, 0x51, 0x14, 0xa2, 0x68, 0x29, 0x9a, 0xca, 0xac, 0x04, 0x71,
-	0x42, 0xe9, 0x42, 0xe8, 0x46, 0xa8, 0xba, 0xd7, 0xe2, 0xca, 0x4d, 0x49, 0x32, 0xc7, 0x69, 0x60,
-	0x32, 0x19, 0x92, 0x74, 0xa0, 0x8f, 0xe0, 0xce, 0xc7, 0x72, 0xd9, 0xa5, 0xab, 0x22, 0xed, 0x1b,
-	0xf4, 0x09, 0x64, 0xd2, 0x52, 0x06, 0x77, 0xff, 0x39, 0xe7, 0xfb, 0xce, 0xe2, 0xc7, 0x37, 0x4a,
-	0x48, 0xc6, 0x8b, 0x22, 0x53, 0x92, 0x7b, 0x65, 0x72, 0xc7, 0xbc, 0xe5, 0xb9, 0x7b, 0x07, 0xcb,
-	0xca, 0xfe, 0x3e, 0xc7, 0x85, 0x35, 0xde, 0x90, 0x0b, 0x25, 0x64, 0x5c, 0x87, 0xe3, 0x3d, 0x50,
-	0xf6, 0xbb, 0xa7, 0xa9, 0x49, 0x4d, 0x00, 0x59, 0x95, 0xb6, 0x4e, 0x74, 0x8f, 0xf1, 0x23, 0xe4,
-	0x46, 0xbf, 0x5a, 0x2e, 0x81, 0x10, 0xfc, 0xaf, 0xe0, 0x7e, 0xda, 0x41, 0x57, 0xe8, 0xba, 0x3d,
-	0x0e, 0x99, 0x5c, 0x62, 0x2c, 0xb8, 0x83, 0x49, 0x52, 0x61, 0x9d, 0x66, 0xb8, 0xb4, 0xab, 0x4d,
-	0xf0, 0xa2, 0x0f, 0x84, 0x5b, 0xcf, 0xdc, 0x72, 0xed, 0xc8, 0x10, 0x1f, 0x3a, 0xc8, 0x93, 0x09,
-	0xe4, 0x5c, 0x64, 0x90, 0x84, 0x2f, 0xff, 0x47, 0xe7, 0x9b, 0x65, 0xef, 0x64, 0xce, 0x75, 0x36,
-	0x8c, 0xea, 0xd7, 0x68, 0x7c, 0x50, 0x8d, 0x4f, 0xdb, 0x89, 0x3c, 0xe0, 0x63, 0x0b, 0x12, 0x54,
-	0x09, 0x7b, 0xbd, 0x19, 0xf4, 0xee, 0x66, 0xd9, 0x3b, 0xdb, 0xea, 0x7f, 0x80, 0x68, 0x7c, 0xb4,
-	0xdb, 0xec, 0x9e, 0x8c, 0x5e, 0xbe, 0x56, 0x14, 0x2d, 0x56, 0x14, 0xfd, 0xac, 0x28, 0xfa, 0x5c,
-	0xd3, 0xc6, 0x62, 0x4d, 0x1b, 0xdf, 0x6b, 0xda, 0x78, 0xbb, 0x4b, 0x95, 0x9f, 0xce, 0x44, 0x2c,
-	0x8d, 0x66, 0xd2, 0x38, 0x6d, 0x1c, 0x53, 0x42, 0xde, 0xa6, 0x86, 0x95, 0x03, 0xa6, 0x4d, 0x32,
-	0xcb, 0xc0, 0x55, 0x3d, 0xd7, 0xfa, 0xf5, 0xf3, 0x02, 0x9c, 0x68, 0x85, 0x9a, 0x06, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xae, 0xdf, 0x93, 0x8e, 0x89, 0x01, 0x00, 0x00,
+	// 496 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x6e, 0xd6, 0x32, 0xad, 0x1e, 0x2b, 0xc2, 0x50, 0x56, 0x0a, 0x24, 0x93, 0x4f, 0x93, 0x80,
+	0x44, 0x63, 0x48, 0x48, 0xbb, 0x80, 0xc2, 0x87, 0xc4, 0x01, 0x09, 0xa2, 0x9d, 0x76, 0xa9, 0x1c,
+	0xc7, 0x4b, 0x2d, 0x9c, 0x38, 0x8a, 0xdd, 0xa2, 0xfd, 0x0b, 0x7e, 0xd6, 0xc4, 0x69, 0x47, 0x4e,
+	0x11, 0x6a, 0x25, 0x7e, 0x40, 0x7e, 0x01, 0x8a, 0x9d, 0xa4, 0x1f, 0x42, 0x1c, 0xb8, 0xbd, 0x1f,
+	0xcf, 0xfb, 0x3c, 0x8f, 0xfd, 0xea, 0x05, 0x4f, 0x59, 0x48, 0x3c, 0x9c, 0x65, 0x9c, 0x11, 0xac,
+	0x98, 0x48, 0xa5, 0xa7, 0x72, 0x9c, 0xca, 0x4b, 0x9a, 0x7b, 0xf3, 0x93, 0x36, 0x76, 0xb3, 0x5c,
+	0x28, 0x01, 0x1f, 0xb3, 0x90, 0xb8, 0xeb, 0x60, 0xb7, 0x05, 0xcc, 0x4f, 0xc6, 0xf7, 0x63, 0x11,
+	0x0b, 0x0d, 0xf4, 0xaa, 0xc8, 0xcc, 0xa0, 0xd7, 0x00, 0xbc, 0xa3, 0xa9, 0x48, 0xce, 0x73, 0x4c,
+	0x28, 0x84, 0xa0, 0x97, 0x61, 0x35, 0x1d, 0x59, 0x47, 0xd6, 0x71, 0x3f, 0xd0, 0x31, 0x7c, 0x02,
+	0x40, 0x88, 0x25, 0x9d, 0x44, 0x15, 0x6c, 0xb4, 0xa3, 0x3b, 0xfd, 0xaa, 0xa2, 0xe7, 0xd0, 0x8f,
+	0x2e, 0xd8, 0xfd, 0x8c, 0x73, 0x9c, 0x48, 0x78, 0x06, 0x6e, 0x4b, 0x9a, 0x46, 0x13, 0x9a, 0xe2,
+	0x90, 0xd3, 0x48, 0xb3, 0xec, 0xf9, 0x87, 0x65, 0xe1, 0xdc, 0xbb, 0xc2, 0x09, 0x3f, 0x43, 0xeb,
+	0x5d, 0x14, 0xec, 0x57, 0xe9, 0x7b, 0x93, 0xc1, 0xb7, 0xe0, 0x4e, 0x4e, 0x09, 0x65, 0x73, 0xda,
+	0x8e, 0xef, 0xe8, 0xf1, 0x71, 0x59, 0x38, 0x0f, 0xcc, 0xf8, 0x16, 0x00, 0x05, 0x83, 0xba, 0xd2,
+	0x90, 0xf0, 0xda, 0xc0, 0x25, 0xe3, 0x8a, 0xe6, 0x72, 0xd4, 0x3d, 0xea, 0x1e, 0xef, 0xbf, 0x78,
+	0xe6, 0xfe, 0xeb, 0x5f, 0xdc, 0xf3, 0x3a, 0xfe, 0xa0, 0x87, 0xfc, 0x47, 0xd7, 0x85, 0xd3, 0xd9,
+	0xb2, 0x5c, 0xf3, 0xd5, 0x96, 0x0d, 0x50, 0xc2, 0xd9, 0xca, 0x72, 0x23, 0xd8, 0xfb, 0x0f, 0x41,
+	0xbb, 0x16, 0xdc, 0x7a, 0x64, 0xab, 0xd9, 0x3c, 0xb2, 0x91, 0xbd, 0x00, 0x87, 0x39, 0x8d, 0x99,
+	0x54, 0x34, 0x37, 0x3b, 0x99, 0x24, 0x54, 0xe1, 0x08, 0x2b, 0x3c, 0xba, 0xa5, 0x7f, 0x0c, 0x95,
+	0x85, 0x63, 0x37, 0x64, 0x7f, 0x05, 0xa2, 0x60, 0xd8, 0x74, 0xf4, 0x12, 0x3f, 0x35, 0xf5, 0xdf,
+	0x16, 0x18, 0x6c, 0xda, 0x83, 0x2f, 0x01, 0x20, 0x53, 0x9c, 0xa6, 0x94, 0x4f, 0x98, 0x59, 0x69,
+	0xdf, 0x1f, 0x96, 0x85, 0x73, 0xd7, 0x28, 0xac, 0x7a, 0x28, 0xe8, 0xd7, 0xc9, 0xc7, 0x08, 0x8e,
+	0xc1, 0x5e, 0xc4, 0xe4, 0xda, 0x1e, 0x83, 0x36, 0x87, 0x6f, 0xc0, 0x00, 0x73, 0x2e, 0xbe, 0xd1,
+	0xc8, 0xd8, 0x32, 0x7b, 0xea, 0xfb, 0x0f, 0xcb, 0xc2, 0x19, 0x1a, 0xd6, 0xcd, 0x3e, 0x0a, 0x0e,
+	0xea, 0x82, 0x76, 0x2b, 0x2b, 0x86, 0x90, 0x0b, 0xf2, 0x75, 0xc5, 0xd0, 0xdb, 0x66, 0xd8, 0xec,
+	0xa3, 0xe0, 0xa0, 0x2e, 0x18, 0x06, 0xff, 0xcb, 0xf5, 0xc2, 0xb6, 0x6e, 0x16, 0xb6, 0xf5, 0x6b,
+	0x61, 0x5b, 0xdf, 0x97, 0x76, 0xe7, 0x66, 0x69, 0x77, 0x7e, 0x2e, 0xed, 0xce, 0xc5, 0xab, 0x98,
+	0xa9, 0xe9, 0x2c, 0x74, 0x89, 0x48, 0x3c, 0x22, 0x64, 0x22, 0xa4, 0xc7, 0x42, 0xf2, 0x3c, 0x16,
+	0xde, 0xfc, 0xd4, 0x4b, 0x44, 0x34, 0xe3, 0x54, 0x56, 0x17, 0xb9, 0x76, 0x89, 0xea, 0x2a, 0xa3,
+	0x32, 0xdc, 0xd5, 0x07, 0x75, 0xfa, 0x67, 0x00, 0x03, 0xd0, 0x2c, 0x15, 0xb3, 0x03, 0x00, 0x00,
 }
 
 func (m *DenomTrace) Marshal() (dAtA []byte, err error) {
@@ -229,6 +353,44 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RegisterDenomMetadata {
+		i--
+		if m.RegisterDenomMetadata {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.ReceiveFilters) > 0 {
+		for iNdEx := len(m.ReceiveFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiveFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.SendFilters) > 0 {
+		for iNdEx := len(m.SendFilters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SendFilters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTransfer(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.ReceiveEnabled {
 		i--
 		if m.ReceiveEnabled {
@@ -252,6 +414,64 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TransferFilter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TransferFilter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TransferFilter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BlockedDenoms) > 0 {
+		for iNdEx := len(m.BlockedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlockedDenoms[iNdEx])
+			copy(dAtA[i:], m.BlockedDenoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.BlockedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for iNdEx := len(m.AllowedDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedDenoms[iNdEx])
+			copy(dAtA[i:], m.AllowedDenoms[iNdEx])
+			i = encodeVarintTransfer(dAtA, i, uint64(len(m.AllowedDenoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTransfer(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTransfer(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTransfer(v)
 	base := offset
@@ -292,6 +512,49 @@ func (m *Params) Size() (n int) {
 	if m.ReceiveEnabled {
 		n += 2
 	}
+	if len(m.SendFilters) > 0 {
+		for _, e := range m.SendFilters {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.ReceiveFilters) > 0 {
+		for _, e := range m.ReceiveFilters {
+			l = e.Size()
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if m.RegisterDenomMetadata {
+		n += 2
+	}
+	return n
+}
+
+func (m *TransferFilter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTransfer(uint64(l))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	if len(m.AllowedDenoms) > 0 {
+		for _, s := range m.AllowedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
+	if len(m.BlockedDenoms) > 0 {
+		for _, s := range m.BlockedDenoms {
+			l = len(s)
+			n += 1 + l + sovTransfer(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -484,6 +747,260 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.ReceiveEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SendFilters = append(m.SendFilters, TransferFilter{})
+			if err := m.SendFilters[len(m.SendFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveFilters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiveFilters = append(m.ReceiveFilters, TransferFilter{})
+			if err := m.ReceiveFilters[len(m.ReceiveFilters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegisterDenomMetadata", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RegisterDenomMetadata = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TransferFilter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TransferFilter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TransferFilter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedDenoms = append(m.AllowedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockedDenoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockedDenoms = append(m.BlockedDenoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTransfer(dAtA[iNdEx:])
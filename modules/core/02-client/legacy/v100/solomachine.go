@@ -178,6 +178,15 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyPacketReceipt panics!
+func (cs ClientState) VerifyPacketReceipt(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, uint64, []byte,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // VerifyNextSequenceRecv panics!
 func (cs ClientState) VerifyNextSequenceRecv(
 	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
@@ -187,6 +196,15 @@ func (cs ClientState) VerifyNextSequenceRecv(
 	panic("legacy solo machine is deprecated!")
 }
 
+// VerifyNextSequenceAck panics!
+func (cs ClientState) VerifyNextSequenceAck(
+	sdk.Context, sdk.KVStore, codec.BinaryCodec, exported.Height,
+	uint64, uint64, exported.Prefix, []byte,
+	string, string, uint64,
+) error {
+	panic("legacy solo machine is deprecated!")
+}
+
 // ClientType panics!
 func (ConsensusState) ClientType() string {
 	panic("legacy solo machine is deprecated!")
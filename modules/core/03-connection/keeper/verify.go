@@ -2,11 +2,15 @@ package keeper
 
 import (
 	"math"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -258,6 +262,46 @@ func (k Keeper) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (k Keeper) VerifyPacketReceipt(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyPacketReceipt(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		sequence, receipt,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed packet receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (k Keeper) VerifyNextSequenceRecv(
@@ -297,6 +341,296 @@ func (k Keeper) VerifyNextSequenceRecv(
 	return nil
 }
 
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (k Keeper) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.GetDelayPeriod()
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	if err := clientState.VerifyNextSequenceAck(
+		ctx, clientStore, k.cdc, height,
+		timeDelay, blockDelay,
+		connection.GetCounterparty().GetPrefix(), proof, portID, channelID,
+		nextSequenceAck,
+	); err != nil {
+		return sdkerrors.Wrapf(err, "failed next sequence acknowledgement verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
+// VerifyMultihopMembership verifies a multi-hop proof of the value stored at the
+// given path on the counterparty chain at the end of the connection hops. The
+// provided connection must be the connection of the first hop.
+func (k Keeper) VerifyMultihopMembership(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	path commitmenttypes.MerklePath,
+	value []byte,
+) error {
+	multihopProofs, consensusState, _, prefix, err := k.verifyMultihopProofs(ctx, connection, height, proof, connectionHops)
+	if err != nil {
+		return err
+	}
+
+	prefixedPath, err := commitmenttypes.ApplyPrefix(prefix, path)
+	if err != nil {
+		return err
+	}
+
+	if err := multihopProofs.KeyProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), prefixedPath, value); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop membership verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// VerifyMultihopNonMembership verifies a multi-hop proof of the absence of a value
+// at the given path on the counterparty chain at the end of the connection hops.
+// The provided connection must be the connection of the first hop.
+func (k Keeper) VerifyMultihopNonMembership(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	path commitmenttypes.MerklePath,
+) error {
+	multihopProofs, consensusState, _, prefix, err := k.verifyMultihopProofs(ctx, connection, height, proof, connectionHops)
+	if err != nil {
+		return err
+	}
+
+	prefixedPath, err := commitmenttypes.ApplyPrefix(prefix, path)
+	if err != nil {
+		return err
+	}
+
+	if err := multihopProofs.KeyProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), prefixedPath); err != nil {
+		return sdkerrors.Wrapf(err, "failed multi-hop non-membership verification for client (%s)", connection.GetClientID())
+	}
+
+	return nil
+}
+
+// GetMultihopCounterpartyConsensusState verifies the connection and consensus
+// proofs of a multi-hop proof and returns the consensus state of the counterparty
+// chain at the end of the connection hops together with its height.
+func (k Keeper) GetMultihopCounterpartyConsensusState(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+) (exported.ConsensusState, exported.Height, error) {
+	_, consensusState, consensusHeight, _, err := k.verifyMultihopProofs(ctx, connection, height, proof, connectionHops)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return consensusState, consensusHeight, nil
+}
+
+// GetMultihopCounterpartyConnectionHops returns the connection hops of the
+// counterparty channel end of a multi-hop channel. The connection identifiers on
+// the counterparty side of each hop are read from the connection ends of the
+// provided connection and the connection proofs, which are verified together
+// with the key proof.
+func (k Keeper) GetMultihopCounterpartyConnectionHops(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	proof []byte,
+	connectionHops []string,
+) ([]string, error) {
+	multihopProofs, err := k.unmarshalMultihopProofs(proof, connectionHops)
+	if err != nil {
+		return nil, err
+	}
+
+	counterpartyHops := make([]string, len(connectionHops))
+	counterpartyHops[len(connectionHops)-1] = connection.GetCounterparty().GetConnectionID()
+
+	for i, connectionProof := range multihopProofs.ConnectionProofs {
+		var connectionEnd types.ConnectionEnd
+		if err := k.cdc.Unmarshal(connectionProof.Value, &connectionEnd); err != nil {
+			return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal connection end of connection hop %d", i+1)
+		}
+
+		counterpartyHops[len(connectionHops)-2-i] = connectionEnd.GetCounterparty().GetConnectionID()
+	}
+
+	return counterpartyHops, nil
+}
+
+// verifyMultihopProofs verifies the connection and consensus proofs of a multi-hop
+// proof, starting with the consensus state of the first hop stored on the client
+// of the provided connection. The unmarshalled proofs are returned together with
+// the proven consensus state of the counterparty chain, its height and the key
+// prefix of the counterparty chain.
+//
+// NOTE: connection delay periods cannot be enforced on the consensus states of
+// the chains along the path, connections with a delay period are therefore not
+// supported by multi-hop channels.
+func (k Keeper) verifyMultihopProofs(
+	ctx sdk.Context,
+	connection exported.ConnectionI,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+) (commitmenttypes.MultihopProofs, exported.ConsensusState, exported.Height, exported.Prefix, error) {
+	multihopProofs, err := k.unmarshalMultihopProofs(proof, connectionHops)
+	if err != nil {
+		return commitmenttypes.MultihopProofs{}, nil, nil, nil, err
+	}
+
+	clientID := connection.GetClientID()
+	clientStore := k.clientKeeper.ClientStore(ctx, clientID)
+
+	clientState, found := k.clientKeeper.GetClientState(ctx, clientID)
+	if !found {
+		return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrap(clienttypes.ErrClientNotFound, clientID)
+	}
+
+	if status := clientState.Status(ctx, clientStore, k.cdc); status != exported.Active {
+		return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	if connection.GetDelayPeriod() != 0 {
+		return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(types.ErrInvalidConnection, "connection hop 0 has a delay period, multi-hop channels do not support delay periods")
+	}
+
+	consensusState, found := k.clientKeeper.GetClientConsensusState(ctx, clientID, height)
+	if !found {
+		return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(
+			clienttypes.ErrConsensusStateNotFound, "please ensure the proof was constructed against a height that exists on the client (%s): %s", clientID, height,
+		)
+	}
+
+	consensusHeight := height
+	prefix := connection.GetCounterparty().GetPrefix()
+
+	for i, connectionID := range connectionHops[1:] {
+		// verify the connection end of the next hop on the chain proven by the previous consensus state
+		connectionProof := multihopProofs.ConnectionProofs[i]
+		connectionPath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.ConnectionPath(connectionID)))
+		if err != nil {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, err
+		}
+
+		if err := connectionProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), connectionPath, connectionProof.Value); err != nil {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(err, "failed connection state verification for connection hop %d (%s)", i+1, connectionID)
+		}
+
+		var connectionEnd types.ConnectionEnd
+		if err := k.cdc.Unmarshal(connectionProof.Value, &connectionEnd); err != nil {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal connection end of connection hop %d (%s)", i+1, connectionID)
+		}
+
+		if connectionEnd.State != types.OPEN {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(
+				types.ErrInvalidConnectionState, "connection hop %d (%s) is not OPEN (got %s)", i+1, connectionID, connectionEnd.State,
+			)
+		}
+
+		if connectionEnd.DelayPeriod != 0 {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(types.ErrInvalidConnection, "connection hop %d (%s) has a delay period, multi-hop channels do not support delay periods", i+1, connectionID)
+		}
+
+		// verify the consensus state of the chain following the connection hop, which is
+		// stored on the client of the connection end
+		consensusProof := multihopProofs.ConsensusProofs[i]
+		consensusHeight, err = parseConsensusProofHeight(consensusProof)
+		if err != nil {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, err
+		}
+
+		consensusPath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(host.FullConsensusStatePath(connectionEnd.ClientId, consensusHeight)))
+		if err != nil {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, err
+		}
+
+		if err := consensusProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), consensusState.GetRoot(), consensusPath, consensusProof.Value); err != nil {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(err, "failed consensus state verification for connection hop %d (%s)", i+1, connectionID)
+		}
+
+		consensusState, err = clienttypes.UnmarshalConsensusState(k.cdc, consensusProof.Value)
+		if err != nil {
+			return commitmenttypes.MultihopProofs{}, nil, nil, nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to unmarshal consensus state of connection hop %d (%s): %s", i+1, connectionID, err)
+		}
+
+		prefix = connectionEnd.GetCounterparty().GetPrefix()
+	}
+
+	return multihopProofs, consensusState, consensusHeight, prefix, nil
+}
+
+// unmarshalMultihopProofs unmarshals the multi-hop proofs and checks that a
+// connection and consensus proof is provided for every connection hop following
+// the first hop.
+func (k Keeper) unmarshalMultihopProofs(proof []byte, connectionHops []string) (commitmenttypes.MultihopProofs, error) {
+	if len(connectionHops) < 2 {
+		return commitmenttypes.MultihopProofs{}, sdkerrors.Wrapf(types.ErrInvalidConnection, "multi-hop proofs require at least 2 connection hops, got %d", len(connectionHops))
+	}
+
+	var multihopProofs commitmenttypes.MultihopProofs
+	if err := k.cdc.Unmarshal(proof, &multihopProofs); err != nil {
+		return commitmenttypes.MultihopProofs{}, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "failed to unmarshal proof into multi-hop proofs")
+	}
+
+	if err := multihopProofs.ValidateBasic(); err != nil {
+		return commitmenttypes.MultihopProofs{}, err
+	}
+
+	if len(multihopProofs.ConnectionProofs) != len(connectionHops)-1 {
+		return commitmenttypes.MultihopProofs{}, sdkerrors.Wrapf(
+			commitmenttypes.ErrInvalidProof, "expected %d connection proofs for %d connection hops, got %d",
+			len(connectionHops)-1, len(connectionHops), len(multihopProofs.ConnectionProofs),
+		)
+	}
+
+	return multihopProofs, nil
+}
+
+// parseConsensusProofHeight returns the consensus height contained in the last
+// element of the prefixed key of a consensus state proof.
+func parseConsensusProofHeight(consensusProof commitmenttypes.MultihopProof) (exported.Height, error) {
+	if consensusProof.PrefixedKey == nil || len(consensusProof.PrefixedKey.KeyPath) == 0 {
+		return nil, sdkerrors.Wrap(commitmenttypes.ErrInvalidProof, "consensus state proof key cannot be empty")
+	}
+
+	keyPath := consensusProof.PrefixedKey.KeyPath[len(consensusProof.PrefixedKey.KeyPath)-1]
+	height, err := clienttypes.ParseHeight(keyPath[strings.LastIndex(keyPath, "/")+1:])
+	if err != nil {
+		return nil, sdkerrors.Wrapf(commitmenttypes.ErrInvalidProof, "failed to parse consensus height from key %s: %s", keyPath, err)
+	}
+
+	return height, nil
+}
+
 // getBlockDelay calculates the block delay period from the time delay of the connection
 // and the maximum expected time per block.
 func (k Keeper) getBlockDelay(ctx sdk.Context, connection exported.ConnectionI) uint64 {
@@ -7,6 +7,7 @@ import (
 	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctmtypes "github.com/cosmos/ibc-go/v3/modules/light-clients/07-tendermint/types"
@@ -582,6 +583,114 @@ func (suite *KeeperTestSuite) TestVerifyPacketReceiptAbsence() {
 	}
 }
 
+// TestVerifyPacketReceipt has chainA verify the packet receipt on channelB.
+// The channels on chainA and chainB are fully opened and a packet is sent from
+// chainA to chainB and received.
+func (suite *KeeperTestSuite) TestVerifyPacketReceipt() {
+	var (
+		path            *ibctesting.Path
+		receipt         []byte
+		heightDiff      uint64
+		delayTimePeriod uint64
+		timePerBlock    uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: delay period passed", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+		}, true},
+		{"delay time period has not passed", func() {
+			delayTimePeriod = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"delay block period has not passed", func() {
+			// make timePerBlock 1 nanosecond so that block delay is not passed.
+			// must also set a non-zero time delay to ensure block delay is enforced.
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			timePerBlock = 1
+		}, false},
+		{"client state not found - changed client ID", func() {
+			connection := path.EndpointA.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointA.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - changed receipt", func() {
+			receipt = channeltypes.TimeoutReceipt
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointA.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// send and receive packet
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			// increment receiving chain's (chainB) time by 2 hour to always pass receive
+			suite.coordinator.IncrementTimeBy(time.Hour * 2)
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			// reset variables
+			receipt = []byte{byte(1)}
+			heightDiff = 0
+			delayTimePeriod = 0
+			timePerBlock = 0
+			tc.malleate()
+
+			connection := path.EndpointA.GetConnection()
+			connection.DelayPeriod = delayTimePeriod
+
+			clientState := path.EndpointA.GetClientState().(*ibctmtypes.ClientState)
+			if clientState.FrozenHeight.IsZero() {
+				// need to update height to prove receipt
+				suite.coordinator.CommitBlock(suite.chainA, suite.chainB)
+				path.EndpointA.UpdateClient()
+			}
+
+			packetReceiptKey := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			proof, proofHeight := suite.chainB.QueryProof(packetReceiptKey)
+
+			// set time per block param
+			if timePerBlock != 0 {
+				suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(timePerBlock))
+			}
+
+			err = suite.chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyPacketReceipt(
+				suite.chainA.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 // TestVerifyNextSequenceRecv has chainA verify the next sequence receive on
 // channelB. The channels on chainA and chainB are fully opened and a packet
 // is sent from chainA to chainB and received.
@@ -681,6 +790,249 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVerifyNextSequenceAck() {
+	var (
+		path            *ibctesting.Path
+		heightDiff      uint64
+		delayTimePeriod uint64
+		timePerBlock    uint64
+		offsetSeq       uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"verification success: delay period passed", func() {
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+		}, true},
+		{"delay time period has not passed", func() {
+			delayTimePeriod = uint64(1 * time.Hour.Nanoseconds())
+		}, false},
+		{"delay block period has not passed", func() {
+			// make timePerBlock 1 nanosecond so that block delay is not passed.
+			// must also set a non-zero time delay to ensure block delay is enforced.
+			delayTimePeriod = uint64(1 * time.Second.Nanoseconds())
+			timePerBlock = 1
+		}, false},
+		{"client state not found- changed client ID", func() {
+			connection := path.EndpointB.GetConnection()
+			connection.ClientId = ibctesting.InvalidID
+			path.EndpointB.SetConnection(connection)
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - wrong expected next seq ack", func() {
+			offsetSeq = 1
+		}, false},
+		{"client status is not active - client is expired", func() {
+			clientState := path.EndpointB.GetClientState().(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointB.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			// send and receive packet
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+			err := path.EndpointA.SendPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+
+			// next seq ack incremented
+			err = path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement())
+			suite.Require().NoError(err)
+
+			// increment receiving chain's (chainB) time by 2 hour to always pass receive
+			suite.coordinator.IncrementTimeBy(time.Hour * 2)
+			err = path.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			nextSeqAckKey := host.NextSequenceAckKey(packet.GetSourcePort(), packet.GetSourceChannel())
+			proof, proofHeight := suite.chainA.QueryProof(nextSeqAckKey)
+
+			// reset variables
+			heightDiff = 0
+			delayTimePeriod = 0
+			timePerBlock = 0
+			offsetSeq = 0
+			tc.malleate()
+
+			// set time per block param
+			if timePerBlock != 0 {
+				suite.chainB.App.GetIBCKeeper().ConnectionKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(timePerBlock))
+			}
+
+			connection := path.EndpointB.GetConnection()
+			connection.DelayPeriod = delayTimePeriod
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyNextSequenceAck(
+				suite.chainB.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1+offsetSeq,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// setupMultihopPath creates a multi-hop path over three chains with an OPEN
+// channel and returns the path together with the proof of the channel end on
+// the first chain queried by the last chain.
+func (suite *KeeperTestSuite) setupMultihopPath() (*ibctesting.MultihopPath, []byte, clienttypes.Height) {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	chainA := suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	chainC := suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+	suite.coordinator.SetupMultihop(path)
+
+	proof, proofHeight := path.EndpointB.QueryMultihopProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	return path, proof, proofHeight
+}
+
+// TestVerifyMultihopMembership verifies a channel end of the first chain of a
+// multi-hop path on the last chain of the path.
+func (suite *KeeperTestSuite) TestVerifyMultihopMembership() {
+	var (
+		path        *ibctesting.MultihopPath
+		proof       []byte
+		proofHeight clienttypes.Height
+		connection  types.ConnectionEnd
+		value       []byte
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"client status is not active - client is frozen", func() {
+			clientState := path.Paths[1].EndpointB.GetClientState().(*ibctmtypes.ClientState)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.Paths[1].EndpointB.SetClientState(clientState)
+		}, false},
+		{"delay period on the first connection hop", func() {
+			connection.DelayPeriod = uint64(time.Hour.Nanoseconds())
+		}, false},
+		{"consensus state for proof height not found", func() {
+			proofHeight = clienttypes.NewHeight(proofHeight.RevisionNumber, proofHeight.RevisionHeight+5)
+		}, false},
+		{"value does not match", func() {
+			value = []byte("invalid channel")
+		}, false},
+		{"proof is not a multi-hop proof", func() {
+			proof, _ = path.Paths[1].EndpointA.Chain.QueryProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+		}, false},
+		{"intermediate connection is not OPEN", func() {
+			intermediate := path.Paths[0].EndpointB.GetConnection()
+			intermediate.State = types.TRYOPEN
+			path.Paths[0].EndpointB.SetConnection(intermediate)
+
+			proof, proofHeight = path.EndpointB.QueryMultihopProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+		}, false},
+		{"intermediate connection has a delay period", func() {
+			intermediate := path.Paths[0].EndpointB.GetConnection()
+			intermediate.DelayPeriod = uint64(time.Hour.Nanoseconds())
+			path.Paths[0].EndpointB.SetConnection(intermediate)
+
+			proof, proofHeight = path.EndpointB.QueryMultihopProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path, proof, proofHeight = suite.setupMultihopPath()
+			connection = path.Paths[1].EndpointB.GetConnection()
+
+			channel := path.EndpointA.GetChannel()
+			bz, err := path.EndpointA.Chain.Codec.Marshal(&channel)
+			suite.Require().NoError(err)
+			value = bz
+
+			tc.malleate()
+
+			merklePath := commitmenttypes.NewMerklePath(host.ChannelPath(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			err = path.EndpointB.Chain.App.GetIBCKeeper().ConnectionKeeper.VerifyMultihopMembership(
+				path.EndpointB.Chain.GetContext(), connection, proofHeight, proof, path.EndpointB.ConnectionHops(), merklePath, value,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestVerifyMultihopNonMembership verifies the absence of a packet receipt on the
+// first chain of a multi-hop path on the last chain of the path.
+func (suite *KeeperTestSuite) TestVerifyMultihopNonMembership() {
+	cases := []struct {
+		name    string
+		recv    bool
+		expPass bool
+	}{
+		{"verification success", false, true},
+		{"packet receipt is stored", true, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path, _, _ := suite.setupMultihopPath()
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, defaultTimeoutHeight, 0)
+			if tc.recv {
+				err := path.EndpointB.SendPacket(packet)
+				suite.Require().NoError(err)
+				err = path.EndpointA.RecvPacket(packet)
+				suite.Require().NoError(err)
+			}
+
+			proof, proofHeight := path.EndpointB.QueryMultihopProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			connection := path.Paths[1].EndpointB.GetConnection()
+
+			merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptPath(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			err := path.EndpointB.Chain.App.GetIBCKeeper().ConnectionKeeper.VerifyMultihopNonMembership(
+				path.EndpointB.Chain.GetContext(), connection, proofHeight, proof, path.EndpointB.ConnectionHops(), merklePath,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func malleateHeight(height exported.Height, diff uint64) exported.Height {
 	return clienttypes.NewHeight(height.GetRevisionNumber(), height.GetRevisionHeight()+diff)
 }
@@ -125,9 +125,11 @@ func TestVerifyProposedVersion(t *testing.T) {
 		supportedVersion *types.Version
 		expPass          bool
 	}{
-		{"entire feature set supported", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_DAG"}), true},
+		{"entire feature set supported", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT", "ORDER_DAG"}), true},
 		{"empty feature sets not supported", types.NewVersion("1", []string{}), types.DefaultIBCVersion, false},
-		{"one feature missing", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_UNORDERED", "ORDER_DAG"}), false},
+		{"one feature missing", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT", "ORDER_DAG"}), false},
+		{"ordered allow timeout feature missing", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_ORDERED", "ORDER_UNORDERED"}), false},
+		{"ordered allow timeout feature not proposed", types.NewVersion("1", []string{"ORDER_ORDERED", "ORDER_UNORDERED"}), types.DefaultIBCVersion, true},
 		{"both features missing", types.DefaultIBCVersion, types.NewVersion("1", []string{"ORDER_DAG"}), false},
 		{"identifiers do not match", types.NewVersion("2", []string{"ORDER_UNORDERED", "ORDER_ORDERED"}), types.DefaultIBCVersion, false},
 	}
@@ -155,6 +157,8 @@ func TestVerifySupportedFeature(t *testing.T) {
 	}{
 		{"check ORDERED supported", ibctesting.ConnectionVersion, "ORDER_ORDERED", true},
 		{"check UNORDERED supported", ibctesting.ConnectionVersion, "ORDER_UNORDERED", true},
+		{"check ORDERED_ALLOW_TIMEOUT supported", ibctesting.ConnectionVersion, "ORDER_ORDERED_ALLOW_TIMEOUT", true},
+		{"check ORDERED_ALLOW_TIMEOUT unsupported by legacy version", types.NewVersion(types.DefaultIBCVersionIdentifier, []string{"ORDER_ORDERED", "ORDER_UNORDERED"}), "ORDER_ORDERED_ALLOW_TIMEOUT", false},
 		{"check DAG unsupported", ibctesting.ConnectionVersion, "ORDER_DAG", false},
 		{"check empty feature set returns false", nilFeatures, "ORDER_ORDERED", false},
 	}
@@ -1,6 +1,8 @@
 package channel
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
@@ -21,6 +23,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 		k.SetPacketCommitment(ctx, commitment.PortId, commitment.ChannelId, commitment.Sequence, commitment.Data)
 	}
 	for _, receipt := range gs.Receipts {
+		if bytes.Equal(receipt.Data, types.TimeoutReceipt) {
+			k.SetPacketTimeoutReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
+			continue
+		}
 		k.SetPacketReceipt(ctx, receipt.PortId, receipt.ChannelId, receipt.Sequence)
 	}
 	for _, ss := range gs.SendSequences {
@@ -32,19 +38,31 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, upgrade := range gs.Upgrades {
+		k.SetUpgrade(ctx, upgrade.PortId, upgrade.ChannelId, upgrade.Upgrade)
+	}
+	for _, rs := range gs.RecvStartSequences {
+		k.SetRecvStartSequence(ctx, rs.PortId, rs.ChannelId, rs.Sequence)
+	}
+	for _, ps := range gs.PruningSequenceStarts {
+		k.SetPruningSequenceStart(ctx, ps.PortId, ps.ChannelId, ps.Sequence)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
 // ExportGenesis returns the ibc channel submodule's exported genesis.
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) types.GenesisState {
 	return types.GenesisState{
-		Channels:            k.GetAllChannels(ctx),
-		Acknowledgements:    k.GetAllPacketAcks(ctx),
-		Commitments:         k.GetAllPacketCommitments(ctx),
-		Receipts:            k.GetAllPacketReceipts(ctx),
-		SendSequences:       k.GetAllPacketSendSeqs(ctx),
-		RecvSequences:       k.GetAllPacketRecvSeqs(ctx),
-		AckSequences:        k.GetAllPacketAckSeqs(ctx),
-		NextChannelSequence: k.GetNextChannelSequence(ctx),
+		Channels:              k.GetAllChannels(ctx),
+		Acknowledgements:      k.GetAllPacketAcks(ctx),
+		Commitments:           k.GetAllPacketCommitments(ctx),
+		Receipts:              k.GetAllPacketReceipts(ctx),
+		SendSequences:         k.GetAllPacketSendSeqs(ctx),
+		RecvSequences:         k.GetAllPacketRecvSeqs(ctx),
+		AckSequences:          k.GetAllPacketAckSeqs(ctx),
+		NextChannelSequence:   k.GetNextChannelSequence(ctx),
+		Upgrades:              k.GetAllUpgrades(ctx),
+		RecvStartSequences:    k.GetAllRecvStartSeqs(ctx),
+		PruningSequenceStarts: k.GetAllPruningSequenceStarts(ctx),
 	}
 }
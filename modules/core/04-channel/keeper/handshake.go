@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -113,11 +114,6 @@ func (k Keeper) ChanOpenTry(
 
 	channelID := previousChannelID
 
-	// connection hops only supports a single connection
-	if len(connectionHops) != 1 {
-		return "", nil, sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
-	}
-
 	// empty channel identifier indicates continuing a previous channel handshake
 	if previousChannelID != "" {
 		// channel identifier and connection hop length checked on msg.ValidateBasic()
@@ -130,7 +126,7 @@ func (k Keeper) ChanOpenTry(
 		if !(previousChannel.Ordering == order &&
 			previousChannel.Counterparty.PortId == counterparty.PortId &&
 			previousChannel.Counterparty.ChannelId == "" &&
-			previousChannel.ConnectionHops[0] == connectionHops[0] && // ChanOpenInit will only set a single connection hop
+			strings.Join(previousChannel.ConnectionHops, "/") == strings.Join(connectionHops, "/") &&
 			previousChannel.Version == counterpartyVersion) {
 			return "", nil, sdkerrors.Wrap(types.ErrInvalidChannel, "channel fields mismatch previous channel fields")
 		}
@@ -177,7 +173,10 @@ func (k Keeper) ChanOpenTry(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(ctx, connectionEnd, connectionHops, proofInit)
+	if err != nil {
+		return "", nil, err
+	}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
 	// (i.e self)
@@ -187,18 +186,14 @@ func (k Keeper) ChanOpenTry(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofInit,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, connectionHops, proofHeight, proofInit,
 		counterparty.PortId, counterparty.ChannelId, expectedChannel,
 	); err != nil {
 		return "", nil, err
 	}
 
-	var (
-		capKey *capabilitytypes.Capability
-		err    error
-	)
-
+	var capKey *capabilitytypes.Capability
 	if !previousChannelFound {
 		capKey, err = k.scopedKeeper.NewCapability(ctx, host.ChannelCapabilityPath(portID, channelID))
 		if err != nil {
@@ -290,7 +285,10 @@ func (k Keeper) ChanOpenAck(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(ctx, connectionEnd, channel.ConnectionHops, proofTry)
+	if err != nil {
+		return err
+	}
 
 	// counterparty of the counterparty channel end (i.e self)
 	expectedCounterparty := types.NewCounterparty(portID, channelID)
@@ -299,8 +297,8 @@ func (k Keeper) ChanOpenAck(
 		counterpartyHops, counterpartyVersion,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofTry,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofTry,
 		channel.Counterparty.PortId, counterpartyChannelID,
 		expectedChannel,
 	); err != nil {
@@ -376,7 +374,10 @@ func (k Keeper) ChanOpenConfirm(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(ctx, connectionEnd, channel.ConnectionHops, proofAck)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
@@ -384,8 +385,8 @@ func (k Keeper) ChanOpenConfirm(
 		counterpartyHops, channel.Version,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofAck,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofAck,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
@@ -444,6 +445,11 @@ func (k Keeper) ChanCloseInit(
 		return sdkerrors.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
 	}
 
+	// an upgrade in progress must complete or be aborted before the channel can be closed
+	if channel.State == types.INITUPGRADE || channel.State == types.TRYUPGRADE {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel is being upgraded (got %s)", channel.State.String())
+	}
+
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
@@ -493,6 +499,11 @@ func (k Keeper) ChanCloseConfirm(
 		return sdkerrors.Wrap(types.ErrInvalidChannelState, "channel is already CLOSED")
 	}
 
+	// an upgrade in progress must complete or be aborted before the channel can be closed
+	if channel.State == types.INITUPGRADE || channel.State == types.TRYUPGRADE {
+		return sdkerrors.Wrapf(types.ErrInvalidChannelState, "channel is being upgraded (got %s)", channel.State.String())
+	}
+
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
@@ -505,7 +516,10 @@ func (k Keeper) ChanCloseConfirm(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(ctx, connectionEnd, channel.ConnectionHops, proofInit)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
@@ -513,8 +527,8 @@ func (k Keeper) ChanCloseConfirm(
 		counterpartyHops, channel.Version,
 	)
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofInit,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofInit,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
@@ -33,7 +33,7 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 	testCases := []testCase{
 		{"success", func() {
 			suite.coordinator.SetupConnections(path)
-			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT"}
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, true},
@@ -47,7 +47,7 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 		}, false},
 		{"capability is incorrect", func() {
 			suite.coordinator.SetupConnections(path)
-			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT"}
 			portCap = capabilitytypes.NewCapability(3)
 		}, false},
 		{"connection version not negotiated", func() {
@@ -63,7 +63,7 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 				suite.chainA.GetContext(),
 				path.EndpointA.ConnectionID, conn,
 			)
-			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED", "ORDER_ORDERED_ALLOW_TIMEOUT"}
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, false},
@@ -85,13 +85,31 @@ func (suite *KeeperTestSuite) TestChanOpenInit() {
 			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
 			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
 		}, true},
+		{"connection does not support ORDERED_ALLOW_TIMEOUT channels", func() {
+			suite.coordinator.SetupConnections(path)
+
+			// modify connA versions to the feature set of connections which predate ORDERED_ALLOW_TIMEOUT channels
+			conn := path.EndpointA.GetConnection()
+
+			version := connectiontypes.NewVersion("1", []string{"ORDER_ORDERED", "ORDER_UNORDERED"})
+			conn.Versions = []*connectiontypes.Version{version}
+
+			suite.chainA.App.GetIBCKeeper().ConnectionKeeper.SetConnection(
+				suite.chainA.GetContext(),
+				path.EndpointA.ConnectionID, conn,
+			)
+			// NOTE: Opening ORDERED and UNORDERED channels is still expected to pass but ORDERED_ALLOW_TIMEOUT channels should fail
+			features = []string{"ORDER_ORDERED", "ORDER_UNORDERED"}
+			suite.chainA.CreatePortCapability(suite.chainA.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap = suite.chainA.GetPortCapability(ibctesting.MockPort)
+		}, true},
 	}
 
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			// run test for all types of ordering
-			for _, order := range []types.Order{types.UNORDERED, types.ORDERED} {
+			for _, order := range []types.Order{types.UNORDERED, types.ORDERED, types.ORDERED_ALLOW_TIMEOUT} {
 				suite.SetupTest() // reset
 				path = ibctesting.NewPath(suite.chainA, suite.chainB)
 				path.EndpointA.ChannelConfig.Order = order
@@ -86,6 +86,33 @@ func (k Keeper) SetChannel(ctx sdk.Context, portID, channelID string, channel ty
 	store.Set(host.ChannelKey(portID, channelID), bz)
 }
 
+// GetUpgrade returns the upgrade state of a channel which is in the INITUPGRADE
+// or TRYUPGRADE state.
+func (k Keeper) GetUpgrade(ctx sdk.Context, portID, channelID string) (types.Upgrade, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.ChannelUpgradeKey(portID, channelID))
+	if bz == nil {
+		return types.Upgrade{}, false
+	}
+
+	var upgrade types.Upgrade
+	k.cdc.MustUnmarshal(bz, &upgrade)
+	return upgrade, true
+}
+
+// SetUpgrade sets the upgrade state of a channel to the store
+func (k Keeper) SetUpgrade(ctx sdk.Context, portID, channelID string, upgrade types.Upgrade) {
+	store := ctx.KVStore(k.storeKey)
+	bz := k.cdc.MustMarshal(&upgrade)
+	store.Set(host.ChannelUpgradeKey(portID, channelID), bz)
+}
+
+// deleteUpgrade removes the upgrade state of a channel from the store
+func (k Keeper) deleteUpgrade(ctx sdk.Context, portID, channelID string) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.ChannelUpgradeKey(portID, channelID))
+}
+
 // GetNextChannelSequence gets the next channel sequence from the store.
 func (k Keeper) GetNextChannelSequence(ctx sdk.Context) uint64 {
 	store := ctx.KVStore(k.storeKey)
@@ -158,6 +185,45 @@ func (k Keeper) SetNextSequenceAck(ctx sdk.Context, portID, channelID string, se
 	store.Set(host.NextSequenceAckKey(portID, channelID), bz)
 }
 
+// GetRecvStartSequence gets a channel's receive start sequence from the store.
+// Packets with a lower sequence can no longer be received on the channel.
+func (k Keeper) GetRecvStartSequence(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.RecvStartSequenceKey(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetRecvStartSequence sets a channel's receive start sequence to the store
+func (k Keeper) SetRecvStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.RecvStartSequenceKey(portID, channelID), bz)
+}
+
+// GetPruningSequenceStart gets the next sequence of a channel whose packet
+// receipt and acknowledgement will be pruned from the store
+func (k Keeper) GetPruningSequenceStart(ctx sdk.Context, portID, channelID string) (uint64, bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(host.PruningSequenceStartKey(portID, channelID))
+	if bz == nil {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
+// SetPruningSequenceStart sets the next sequence of a channel whose packet
+// receipt and acknowledgement will be pruned to the store
+func (k Keeper) SetPruningSequenceStart(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	store.Set(host.PruningSequenceStartKey(portID, channelID), bz)
+}
+
 // GetPacketReceipt gets a packet receipt from the store
 func (k Keeper) GetPacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) (string, bool) {
 	store := ctx.KVStore(k.storeKey)
@@ -175,6 +241,18 @@ func (k Keeper) SetPacketReceipt(ctx sdk.Context, portID, channelID string, sequ
 	store.Set(host.PacketReceiptKey(portID, channelID, sequence), []byte{byte(1)})
 }
 
+// SetPacketTimeoutReceipt sets the timeout receipt of a packet which timed out
+// on an ORDERED_ALLOW_TIMEOUT channel to the store
+func (k Keeper) SetPacketTimeoutReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt)
+}
+
+func (k Keeper) deletePacketReceipt(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketReceiptKey(portID, channelID, sequence))
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k Keeper) GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte {
 	store := ctx.KVStore(k.storeKey)
@@ -221,6 +299,11 @@ func (k Keeper) HasPacketAcknowledgement(ctx sdk.Context, portID, channelID stri
 	return store.Has(host.PacketAcknowledgementKey(portID, channelID, sequence))
 }
 
+func (k Keeper) deletePacketAcknowledgement(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(host.PacketAcknowledgementKey(portID, channelID, sequence))
+}
+
 // IteratePacketSequence provides an iterator over all send, receive or ack sequences.
 // For each sequence, cb will be called. If the cb returns true, the iterator
 // will close and stop.
@@ -277,6 +360,30 @@ func (k Keeper) GetAllPacketAckSeqs(ctx sdk.Context) (seqs []types.PacketSequenc
 	return seqs
 }
 
+// GetAllRecvStartSeqs returns all stored receive start sequences.
+func (k Keeper) GetAllRecvStartSeqs(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyRecvStartSeqPrefix))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, recvStartSeq uint64) bool {
+		ps := types.NewPacketSequence(portID, channelID, recvStartSeq)
+		seqs = append(seqs, ps)
+		return false
+	})
+	return seqs
+}
+
+// GetAllPruningSequenceStarts returns all stored pruning start sequences.
+func (k Keeper) GetAllPruningSequenceStarts(ctx sdk.Context) (seqs []types.PacketSequence) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyPruningSeqStartPrefix))
+	k.IteratePacketSequence(ctx, iterator, func(portID, channelID string, pruningSeqStart uint64) bool {
+		ps := types.NewPacketSequence(portID, channelID, pruningSeqStart)
+		seqs = append(seqs, ps)
+		return false
+	})
+	return seqs
+}
+
 // IteratePacketCommitment provides an iterator over all PacketCommitment objects. For each
 // packet commitment, cb will be called. If the cb returns true, the iterator will close
 // and stop.
@@ -383,6 +490,35 @@ func (k Keeper) GetAllChannels(ctx sdk.Context) (channels []types.IdentifiedChan
 	return channels
 }
 
+// IterateUpgrades provides an iterator over the upgrade state of all channels
+// with an upgrade in progress. For each upgrade, cb will be called. If the cb
+// returns true, the iterator will close and stop.
+func (k Keeper) IterateUpgrades(ctx sdk.Context, cb func(types.IdentifiedUpgrade) bool) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.KeyChannelUpgradePrefix))
+
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		var upgrade types.Upgrade
+		k.cdc.MustUnmarshal(iterator.Value(), &upgrade)
+
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		if cb(types.NewIdentifiedUpgrade(portID, channelID, upgrade)) {
+			break
+		}
+	}
+}
+
+// GetAllUpgrades returns the upgrade state of all channels with an upgrade in
+// progress.
+func (k Keeper) GetAllUpgrades(ctx sdk.Context) (upgrades []types.IdentifiedUpgrade) {
+	k.IterateUpgrades(ctx, func(upgrade types.IdentifiedUpgrade) bool {
+		upgrades = append(upgrades, upgrade)
+		return false
+	})
+	return upgrades
+}
+
 // GetChannelClientState returns the associated client state with its ID, from a port and channel identifier.
 func (k Keeper) GetChannelClientState(ctx sdk.Context, portID, channelID string) (string, exported.ClientState, error) {
 	channel, found := k.GetChannel(ctx, portID, channelID)
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/stretchr/testify/suite"
@@ -199,7 +200,8 @@ func (suite KeeperTestSuite) TestGetAllPacketState() {
 
 	// channel 1 receipts
 	rec3 := types.NewPacketState(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 1, []byte(receipt))
-	rec4 := types.NewPacketState(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 2, []byte(receipt))
+	// timeout receipt
+	rec4 := types.NewPacketState(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, 2, types.TimeoutReceipt)
 
 	// channel 0 packet commitments
 	comm1 := types.NewPacketState(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, []byte("hash"))
@@ -222,6 +224,10 @@ func (suite KeeperTestSuite) TestGetAllPacketState() {
 
 	// set packet receipts
 	for _, rec := range expReceipts {
+		if bytes.Equal(rec.Data, types.TimeoutReceipt) {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketTimeoutReceipt(ctxA, rec.PortId, rec.ChannelId, rec.Sequence)
+			continue
+		}
 		suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetPacketReceipt(ctxA, rec.PortId, rec.ChannelId, rec.Sequence)
 	}
 
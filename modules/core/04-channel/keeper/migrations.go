@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate2to3 migrates from version 2 to 3.
+// This migration sets the next sequence to be acknowledged of every UNORDERED
+// channel to the lowest sequence of its stored packet commitments, or to the
+// next sequence to be sent if no packet commitments are stored, so that all
+// packets with a lower sequence have been acknowledged or timed out.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	// the packet commitments are not ordered by sequence in the store
+	lowestCommitments := make(map[string]uint64)
+	m.keeper.IteratePacketCommitment(ctx, func(portID, channelID string, sequence uint64, _ []byte) bool {
+		key := host.ChannelPath(portID, channelID)
+		if lowest, found := lowestCommitments[key]; !found || sequence < lowest {
+			lowestCommitments[key] = sequence
+		}
+		return false
+	})
+
+	for _, channel := range m.keeper.GetAllChannels(ctx) {
+		if channel.Ordering != types.UNORDERED {
+			continue
+		}
+
+		nextSequenceAck, found := lowestCommitments[host.ChannelPath(channel.PortId, channel.ChannelId)]
+		if !found {
+			nextSequenceAck, found = m.keeper.GetNextSequenceSend(ctx, channel.PortId, channel.ChannelId)
+			if !found {
+				continue
+			}
+		}
+
+		m.keeper.SetNextSequenceAck(ctx, channel.PortId, channel.ChannelId, nextSequenceAck)
+	}
+
+	return nil
+}
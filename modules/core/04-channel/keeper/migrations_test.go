@@ -0,0 +1,63 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/keeper"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+func (suite *KeeperTestSuite) TestMigrate2to3() {
+	// UNORDERED channel with a pending packet between acknowledged packets
+	path1 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path1)
+
+	packets := make([]types.Packet, 3)
+	for i := range packets {
+		packets[i] = types.NewPacket(ibctesting.MockPacketData, uint64(i+1), path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, path1.EndpointB.ChannelConfig.PortID, path1.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+		suite.Require().NoError(path1.EndpointA.SendPacket(packets[i]))
+	}
+	for _, packet := range []types.Packet{packets[0], packets[2]} {
+		suite.Require().NoError(path1.EndpointB.UpdateClient())
+		suite.Require().NoError(path1.EndpointB.RecvPacket(packet))
+		suite.Require().NoError(path1.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+	}
+
+	// UNORDERED channel without pending packets
+	path2 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path2)
+	suite.relayPackets(path2, 2)
+
+	// ORDERED channel with a pending packet
+	path3 := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path3.SetChannelOrdered()
+	suite.coordinator.Setup(path3)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path3.EndpointA.ChannelConfig.PortID, path3.EndpointA.ChannelID, path3.EndpointB.ChannelConfig.PortID, path3.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path3.EndpointA.SendPacket(packet))
+
+	// the next sequence to be acknowledged of UNORDERED channels was not advanced before the migration
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	for _, path := range []*ibctesting.Path{path1, path2, path3} {
+		channelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+	}
+
+	migrator := keeper.NewMigrator(channelKeeper)
+	suite.Require().NoError(migrator.Migrate2to3(suite.chainA.GetContext()))
+
+	testCases := []struct {
+		msg                string
+		path               *ibctesting.Path
+		expNextSequenceAck uint64
+	}{
+		{"lowest pending packet", path1, 2},
+		{"next sequence send", path2, 3},
+		{"ORDERED channel is not migrated", path3, 1},
+	}
+
+	for _, tc := range testCases {
+		nextSequenceAck, found := channelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), tc.path.EndpointA.ChannelConfig.PortID, tc.path.EndpointA.ChannelID)
+		suite.Require().True(found, tc.msg)
+		suite.Require().Equal(tc.expNextSequenceAck, nextSequenceAck, tc.msg)
+	}
+}
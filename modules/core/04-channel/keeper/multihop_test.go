@@ -0,0 +1,320 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+// setupMultihopPath creates a coordinator with the given number of chains and
+// returns a multi-hop path over all chains with OPEN connections between every
+// consecutive pair of chains.
+func (suite *KeeperTestSuite) setupMultihopPath(numChains int) *ibctesting.MultihopPath {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), numChains)
+
+	chains := make([]*ibctesting.TestChain, numChains)
+	for i := range chains {
+		chains[i] = suite.coordinator.GetChain(ibctesting.GetChainID(i + 1))
+		// commit some blocks so that QueryProof returns valid proof (cannot return valid query if height <= 1)
+		suite.coordinator.CommitNBlocks(chains[i], 2)
+	}
+
+	path := ibctesting.NewMultihopPath(chains...)
+	for _, hopPath := range path.Paths {
+		suite.coordinator.SetupConnections(hopPath)
+	}
+
+	return path
+}
+
+// TestMultihopChannelHandshake opens and closes channels over multiple connection
+// hops and checks that each channel end stores the connection hops leading to
+// the counterparty chain.
+func (suite *KeeperTestSuite) TestMultihopChannelHandshake() {
+	for _, numChains := range []int{3, 4} {
+		suite.Run(fmt.Sprintf("%d chains", numChains), func() {
+			path := suite.setupMultihopPath(numChains)
+			suite.coordinator.CreateMultihopChannels(path)
+
+			channelA := path.EndpointA.GetChannel()
+			suite.Require().Equal(types.OPEN, channelA.State)
+			suite.Require().Equal(path.EndpointA.ConnectionHops(), channelA.ConnectionHops)
+			suite.Require().Equal(types.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), channelA.Counterparty)
+
+			channelB := path.EndpointB.GetChannel()
+			suite.Require().Equal(types.OPEN, channelB.State)
+			suite.Require().Equal(path.EndpointB.ConnectionHops(), channelB.ConnectionHops)
+			suite.Require().Equal(types.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), channelB.Counterparty)
+			suite.Require().Len(channelB.ConnectionHops, numChains-1)
+
+			suite.Require().NoError(path.EndpointA.ChanCloseInit())
+			suite.Require().NoError(path.EndpointB.ChanCloseConfirm())
+
+			suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+			suite.Require().Equal(types.CLOSED, path.EndpointB.GetChannel().State)
+		})
+	}
+}
+
+// TestMultihopChanOpenTry tests the verification of multi-hop proofs in the
+// OpenTry handshake step of a channel over two connection hops.
+func (suite *KeeperTestSuite) TestMultihopChanOpenTry() {
+	var (
+		path           *ibctesting.MultihopPath
+		connectionHops []string
+		proof          []byte
+		proofHeight    clienttypes.Height
+	)
+
+	// replaceProofs unmarshals the multi-hop proofs, applies the given function to
+	// them and marshals the result into the proof
+	replaceProofs := func(fn func(*commitmenttypes.MultihopProofs)) {
+		var multihopProofs commitmenttypes.MultihopProofs
+		suite.Require().NoError(path.EndpointB.Chain.Codec.Unmarshal(proof, &multihopProofs))
+
+		fn(&multihopProofs)
+
+		bz, err := path.EndpointB.Chain.Codec.Marshal(&multihopProofs)
+		suite.Require().NoError(err)
+		proof = bz
+	}
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"connection hop count does not match the proof", func() {
+			connectionHops = connectionHops[:1]
+		}, false},
+		{"connection hop does not match the connection proof", func() {
+			connectionHops[1] = ibctesting.InvalidID
+		}, false},
+		{"proof is not a multi-hop proof", func() {
+			proof, _ = path.Paths[1].EndpointB.Chain.QueryProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+		}, false},
+		{"missing connection proof", func() {
+			replaceProofs(func(multihopProofs *commitmenttypes.MultihopProofs) {
+				multihopProofs.ConnectionProofs = nil
+				multihopProofs.ConsensusProofs = nil
+			})
+		}, false},
+		{"consensus proof of a different height", func() {
+			replaceProofs(func(multihopProofs *commitmenttypes.MultihopProofs) {
+				height := clienttypes.NewHeight(0, 1)
+				prefixedKey, err := commitmenttypes.ApplyPrefix(path.Paths[0].EndpointB.Chain.GetPrefix(), commitmenttypes.NewMerklePath(host.FullConsensusStatePath(path.Paths[1].EndpointA.ClientID, height)))
+				suite.Require().NoError(err)
+				multihopProofs.ConsensusProofs[0].PrefixedKey = &prefixedKey
+			})
+		}, false},
+		{"key proof of a different value", func() {
+			replaceProofs(func(multihopProofs *commitmenttypes.MultihopProofs) {
+				multihopProofs.KeyProof.Value = []byte("invalid channel")
+			})
+		}, false},
+		{"consensus state not found", func() {
+			proofHeight = clienttypes.NewHeight(proofHeight.RevisionNumber, proofHeight.RevisionHeight+100)
+		}, false},
+		{"intermediate connection is not OPEN", func() {
+			connection := path.Paths[0].EndpointB.GetConnection()
+			connection.State = connectiontypes.TRYOPEN
+			path.Paths[0].EndpointB.SetConnection(connection)
+
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proof, proofHeight = path.EndpointB.QueryMultihopProof(channelKey)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			path = suite.setupMultihopPath(3)
+			suite.Require().NoError(path.EndpointA.ChanOpenInit())
+
+			connectionHops = path.EndpointB.ConnectionHops()
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proof, proofHeight = path.EndpointB.QueryMultihopProof(channelKey)
+
+			tc.malleate()
+
+			path.EndpointB.Chain.CreatePortCapability(path.EndpointB.Chain.GetSimApp().ScopedIBCMockKeeper, ibctesting.MockPort)
+			portCap := path.EndpointB.Chain.GetPortCapability(ibctesting.MockPort)
+
+			counterparty := types.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			channelID, capability, err := path.EndpointB.Chain.App.GetIBCKeeper().ChannelKeeper.ChanOpenTry(
+				path.EndpointB.Chain.GetContext(), path.EndpointB.ChannelConfig.Order, connectionHops,
+				path.EndpointB.ChannelConfig.PortID, "", portCap, counterparty, path.EndpointA.ChannelConfig.Version,
+				proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(capability)
+				suite.Require().Equal(types.FormatChannelIdentifier(0), channelID)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestMultihopPacketFlow relays packets in both directions over ORDERED and
+// UNORDERED channels with multiple connection hops.
+func (suite *KeeperTestSuite) TestMultihopPacketFlow() {
+	for _, numChains := range []int{3, 4} {
+		for _, order := range []types.Order{types.ORDERED, types.UNORDERED} {
+			suite.Run(fmt.Sprintf("%d chains %s", numChains, order), func() {
+				path := suite.setupMultihopPath(numChains)
+				path.EndpointA.ChannelConfig.Order = order
+				path.EndpointB.ChannelConfig.Order = order
+				suite.coordinator.CreateMultihopChannels(path)
+
+				for sequence := uint64(1); sequence <= 2; sequence++ {
+					packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), disabledTimeoutTimestamp+uint64(suite.coordinator.CurrentTime.Add(ibctesting.TimeIncrement*100).UnixNano()))
+					suite.Require().NoError(path.EndpointA.SendPacket(packet))
+					suite.Require().NoError(path.RelayPacket(packet))
+
+					commitment := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(path.EndpointA.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), sequence)
+					suite.Require().Empty(commitment)
+
+					ack := path.EndpointB.Chain.GetAcknowledgement(packet)
+					suite.Require().Equal(types.CommitAcknowledgement(ibcmock.MockAcknowledgement.Acknowledgement()), ack)
+				}
+
+				// relay a packet in the opposite direction
+				packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, clienttypes.ZeroHeight(), uint64(suite.coordinator.CurrentTime.Add(ibctesting.TimeIncrement*100).UnixNano()))
+				suite.Require().NoError(path.EndpointB.SendPacket(packet))
+
+				res, err := path.EndpointA.RecvPacketWithResult(packet)
+				suite.Require().NoError(err)
+
+				ack, err := ibctesting.ParseAckFromEvents(res.GetEvents())
+				suite.Require().NoError(err)
+				suite.Require().NoError(path.EndpointB.AcknowledgePacket(packet, ack))
+			})
+		}
+	}
+}
+
+// TestMultihopTimeoutPacket tests timing out packets sent on channels over
+// multiple connection hops using the height of the counterparty chain.
+func (suite *KeeperTestSuite) TestMultihopTimeoutPacket() {
+	var (
+		path    *ibctesting.MultihopPath
+		packet  types.Packet
+		chanCap *capabilitytypes.Capability
+	)
+
+	testCases := []struct {
+		msg      string
+		order    types.Order
+		malleate func()
+		expPass  bool
+	}{
+		{"success: ORDERED", types.ORDERED, func() {
+			suite.coordinator.CommitNBlocks(path.EndpointB.Chain, 5)
+		}, true},
+		{"success: UNORDERED", types.UNORDERED, func() {
+			suite.coordinator.CommitNBlocks(path.EndpointB.Chain, 5)
+		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", types.ORDERED_ALLOW_TIMEOUT, func() {
+			suite.coordinator.CommitNBlocks(path.EndpointB.Chain, 5)
+			// the counterparty skips the packet and writes a timeout receipt
+			suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+		}, true},
+		{"timeout receipt not written: ORDERED_ALLOW_TIMEOUT", types.ORDERED_ALLOW_TIMEOUT, func() {
+			suite.coordinator.CommitNBlocks(path.EndpointB.Chain, 5)
+		}, false},
+		{"timeout height of the counterparty chain not reached", types.UNORDERED, func() {
+			// the chains along the path are at a higher height than the counterparty chain
+			for _, hopPath := range path.Paths[:len(path.Paths)-1] {
+				suite.coordinator.CommitNBlocks(hopPath.EndpointB.Chain, 10)
+			}
+		}, false},
+		{"packet already received", types.UNORDERED, func() {
+			suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+			suite.coordinator.CommitNBlocks(path.EndpointB.Chain, 5)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			path = suite.setupMultihopPath(3)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			suite.coordinator.CreateMultihopChannels(path)
+
+			timeoutHeight := clienttypes.GetSelfHeight(path.EndpointB.Chain.GetContext()).Increment().Increment().(clienttypes.Height)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+			tc.malleate()
+
+			key := host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+			if tc.order == types.ORDERED {
+				key = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+			}
+			proof, proofHeight := path.EndpointA.QueryMultihopProof(key)
+
+			err := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.TimeoutPacket(path.EndpointA.Chain.GetContext(), packet, proof, proofHeight, 1)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+
+			chanCap = path.EndpointA.Chain.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel())
+			suite.Require().NoError(path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.TimeoutExecuted(path.EndpointA.Chain.GetContext(), chanCap, packet))
+
+			if tc.order == types.ORDERED {
+				suite.Require().Equal(types.CLOSED, path.EndpointA.GetChannel().State)
+			} else {
+				suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+			}
+		})
+	}
+}
+
+// TestMultihopTimeoutOnClose tests timing out a packet sent on a channel over
+// multiple connection hops after the counterparty channel end was closed.
+func (suite *KeeperTestSuite) TestMultihopTimeoutOnClose() {
+	for _, order := range []types.Order{types.ORDERED, types.UNORDERED, types.ORDERED_ALLOW_TIMEOUT} {
+		suite.Run(order.String(), func() {
+			path := suite.setupMultihopPath(3)
+			path.EndpointA.ChannelConfig.Order = order
+			path.EndpointB.ChannelConfig.Order = order
+			suite.coordinator.CreateMultihopChannels(path)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), uint64(suite.coordinator.CurrentTime.Add(ibctesting.TimeIncrement*100).UnixNano()))
+			suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+			suite.Require().NoError(path.EndpointB.ChanCloseInit())
+			suite.Require().NoError(path.EndpointA.TimeoutOnClose(packet))
+
+			commitment := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(path.EndpointA.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+			suite.Require().Empty(commitment)
+		})
+	}
+}
+
+// TestMultihopChannelUpgrade checks that channels over multiple connection hops
+// cannot be upgraded.
+func (suite *KeeperTestSuite) TestMultihopChannelUpgrade() {
+	path := suite.setupMultihopPath(3)
+	suite.coordinator.CreateMultihopChannels(path)
+
+	channel := path.EndpointA.GetChannel()
+	chanCap := path.EndpointA.Chain.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+	err := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeInit(
+		path.EndpointA.Chain.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, chanCap,
+		types.UNORDERED, channel.ConnectionHops[:1], channel.Version,
+	)
+	suite.Require().ErrorIs(err, types.ErrTooManyConnectionHops)
+}
@@ -39,6 +39,14 @@ func (k Keeper) SendPacket(
 		)
 	}
 
+	// packets cannot be sent while the channel is being upgraded
+	if channel.State == types.INITUPGRADE || channel.State == types.TRYUPGRADE {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel is being upgraded (got %s)", channel.State.String(),
+		)
+	}
+
 	if !k.scopedKeeper.AuthenticateCapability(ctx, channelCap, host.ChannelCapabilityPath(packet.GetSourcePort(), packet.GetSourceChannel())) {
 		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
@@ -73,34 +81,38 @@ func (k Keeper) SendPacket(
 		return sdkerrors.Wrapf(clienttypes.ErrClientNotActive, "cannot send packet using client (%s) with status %s", connectionEnd.GetClientID(), status)
 	}
 
-	// check if packet is timed out on the receiving chain
-	latestHeight := clientState.GetLatestHeight()
+	// check if packet is timed out on the receiving chain. The client of a multi-hop
+	// channel tracks the chain of the first hop, the timeout of a packet sent on a
+	// multi-hop channel can therefore only be checked on the receiving chain.
 	timeoutHeight := packet.GetTimeoutHeight()
-	if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
-		return sdkerrors.Wrapf(
-			types.ErrPacketTimeout,
-			"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
-		)
-	}
-
-	clientType, _, err := clienttypes.ParseClientIdentifier(connectionEnd.GetClientID())
-	if err != nil {
-		return err
-	}
+	if len(channel.ConnectionHops) == 1 {
+		latestHeight := clientState.GetLatestHeight()
+		if !timeoutHeight.IsZero() && latestHeight.GTE(timeoutHeight) {
+			return sdkerrors.Wrapf(
+				types.ErrPacketTimeout,
+				"receiving chain block height >= packet timeout height (%s >= %s)", latestHeight, timeoutHeight,
+			)
+		}
 
-	// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
-	// A future change should move this function to be a ClientState callback.
-	if clientType != exported.Solomachine {
-		latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
+		clientType, _, err := clienttypes.ParseClientIdentifier(connectionEnd.GetClientID())
 		if err != nil {
 			return err
 		}
 
-		if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
-			return sdkerrors.Wrapf(
-				types.ErrPacketTimeout,
-				"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
-			)
+		// NOTE: this is a temporary fix. Solo machine does not support usage of 'GetTimestampAtHeight'
+		// A future change should move this function to be a ClientState callback.
+		if clientType != exported.Solomachine {
+			latestTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, latestHeight)
+			if err != nil {
+				return err
+			}
+
+			if packet.GetTimeoutTimestamp() != 0 && latestTimestamp >= packet.GetTimeoutTimestamp() {
+				return sdkerrors.Wrapf(
+					types.ErrPacketTimeout,
+					"receiving chain block timestamp >= packet timeout timestamp (%s >= %s)", time.Unix(0, int64(latestTimestamp)), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
+				)
+			}
 		}
 	}
 
@@ -200,28 +212,33 @@ func (k Keeper) RecvPacket(
 	}
 
 	// check if packet timeouted by comparing it with the latest height of the chain
+	var timeoutErr error
 	selfHeight := clienttypes.GetSelfHeight(ctx)
 	timeoutHeight := packet.GetTimeoutHeight()
 	if !timeoutHeight.IsZero() && selfHeight.GTE(timeoutHeight) {
-		return sdkerrors.Wrapf(
+		timeoutErr = sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
 			"block height >= packet timeout height (%s >= %s)", selfHeight, timeoutHeight,
 		)
-	}
-
-	// check if packet timeouted by comparing it with the latest timestamp of the chain
-	if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
-		return sdkerrors.Wrapf(
+	} else if packet.GetTimeoutTimestamp() != 0 && uint64(ctx.BlockTime().UnixNano()) >= packet.GetTimeoutTimestamp() {
+		// check if packet timeouted by comparing it with the latest timestamp of the chain
+		timeoutErr = sdkerrors.Wrapf(
 			types.ErrPacketTimeout,
 			"block timestamp >= packet timeout timestamp (%s >= %s)", ctx.BlockTime(), time.Unix(0, int64(packet.GetTimeoutTimestamp())),
 		)
 	}
 
+	// packets which timed out are skipped on ORDERED_ALLOW_TIMEOUT channels once
+	// the commitment and the ordering of the packet are verified
+	if timeoutErr != nil && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
+		return timeoutErr
+	}
+
 	commitment := types.CommitPacket(k.cdc, packet)
 
 	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
+	if err := k.verifyPacketCommitment(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
 		commitment,
 	); err != nil {
@@ -231,8 +248,10 @@ func (k Keeper) RecvPacket(
 	switch channel.Ordering {
 	case types.UNORDERED:
 		// check if the packet receipt has been received already for unordered channels
+		// packets below the receive start sequence have been received or timed out,
+		// their packet receipts may have been pruned
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
-		if found {
+		if found || packet.GetSequence() < k.getRecvStartSequence(ctx, packet.GetDestPort(), packet.GetDestChannel()) {
 			EmitRecvPacketEvent(ctx, packet, channel)
 			// This error indicates that the packet has already been relayed. Core IBC will
 			// treat this error as a no-op in order to prevent an entire relay transaction
@@ -246,7 +265,7 @@ func (k Keeper) RecvPacket(
 		// it's just a single store key set to an empty string to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -279,6 +298,25 @@ func (k Keeper) RecvPacket(
 		// Since this is the receiving chain, our channelEnd is packet's destination port and channel
 		k.SetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv)
 
+		if timeoutErr != nil {
+			// The packet timed out on an ORDERED_ALLOW_TIMEOUT channel. It is skipped without
+			// executing the application callback and a timeout receipt is written, so that the
+			// counterparty can prove the timeout without closing the channel.
+			k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+			k.Logger(ctx).Info(
+				"packet timeout receipt written",
+				"sequence", packet.GetSequence(),
+				"src_port", packet.GetSourcePort(),
+				"src_channel", packet.GetSourceChannel(),
+				"dst_port", packet.GetDestPort(),
+				"dst_channel", packet.GetDestChannel(),
+			)
+
+			EmitWriteTimeoutReceiptEvent(ctx, packet, channel)
+
+			return types.ErrTimeoutReceiptWritten
+		}
 	}
 
 	// log that a packet has been received & executed
@@ -377,7 +415,9 @@ func (k Keeper) WriteAcknowledgement(
 // module on the counterparty chain. Its intended usage is within the ante
 // handler. AcknowledgePacket will clean up the packet commitment,
 // which is no longer necessary since the packet has been received and acted upon.
-// It will also increment NextSequenceAck in case of ORDERED channels.
+// It will also increment NextSequenceAck in case of ORDERED and ORDERED_ALLOW_TIMEOUT
+// channels and advance it past all acknowledged or timed out packets in case of
+// UNORDERED channels.
 func (k Keeper) AcknowledgePacket(
 	ctx sdk.Context,
 	chanCap *capabilitytypes.Capability,
@@ -455,15 +495,15 @@ func (k Keeper) AcknowledgePacket(
 		return sdkerrors.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+	if err := k.verifyPacketAcknowledgement(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
 		packet.GetSequence(), acknowledgement,
 	); err != nil {
 		return err
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering == types.ORDERED || channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return sdkerrors.Wrapf(
@@ -491,6 +531,10 @@ func (k Keeper) AcknowledgePacket(
 	// Delete packet commitment, since the packet has been acknowledged, the commitement is no longer necessary
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
+	if channel.Ordering == types.UNORDERED {
+		k.advanceNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	}
+
 	// log that a packet has been acknowledged
 	k.Logger(ctx).Info(
 		"packet acknowledged",
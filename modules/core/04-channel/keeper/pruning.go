@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Packet Receipt and Acknowledgement Pruning
+//
+// The packet receipts and acknowledgements of an UNORDERED channel are pruned
+// once they can no longer be used by the counterparty:
+//
+// - The sending chain advances the next sequence to be acknowledged of an
+//   UNORDERED channel past every packet which has been acknowledged or timed out,
+//   i.e. whose packet commitment has been deleted.
+// - The receiving chain proves the next sequence to be acknowledged of the
+//   counterparty channel end and stores it as the receive start sequence of the
+//   channel. Packets with a lower sequence are never received again, since they
+//   were either received already or timed out.
+// - The packet receipts and acknowledgements below the receive start sequence
+//   are pruned in bounded batches.
+
+// maxNextSequenceAckAdvance is the maximum number of sequences the next sequence
+// to be acknowledged of an UNORDERED channel is advanced by when a packet is
+// acknowledged or timed out. It bounds the gas consumed by a single message.
+const maxNextSequenceAckAdvance = 100
+
+// advanceNextSequenceAck advances the next sequence to be acknowledged of an
+// UNORDERED channel past the sequences of sent packets whose packet commitment
+// has been deleted. All packets with a lower sequence have been acknowledged or
+// timed out.
+func (k Keeper) advanceNextSequenceAck(ctx sdk.Context, portID, channelID string) {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	nextSequenceSend, found := k.GetNextSequenceSend(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	sequence := nextSequenceAck
+	for i := 0; i < maxNextSequenceAckAdvance && sequence < nextSequenceSend; i++ {
+		if k.HasPacketCommitment(ctx, portID, channelID, sequence) {
+			break
+		}
+		sequence++
+	}
+
+	if sequence != nextSequenceAck {
+		k.SetNextSequenceAck(ctx, portID, channelID, sequence)
+	}
+}
+
+// getRecvStartSequence returns the receive start sequence of a channel. Packets
+// can be received with any sequence on channels which have not been pruned.
+func (k Keeper) getRecvStartSequence(ctx sdk.Context, portID, channelID string) uint64 {
+	sequence, found := k.GetRecvStartSequence(ctx, portID, channelID)
+	if !found {
+		return 1
+	}
+
+	return sequence
+}
+
+// getPruningSequenceStart returns the next sequence to be pruned of a channel.
+func (k Keeper) getPruningSequenceStart(ctx sdk.Context, portID, channelID string) uint64 {
+	sequence, found := k.GetPruningSequenceStart(ctx, portID, channelID)
+	if !found {
+		return 1
+	}
+
+	return sequence
+}
+
+// PruneAcknowledgements prunes up to limit packet receipts and acknowledgements
+// of an UNORDERED channel. If a non-zero next sequence to be acknowledged of the
+// counterparty channel end is provided, it is verified using the given proof and
+// advances the receive start sequence of the channel. Only sequences below the
+// receive start sequence are pruned. The number of pruned sequences and the
+// number of sequences below the receive start sequence which are left to be
+// pruned are returned.
+func (k Keeper) PruneAcknowledgements(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+	proof []byte,
+	proofHeight exported.Height,
+	limit uint64,
+) (uint64, uint64, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return 0, 0, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.UNORDERED {
+		return 0, 0, sdkerrors.Wrapf(
+			types.ErrInvalidChannelOrdering,
+			"packet receipts and acknowledgements can only be pruned on UNORDERED channels, got %s", channel.Ordering,
+		)
+	}
+
+	recvStartSequence := k.getRecvStartSequence(ctx, portID, channelID)
+	if nextSequenceAck > recvStartSequence {
+		connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+		if !found {
+			return 0, 0, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+		}
+
+		if err := k.verifyNextSequenceAck(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
+			channel.Counterparty.PortId, channel.Counterparty.ChannelId, nextSequenceAck,
+		); err != nil {
+			return 0, 0, sdkerrors.Wrap(err, "couldn't verify counterparty next sequence acknowledgement")
+		}
+
+		recvStartSequence = nextSequenceAck
+		k.SetRecvStartSequence(ctx, portID, channelID, recvStartSequence)
+	}
+
+	pruningSequenceStart := k.getPruningSequenceStart(ctx, portID, channelID)
+
+	sequence := pruningSequenceStart
+	for ; sequence < recvStartSequence && sequence-pruningSequenceStart < limit; sequence++ {
+		k.deletePacketReceipt(ctx, portID, channelID, sequence)
+		k.deletePacketAcknowledgement(ctx, portID, channelID, sequence)
+	}
+
+	if sequence != pruningSequenceStart {
+		k.SetPruningSequenceStart(ctx, portID, channelID, sequence)
+	}
+
+	k.Logger(ctx).Info(
+		"packet receipts and acknowledgements pruned",
+		"port-id", portID,
+		"channel-id", channelID,
+		"recv-start-sequence", recvStartSequence,
+		"pruning-sequence-start", sequence,
+	)
+
+	EmitPruneAcknowledgementsEvent(ctx, portID, channelID, recvStartSequence, sequence)
+
+	var totalRemaining uint64
+	if recvStartSequence > sequence {
+		totalRemaining = recvStartSequence - sequence
+	}
+
+	return sequence - pruningSequenceStart, totalRemaining, nil
+}
@@ -0,0 +1,253 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+// relayPackets sends the given number of packets from chainA to chainB, receives
+// them on chainB and acknowledges them on chainA. The packets are returned.
+func (suite *KeeperTestSuite) relayPackets(path *ibctesting.Path, numPackets int) []types.Packet {
+	packets := make([]types.Packet, numPackets)
+	for i := range packets {
+		sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceSend(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		suite.Require().True(found)
+
+		packets[i] = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+		suite.Require().NoError(path.EndpointA.SendPacket(packets[i]))
+		suite.Require().NoError(path.EndpointB.RecvPacket(packets[i]))
+		suite.Require().NoError(path.EndpointA.AcknowledgePacket(packets[i], ibcmock.MockAcknowledgement.Acknowledgement()))
+	}
+
+	return packets
+}
+
+// TestAdvanceNextSequenceAck tests that the next sequence to be acknowledged of
+// an UNORDERED channel is advanced past all acknowledged and timed out packets.
+func (suite *KeeperTestSuite) TestAdvanceNextSequenceAck() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	nextSequenceAck := func() uint64 {
+		sequence, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		suite.Require().True(found)
+		return sequence
+	}
+
+	shortTimeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().Increment().Increment().(clienttypes.Height)
+
+	packet1 := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	packet2 := types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	packet3 := types.NewPacket(ibctesting.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, shortTimeoutHeight, disabledTimeoutTimestamp)
+	for _, packet := range []types.Packet{packet1, packet2, packet3} {
+		suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	}
+
+	// acknowledging a packet above a pending packet does not advance the sequence
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet2))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet2, ibcmock.MockAcknowledgement.Acknowledgement()))
+	suite.Require().Equal(uint64(1), nextSequenceAck())
+
+	// acknowledging the lowest pending packet advances the sequence past the acknowledged packets
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet1))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet1, ibcmock.MockAcknowledgement.Acknowledgement()))
+	suite.Require().Equal(uint64(3), nextSequenceAck())
+
+	// timing out the last pending packet advances the sequence to the next sequence to be sent
+	suite.coordinator.CommitNBlocks(suite.chainB, 3)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(packet3))
+	suite.Require().Equal(uint64(4), nextSequenceAck())
+}
+
+// TestPruneAcknowledgements tests pruning the packet receipts and acknowledgements
+// of packets sent from chainA to chainB on chainB.
+func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
+	var (
+		path            *ibctesting.Path
+		nextSequenceAck uint64
+		proof           []byte
+		proofHeight     clienttypes.Height
+		limit           uint64
+	)
+
+	testCases := []struct {
+		msg               string
+		malleate          func()
+		expPass           bool
+		expPruned         uint64
+		expRemaining      uint64
+		expRecvStartSeq   uint64
+		expPruningSeqStat uint64
+	}{
+		{"success", func() {}, true, 5, 0, 6, 6},
+		{"success: limit is lower than the number of prunable sequences", func() {
+			limit = 2
+		}, true, 2, 3, 6, 3},
+		{"success: next sequence ack of counterparty is not updated", func() {
+			nextSequenceAck = 0
+			proof = nil
+		}, true, 0, 0, 1, 1},
+		{"success: prune sequences below previously updated receive start sequence", func() {
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 4)
+			nextSequenceAck = 0
+			proof = nil
+		}, true, 3, 0, 4, 4},
+		{"success: proven next sequence ack is lower than receive start sequence", func() {
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.SetRecvStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, 6)
+			nextSequenceAck = 2
+			proof = nil
+		}, true, 5, 0, 6, 6},
+		{"channel not found", func() {
+			path.EndpointB.ChannelID = ibctesting.InvalidID
+		}, false, 0, 0, 0, 0},
+		{"channel is ORDERED", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.Ordering = types.ORDERED
+			path.EndpointB.SetChannel(channel)
+		}, false, 0, 0, 0, 0},
+		{"next sequence ack of counterparty does not match proof", func() {
+			nextSequenceAck++
+		}, false, 0, 0, 0, 0},
+		{"consensus state not found", func() {
+			proofHeight = clienttypes.NewHeight(proofHeight.RevisionNumber, proofHeight.RevisionHeight+100)
+		}, false, 0, 0, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets := suite.relayPackets(path, 5)
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			var found bool
+			nextSequenceAck, found = suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			proof, proofHeight = path.EndpointA.QueryProof(host.NextSequenceAckKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			limit = 10
+
+			tc.malleate()
+
+			pruned, remaining, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.PruneAcknowledgements(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				nextSequenceAck, proof, proofHeight, limit,
+			)
+
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPruned, pruned)
+			suite.Require().Equal(tc.expRemaining, remaining)
+
+			channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+			if tc.expRecvStartSeq != 1 {
+				recvStartSequence, found := channelKeeper.GetRecvStartSequence(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(tc.expRecvStartSeq, recvStartSequence)
+			}
+
+			pruningSequenceStart, found := channelKeeper.GetPruningSequenceStart(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			suite.Require().Equal(tc.expPruningSeqStat != 1, found)
+			if found {
+				suite.Require().Equal(tc.expPruningSeqStat, pruningSequenceStart)
+			}
+
+			for _, packet := range packets {
+				_, receiptFound := channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				ackFound := channelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+				pruned := packet.GetSequence() < tc.expPruningSeqStat
+				suite.Require().Equal(!pruned, receiptFound, "packet receipt of sequence %d", packet.GetSequence())
+				suite.Require().Equal(!pruned, ackFound, "packet acknowledgement of sequence %d", packet.GetSequence())
+			}
+		})
+	}
+}
+
+// TestPruneAcknowledgementsReplay tests that packets whose packet receipts were
+// pruned cannot be received again.
+func (suite *KeeperTestSuite) TestPruneAcknowledgementsReplay() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	suite.coordinator.Setup(path)
+
+	// send a packet and store the proof of its packet commitment before it is acknowledged
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+
+	// a timed out packet has no packet receipt, but advances the next sequence ack of chainA
+	shortTimeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext()).Increment().(clienttypes.Height)
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, shortTimeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(timedOutPacket))
+	suite.coordinator.CommitNBlocks(suite.chainB, 2)
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(timedOutPacket))
+
+	suite.Require().NoError(path.EndpointB.PruneAcknowledgements(10))
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	_, found := channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the proof of the packet commitment is still valid, but the packet is not received again
+	chanCap := suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	err := channelKeeper.RecvPacket(suite.chainB.GetContext(), chanCap, packet, proof, proofHeight)
+	suite.Require().ErrorIs(err, types.ErrNoOpMsg)
+
+	_, found = channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+
+	// the pruned packet receipt cannot be used to time out the acknowledged packet
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	proof, proofHeight = path.EndpointA.QueryProof(host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutPacket(suite.chainA.GetContext(), packet, proof, proofHeight, 1)
+	suite.Require().Error(err)
+
+	// packets above the receive start sequence are received
+	packet3 := types.NewPacket(ibctesting.MockPacketData, 3, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet3))
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet3))
+
+	_, found = channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet3.GetDestPort(), packet3.GetDestChannel(), packet3.GetSequence())
+	suite.Require().True(found)
+}
+
+// TestMultihopPruneAcknowledgements tests pruning the packet receipts and
+// acknowledgements of a channel over multiple connection hops.
+func (suite *KeeperTestSuite) TestMultihopPruneAcknowledgements() {
+	path := suite.setupMultihopPath(3)
+	suite.coordinator.CreateMultihopChannels(path)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.ZeroHeight(), uint64(suite.coordinator.CurrentTime.Add(ibctesting.TimeIncrement*100).UnixNano()))
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.RelayPacket(packet))
+
+	proof, proofHeight := path.EndpointB.QueryMultihopProof(host.NextSequenceAckKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+	channelKeeper := path.EndpointB.Chain.App.GetIBCKeeper().ChannelKeeper
+	pruned, remaining, err := channelKeeper.PruneAcknowledgements(
+		path.EndpointB.Chain.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		2, proof, proofHeight, 10,
+	)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), pruned)
+	suite.Require().Zero(remaining)
+
+	suite.Require().False(channelKeeper.HasPacketAcknowledgement(path.EndpointB.Chain.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+}
@@ -60,13 +60,13 @@ func (k Keeper) TimeoutPacket(
 	}
 
 	// check that timeout height or timeout timestamp has passed on the other end
-	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+	counterpartyHeight, proofTimestamp, err := k.getCounterpartyHeightAndTimestamp(ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof)
 	if err != nil {
 		return err
 	}
 
 	timeoutHeight := packet.GetTimeoutHeight()
-	if (timeoutHeight.IsZero() || proofHeight.LT(timeoutHeight)) &&
+	if (timeoutHeight.IsZero() || counterpartyHeight.LT(timeoutHeight)) &&
 		(packet.GetTimeoutTimestamp() == 0 || proofTimestamp < packet.GetTimeoutTimestamp()) {
 		return sdkerrors.Wrap(types.ErrPacketTimeout, "packet timeout has not been reached for height or timestamp")
 	}
@@ -107,13 +107,23 @@ func (k Keeper) TimeoutPacket(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.checkTimeoutOrdering(ctx, packet); err != nil {
+			return err
+		}
+
+		// check that the counterparty skipped the packet and wrote a timeout receipt
+		err = k.verifyPacketReceipt(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), types.TimeoutReceipt,
+		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
 	default:
@@ -130,6 +140,10 @@ func (k Keeper) TimeoutPacket(
 
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// If it came from an ORDERED_ALLOW_TIMEOUT channel then NextSequenceAck is incremented
+// and the channel stays open.
+// If it came from an UNORDERED channel then NextSequenceAck is advanced past all
+// acknowledged or timed out packets.
 //
 // CONTRACT: this function must be called in the IBC handler
 func (k Keeper) TimeoutExecuted(
@@ -152,9 +166,14 @@ func (k Keeper) TimeoutExecuted(
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
-	if channel.Ordering == types.ORDERED {
+	switch channel.Ordering {
+	case types.ORDERED:
 		channel.State = types.CLOSED
 		k.SetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), channel)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
+	case types.UNORDERED:
+		k.advanceNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 	}
 
 	k.Logger(ctx).Info(
@@ -234,7 +253,10 @@ func (k Keeper) TimeoutOnClose(
 		return sdkerrors.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+	counterpartyHops, err := k.getCounterpartyConnectionHops(ctx, connectionEnd, channel.ConnectionHops, proofClosed)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(packet.GetSourcePort(), packet.GetSourceChannel())
 	expectedChannel := types.NewChannel(
@@ -242,15 +264,14 @@ func (k Keeper) TimeoutOnClose(
 	)
 
 	// check that the opposing channel end has closed
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, proofClosed,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proofClosed,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
 		return err
 	}
 
-	var err error
 	switch channel.Ordering {
 	case types.ORDERED:
 		// check that packet has not been received
@@ -259,13 +280,32 @@ func (k Keeper) TimeoutOnClose(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		if err := k.checkTimeoutOrdering(ctx, packet); err != nil {
+			return err
+		}
+
+		if nextSequenceRecv > packet.GetSequence() {
+			// the packet timed out before the channel was closed, check that the
+			// counterparty skipped the packet and wrote a timeout receipt
+			err = k.verifyPacketReceipt(
+				ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), types.TimeoutReceipt,
+			)
+		} else {
+			// check that the recv sequence is as claimed
+			err = k.verifyNextSequenceRecv(
+				ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
+				packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+			)
+		}
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
 	default:
@@ -279,3 +319,24 @@ func (k Keeper) TimeoutOnClose(
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return nil
 }
+
+// checkTimeoutOrdering checks that the packets of an ORDERED_ALLOW_TIMEOUT channel are
+// timed out in order, since a timeout increments the next sequence to be acknowledged.
+func (k Keeper) checkTimeoutOrdering(ctx sdk.Context, packet exported.PacketI) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return sdkerrors.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return sdkerrors.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	return nil
+}
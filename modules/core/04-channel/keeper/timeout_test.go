@@ -13,6 +13,7 @@ import (
 	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
 )
 
 // TestTimeoutPacket test the TimeoutPacket call on chainA by ensuring the timeout has passed
@@ -47,6 +48,54 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			// need to update chainA's client representing chainB to prove missing ack
 			path.EndpointA.UpdateClient()
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+
+			// chainB skips the timed out packet and writes a timeout receipt
+			err := path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+			nextSeqRecv = 2
+
+			// need to update chainA's client representing chainB to prove the timeout receipt
+			path.EndpointA.UpdateClient()
+		}, true},
+		{"timeout receipt not written: ORDERED_ALLOW_TIMEOUT", func() {
+			// skip error check, error occurs in light-clients
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			// need to update chainA's client representing chainB to prove missing ack
+			path.EndpointA.UpdateClient()
+		}, false},
+		{"packet timed out out of order: ORDERED_ALLOW_TIMEOUT", func() {
+			expError = types.ErrPacketSequenceOutOfOrder
+			ordered = false
+			path.SetChannelOrderedAllowTimeout()
+
+			suite.coordinator.Setup(path)
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			for seq := uint64(1); seq <= 2; seq++ {
+				packet = types.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+				path.EndpointA.SendPacket(packet)
+			}
+			for seq := uint64(1); seq <= 2; seq++ {
+				suite.Require().NoError(path.EndpointB.UpdateClient())
+				err := path.EndpointB.RecvPacket(types.NewPacket(ibctesting.MockPacketData, seq, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp))
+				suite.Require().NoError(err)
+			}
+			nextSeqRecv = 3
+
+			// attempt to time out packet 2 before packet 1
+			path.EndpointA.UpdateClient()
+		}, false},
 		{"packet already timed out: ORDERED", func() {
 			expError = types.ErrNoOpMsg
 			ordered = true
@@ -229,6 +278,15 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 
 			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"success ORDERED_ALLOW_TIMEOUT", func() {
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
@@ -259,6 +317,19 @@ func (suite *KeeperTestSuite) TestTimeoutExecuted() {
 			if tc.expPass {
 				suite.NoError(err)
 				suite.Nil(pc)
+
+				channel := path.EndpointA.GetChannel()
+				if channel.Ordering == types.ORDERED {
+					suite.Equal(types.CLOSED, channel.State)
+				} else {
+					suite.Equal(types.OPEN, channel.State)
+				}
+
+				if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+					nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
+					suite.True(found)
+					suite.Equal(packet.GetSequence()+1, nextSeqAck)
+				}
 			} else {
 				suite.Error(err)
 			}
@@ -302,6 +373,36 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 
 			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
 		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT", func() {
+			ordered = true
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			path.EndpointB.SetChannelClosed()
+			// need to update chainA's client representing chainB to prove missing ack
+			path.EndpointA.UpdateClient()
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
+		{"success: ORDERED_ALLOW_TIMEOUT with timeout receipt", func() {
+			// the timeout receipt written before the channel was closed is proven
+			ordered = false
+			nextSeqRecv = 2
+			path.SetChannelOrderedAllowTimeout()
+			suite.coordinator.Setup(path)
+
+			packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+			path.EndpointA.SendPacket(packet)
+			err := path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+			path.EndpointB.SetChannelClosed()
+			// need to update chainA's client representing chainB to prove the timeout receipt
+			path.EndpointA.UpdateClient()
+
+			chanCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, true},
 		{"channel not found", func() {
 			// use wrong channel naming
 			suite.coordinator.Setup(path)
@@ -432,3 +533,54 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 	}
 
 }
+
+// TestOrderedAllowTimeout tests that a packet which timed out on an ORDERED_ALLOW_TIMEOUT
+// channel is skipped by chainB and timed out on chainA without closing the channel, while
+// the following packet is still received and acknowledged in order.
+func (suite *KeeperTestSuite) TestOrderedAllowTimeout() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	suite.coordinator.Setup(path)
+
+	timedOutPacket := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(timedOutPacket))
+	packet := types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+	// the timed out packet is skipped and a timeout receipt is written instead of an acknowledgement
+	suite.Require().NoError(path.EndpointB.RecvPacket(timedOutPacket))
+
+	channelKeeperB := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	receipt, found := channelKeeperB.GetPacketReceipt(suite.chainB.GetContext(), timedOutPacket.GetDestPort(), timedOutPacket.GetDestChannel(), timedOutPacket.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+	suite.Require().False(channelKeeperB.HasPacketAcknowledgement(suite.chainB.GetContext(), timedOutPacket.GetDestPort(), timedOutPacket.GetDestChannel(), timedOutPacket.GetSequence()))
+
+	// the following packet is received in order
+	suite.Require().NoError(path.EndpointB.UpdateClient())
+	suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+	nextSeqRecv, found := channelKeeperB.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(3), nextSeqRecv)
+
+	// the packet cannot be acknowledged before the timed out packet
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointB.QueryProof(packetKey)
+	err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.AcknowledgePacket(suite.chainA.GetContext(), suite.chainA.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel()), packet, ibcmock.MockAcknowledgement.Acknowledgement(), proof, proofHeight)
+	suite.Require().True(errors.Is(err, types.ErrPacketSequenceOutOfOrder))
+
+	// the timeout receipt is proven and the channel stays open
+	suite.Require().NoError(path.EndpointA.TimeoutPacket(timedOutPacket))
+	suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+
+	channelKeeperA := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	nextSeqAck, found := channelKeeperA.GetNextSequenceAck(suite.chainA.GetContext(), timedOutPacket.GetSourcePort(), timedOutPacket.GetSourceChannel())
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), nextSeqAck)
+
+	suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+	nextSeqAck, found = channelKeeperA.GetNextSequenceAck(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel())
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(3), nextSeqAck)
+}
@@ -0,0 +1,595 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// Upgrade Handshake
+//
+// This section defines the set of functions required to upgrade the ordering,
+// connection hops or version of an OPEN channel. While a channel end is in the
+// INITUPGRADE or TRYUPGRADE state the proposed channel end is stored under the
+// channel path, so that the counterparty can verify it using VerifyChannelState,
+// and the original channel end is kept in the upgrade state so that it can be
+// restored if the upgrade is aborted. Packets cannot be sent or received while
+// an upgrade is in progress.
+//
+// ChanUpgradeInit is called by a module to start the upgrade of an OPEN channel
+// to the proposed ordering, connection hops and version.
+func (k Keeper) ChanUpgradeInit(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	order types.Order,
+	connectionHops []string,
+	version string,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if _, err := k.validateUpgradeFields(ctx, channel, order, connectionHops); err != nil {
+		return err
+	}
+
+	if order == channel.Ordering && connectionHops[0] == channel.ConnectionHops[0] && version == channel.Version {
+		return sdkerrors.Wrap(types.ErrInvalidUpgrade, "proposed upgrade does not change the channel ordering, connection hops or version")
+	}
+
+	if k.hasInflightPackets(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrPacketsInFlight, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return nil
+}
+
+// WriteUpgradeInitChannel stores the proposed channel end in the INITUPGRADE state
+// for a channel which has successfully passed the UpgradeInit handshake step. The
+// current channel end and the upgrade timeout are stored in the upgrade state.
+// An event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeInitChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order types.Order,
+	connectionHops []string,
+	version string,
+	timeout types.UpgradeTimeout,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeInit step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	k.SetUpgrade(ctx, portID, channelID, types.NewUpgrade(channel, timeout, clienttypes.ZeroHeight()))
+
+	proposedChannel := types.NewChannel(types.INITUPGRADE, order, channel.Counterparty, connectionHops, version)
+	k.SetChannel(ctx, portID, channelID, proposedChannel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "OPEN", "new-state", "INITUPGRADE")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-init")
+	}()
+
+	EmitChannelUpgradeInitEvent(ctx, portID, channelID, proposedChannel)
+}
+
+// ChanUpgradeTry is called by a module to accept the upgrade of an OPEN channel
+// initialized by the counterparty module. The proposed ordering must match the
+// ordering proposed by the counterparty.
+func (k Keeper) ChanUpgradeTry(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	order types.Order,
+	connectionHops []string,
+	counterpartyVersion string,
+	proofInit []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != types.OPEN {
+		return sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not OPEN (got %s)", channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	proposedConnection, err := k.validateUpgradeFields(ctx, channel, order, connectionHops)
+	if err != nil {
+		return err
+	}
+
+	if k.hasInflightPackets(ctx, portID, channelID) {
+		return sdkerrors.Wrapf(types.ErrPacketsInFlight, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops := []string{proposedConnection.GetCounterparty().GetConnectionID()}
+
+	// expectedCounterparty is the counterparty of the counterparty's channel end
+	// (i.e self)
+	expectedCounterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.INITUPGRADE, order, expectedCounterparty,
+		counterpartyHops, counterpartyVersion,
+	)
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofInit,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeTryChannel stores the proposed channel end in the TRYUPGRADE state
+// for a channel which has successfully passed the UpgradeTry handshake step. The
+// current channel end and the height at which the counterparty INITUPGRADE
+// channel end was proven are stored in the upgrade state. An event is emitted for
+// the handshake step.
+func (k Keeper) WriteUpgradeTryChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	order types.Order,
+	connectionHops []string,
+	version string,
+	proofHeight clienttypes.Height,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeTry step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	k.SetUpgrade(ctx, portID, channelID, types.NewUpgrade(channel, types.UpgradeTimeout{}, proofHeight))
+
+	proposedChannel := types.NewChannel(types.TRYUPGRADE, order, channel.Counterparty, connectionHops, version)
+	k.SetChannel(ctx, portID, channelID, proposedChannel)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "OPEN", "new-state", "TRYUPGRADE")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-try")
+	}()
+
+	EmitChannelUpgradeTryEvent(ctx, portID, channelID, proposedChannel)
+}
+
+// ChanUpgradeAck is called by the handshake-originating module to acknowledge the
+// acceptance of the upgrade by the counterparty module.
+func (k Keeper) ChanUpgradeAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	counterpartyVersion string,
+	proofTry []byte,
+	proofHeight exported.Height,
+) error {
+	channel, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap, types.INITUPGRADE)
+	if err != nil {
+		return err
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	proposedConnection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops := []string{proposedConnection.GetCounterparty().GetConnectionID()}
+
+	// counterparty of the counterparty channel end (i.e self)
+	expectedCounterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.TRYUPGRADE, channel.Ordering, expectedCounterparty,
+		counterpartyHops, counterpartyVersion,
+	)
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofTry,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeAckChannel opens the upgraded channel end with the counterparty
+// version for a channel which has successfully passed the UpgradeAck handshake
+// step. The upgrade state is removed and an event is emitted for the handshake
+// step.
+func (k Keeper) WriteUpgradeAckChannel(
+	ctx sdk.Context,
+	portID,
+	channelID,
+	counterpartyVersion string,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeAck step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	channel.State = types.OPEN
+	channel.Version = counterpartyVersion
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.deleteUpgrade(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "INITUPGRADE", "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-ack")
+	}()
+
+	EmitChannelUpgradeAckEvent(ctx, portID, channelID, channel)
+}
+
+// ChanUpgradeConfirm is called by the counterparty module to open their end of
+// the upgraded channel, since the other end has completed the upgrade.
+func (k Keeper) ChanUpgradeConfirm(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	proofAck []byte,
+	proofHeight exported.Height,
+) error {
+	channel, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap, types.TRYUPGRADE)
+	if err != nil {
+		return err
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	proposedConnection, err := k.getOpenConnection(ctx, channel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	counterpartyHops := []string{proposedConnection.GetCounterparty().GetConnectionID()}
+
+	counterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.OPEN, channel.Ordering, counterparty,
+		counterpartyHops, channel.Version,
+	)
+
+	if err := k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofAck,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
+		expectedChannel,
+	); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteUpgradeConfirmChannel opens the upgraded channel end for a channel which
+// has successfully passed the UpgradeConfirm handshake step. The upgrade state
+// is removed and an event is emitted for the handshake step.
+func (k Keeper) WriteUpgradeConfirmChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when updating channel state in successful ChanUpgradeConfirm step, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	channel.State = types.OPEN
+	k.SetChannel(ctx, portID, channelID, channel)
+	k.deleteUpgrade(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", "TRYUPGRADE", "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-confirm")
+	}()
+
+	EmitChannelUpgradeConfirmEvent(ctx, portID, channelID, channel)
+}
+
+// ChanUpgradeTimeout is called by the handshake-originating module to abort an
+// upgrade once the upgrade timeout has passed on the counterparty chain. The
+// counterparty channel end must still be OPEN with its original fields, proving
+// that the counterparty never executed the try step. The caller is expected to
+// restore the channel using RestoreChannel.
+func (k Keeper) ChanUpgradeTimeout(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	_, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap, types.INITUPGRADE)
+	if err != nil {
+		return err
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	proofTimestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+	if err != nil {
+		return err
+	}
+
+	if !upgrade.Timeout.HasPassed(proofHeight, proofTimestamp) {
+		return sdkerrors.Wrapf(
+			types.ErrUpgradeTimeoutNotReached,
+			"proof height (%s) and proof timestamp (%d) are below the upgrade timeout height (%s) and timeout timestamp (%d)",
+			proofHeight, proofTimestamp, upgrade.Timeout.TimeoutHeight, upgrade.Timeout.TimeoutTimestamp,
+		)
+	}
+
+	return k.verifyCounterpartyRestored(ctx, portID, channelID, upgrade, connectionEnd, proofChannel, proofHeight)
+}
+
+// ChanUpgradeCancel is called by the counterparty module to abort an upgrade in
+// the TRYUPGRADE state after the handshake-originating module aborted the upgrade.
+// The counterparty channel end must be OPEN with its original fields at a height
+// greater than the height at which it was proven to be in the INITUPGRADE state.
+// The caller is expected to restore the channel using RestoreChannel.
+func (k Keeper) ChanUpgradeCancel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	_, upgrade, err := k.getUpgradingChannel(ctx, portID, channelID, chanCap, types.TRYUPGRADE)
+	if err != nil {
+		return err
+	}
+
+	if !proofHeight.GT(upgrade.CounterpartyInitHeight) {
+		return sdkerrors.Wrapf(
+			sdkerrors.ErrInvalidHeight,
+			"proof height (%s) must be greater than the height at which the counterparty upgrade was initialized (%s)",
+			proofHeight, upgrade.CounterpartyInitHeight,
+		)
+	}
+
+	connectionEnd, err := k.getOpenConnection(ctx, upgrade.RestoreChannel.ConnectionHops[0])
+	if err != nil {
+		return err
+	}
+
+	return k.verifyCounterpartyRestored(ctx, portID, channelID, upgrade, connectionEnd, proofChannel, proofHeight)
+}
+
+// RestoreChannel aborts the upgrade of a channel in the INITUPGRADE or TRYUPGRADE
+// state. The original channel end is restored and the upgrade state is removed.
+// An event is emitted for the aborted upgrade.
+func (k Keeper) RestoreChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+) {
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing upgrade when restoring channel, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		panic(fmt.Sprintf("could not find existing channel when restoring channel, channelID: %s, portID: %s", channelID, portID))
+	}
+
+	k.SetChannel(ctx, portID, channelID, upgrade.RestoreChannel)
+	k.deleteUpgrade(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel state updated", "port-id", portID, "channel-id", channelID, "previous-state", channel.State.String(), "new-state", "OPEN")
+
+	defer func() {
+		telemetry.IncrCounter(1, "ibc", "channel", "upgrade-abort")
+	}()
+
+	EmitChannelUpgradeAbortEvent(ctx, portID, channelID, upgrade.RestoreChannel)
+}
+
+// getUpgradingChannel returns the channel end and its upgrade state after
+// checking that the channel is in the expected upgrade state and that the
+// capability is owned by the caller.
+func (k Keeper) getUpgradingChannel(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	chanCap *capabilitytypes.Capability,
+	state types.State,
+) (types.Channel, types.Upgrade, error) {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.State != state {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(
+			types.ErrInvalidChannelState,
+			"channel state is not %s (got %s)", state.String(), channel.State.String(),
+		)
+	}
+
+	if !k.scopedKeeper.AuthenticateCapability(ctx, chanCap, host.ChannelCapabilityPath(portID, channelID)) {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrChannelCapabilityNotFound, "caller does not own capability for channel, port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	upgrade, found := k.GetUpgrade(ctx, portID, channelID)
+	if !found {
+		return types.Channel{}, types.Upgrade{}, sdkerrors.Wrapf(types.ErrUpgradeNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return channel, upgrade, nil
+}
+
+// validateUpgradeFields checks that the channel can be upgraded to the proposed
+// ordering and connection hops and returns the proposed connection. The proposed
+// connection must be OPEN, support the proposed ordering and use the same client
+// as the current connection of the channel.
+func (k Keeper) validateUpgradeFields(
+	ctx sdk.Context,
+	channel types.Channel,
+	order types.Order,
+	connectionHops []string,
+) (connectiontypes.ConnectionEnd, error) {
+	if err := types.ValidateUpgradeOrdering(channel.Ordering, order); err != nil {
+		return connectiontypes.ConnectionEnd{}, err
+	}
+
+	// upgrades are only supported for channels over a single connection hop
+	if len(channel.ConnectionHops) != 1 {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "multi-hop channels cannot be upgraded, got %d connection hops", len(channel.ConnectionHops))
+	}
+	if len(connectionHops) != 1 {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	proposedConnection, err := k.getOpenConnection(ctx, connectionHops[0])
+	if err != nil {
+		return connectiontypes.ConnectionEnd{}, err
+	}
+
+	if proposedConnection.GetClientID() != connectionEnd.GetClientID() {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			types.ErrInvalidUpgrade,
+			"proposed connection client (%s) does not match the current connection client (%s)",
+			proposedConnection.GetClientID(), connectionEnd.GetClientID(),
+		)
+	}
+
+	getVersions := proposedConnection.GetVersions()
+	if len(getVersions) != 1 {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"single version must be negotiated on connection before upgrading channel, got: %v",
+			getVersions,
+		)
+	}
+
+	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.String()) {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidVersion,
+			"connection version %s does not support channel ordering: %s",
+			getVersions[0], order.String(),
+		)
+	}
+
+	return proposedConnection, nil
+}
+
+// getOpenConnection returns the connection with the provided identifier if it is
+// in the OPEN state.
+func (k Keeper) getOpenConnection(ctx sdk.Context, connectionID string) (connectiontypes.ConnectionEnd, error) {
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, connectionID)
+	if !found {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrap(connectiontypes.ErrConnectionNotFound, connectionID)
+	}
+
+	if connectionEnd.GetState() != int32(connectiontypes.OPEN) {
+		return connectiontypes.ConnectionEnd{}, sdkerrors.Wrapf(
+			connectiontypes.ErrInvalidConnectionState,
+			"connection state is not OPEN (got %s)", connectiontypes.State(connectionEnd.GetState()).String(),
+		)
+	}
+
+	return connectionEnd, nil
+}
+
+// verifyCounterpartyRestored verifies that the counterparty channel end is OPEN
+// with the fields the channel had before the upgrade was started.
+func (k Keeper) verifyCounterpartyRestored(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	upgrade types.Upgrade,
+	connectionEnd connectiontypes.ConnectionEnd,
+	proofChannel []byte,
+	proofHeight exported.Height,
+) error {
+	restoreChannel := upgrade.RestoreChannel
+	counterpartyHops := []string{connectionEnd.GetCounterparty().GetConnectionID()}
+
+	counterparty := types.NewCounterparty(portID, channelID)
+	expectedChannel := types.NewChannel(
+		types.OPEN, restoreChannel.Ordering, counterparty,
+		counterpartyHops, restoreChannel.Version,
+	)
+
+	return k.connectionKeeper.VerifyChannelState(
+		ctx, connectionEnd, proofHeight, proofChannel,
+		restoreChannel.Counterparty.PortId, restoreChannel.Counterparty.ChannelId,
+		expectedChannel,
+	)
+}
+
+// hasInflightPackets returns true if there are packet commitments stored for the
+// channel, i.e. packets which have been sent but not yet acknowledged or timed out.
+func (k Keeper) hasInflightPackets(ctx sdk.Context, portID, channelID string) bool {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, []byte(host.PacketCommitmentPrefixPath(portID, channelID)))
+	defer iterator.Close()
+
+	return iterator.Valid()
+}
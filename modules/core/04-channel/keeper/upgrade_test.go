@@ -0,0 +1,448 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v3/testing"
+	ibcmock "github.com/cosmos/ibc-go/v3/testing/mock"
+)
+
+const upgradeVersion = "mock-version-v2"
+
+var defaultUpgradeTimeout = types.NewUpgradeTimeout(clienttypes.NewHeight(0, 10000), 0)
+
+// TestChanUpgradeInit tests the UpgradeInit handshake call for channels. The channel
+// on chainA is fully open before ChanUpgradeInit is called.
+func (suite *KeeperTestSuite) TestChanUpgradeInit() {
+	var (
+		path           *ibctesting.Path
+		channelCap     *capabilitytypes.Capability
+		order          types.Order
+		connectionHops []string
+		version        string
+	)
+
+	testCases := []testCase{
+		{"success: version upgrade", func() {}, true},
+		{"success: ORDERED to UNORDERED", func() {
+			version = ibcmock.Version
+			order = types.UNORDERED
+		}, true},
+		{"channel doesn't exist", func() {
+			path.EndpointA.ChannelID = "channel-100"
+		}, false},
+		{"channel state is not OPEN", func() {
+			suite.Require().NoError(path.EndpointA.SetChannelClosed())
+		}, false},
+		{"channel capability not found", func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"cannot upgrade UNORDERED to ORDERED", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.Ordering = types.UNORDERED
+			path.EndpointA.SetChannel(channel)
+		}, false},
+		{"too many connection hops", func() {
+			connectionHops = append(connectionHops, connectionHops[0])
+		}, false},
+		{"proposed connection not found", func() {
+			connectionHops = []string{"connection-100"}
+		}, false},
+		{"proposed upgrade does not change the channel", func() {
+			version = ibcmock.Version
+		}, false},
+		{"packets in flight", func() {
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointA.SendPacket(packet))
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			order = types.ORDERED
+			connectionHops = []string{path.EndpointA.ConnectionID}
+			version = upgradeVersion
+
+			tc.malleate()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeInit(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap,
+				order, connectionHops, version,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeTry tests the UpgradeTry handshake call for channels. The upgrade
+// is initialized on chainA before ChanUpgradeTry is called on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeTry() {
+	var (
+		path       *ibctesting.Path
+		channelCap *capabilitytypes.Capability
+		order      types.Order
+		version    string
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel state is not OPEN", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.State = types.TRYUPGRADE
+			path.EndpointB.SetChannel(channel)
+		}, false},
+		{"channel capability not found", func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"packets in flight", func() {
+			packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			suite.Require().NoError(path.EndpointB.SendPacket(packet))
+		}, false},
+		{"ordering does not match the counterparty proposal", func() {
+			order = types.UNORDERED
+		}, false},
+		{"version does not match the counterparty proposal", func() {
+			version = ibcmock.Version
+		}, false},
+		{"counterparty upgrade was not initialized", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.RestoreChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.coordinator.CommitBlock(suite.chainA)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			suite.coordinator.Setup(path)
+
+			err := path.EndpointA.ChanUpgradeInit(types.ORDERED, upgradeVersion, defaultUpgradeTimeout)
+			suite.Require().NoError(err)
+
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			order = types.ORDERED
+			version = upgradeVersion
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proof, proofHeight := suite.chainA.QueryProof(channelKey)
+
+			err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeTry(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, channelCap,
+				order, []string{path.EndpointB.ConnectionID}, version, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeAck tests the UpgradeAck handshake call for channels. The upgrade
+// is initialized on chainA and accepted on chainB before ChanUpgradeAck is called.
+func (suite *KeeperTestSuite) TestChanUpgradeAck() {
+	var (
+		path                *ibctesting.Path
+		channelCap          *capabilitytypes.Capability
+		counterpartyVersion string
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel state is not INITUPGRADE", func() {
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.RestoreChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+		}, false},
+		{"channel capability not found", func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"counterparty version does not match", func() {
+			counterpartyVersion = ibcmock.Version
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(types.UNORDERED, upgradeVersion, defaultUpgradeTimeout))
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			counterpartyVersion = upgradeVersion
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			proof, proofHeight := suite.chainB.QueryProof(channelKey)
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeAck(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap,
+				counterpartyVersion, proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeConfirm tests the UpgradeConfirm handshake call for channels. The
+// upgrade is completed on chainA before ChanUpgradeConfirm is called on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeConfirm() {
+	var (
+		path       *ibctesting.Path
+		channelCap *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel state is not TRYUPGRADE", func() {
+			suite.chainB.App.GetIBCKeeper().ChannelKeeper.RestoreChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+		}, false},
+		{"channel capability not found", func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"counterparty upgrade is not completed", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.State = types.INITUPGRADE
+			path.EndpointA.SetChannel(channel)
+			suite.coordinator.CommitBlock(suite.chainA)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(types.UNORDERED, upgradeVersion, defaultUpgradeTimeout))
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeAck())
+
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proof, proofHeight := suite.chainA.QueryProof(channelKey)
+
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeConfirm(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, channelCap,
+				proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeTimeout tests the aborting of an upgrade on chainA once the upgrade
+// timeout has passed on chainB.
+func (suite *KeeperTestSuite) TestChanUpgradeTimeout() {
+	var (
+		path       *ibctesting.Path
+		channelCap *capabilitytypes.Capability
+		timeout    types.UpgradeTimeout
+	)
+
+	testCases := []testCase{
+		{"success: timeout height", func() {}, true},
+		{"success: timeout timestamp", func() {
+			timeout = types.NewUpgradeTimeout(clienttypes.ZeroHeight(), uint64(suite.chainB.GetContext().BlockTime().UnixNano()))
+		}, true},
+		{"timeout not reached", func() {
+			timeout = defaultUpgradeTimeout
+		}, false},
+		{"channel capability not found", func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"counterparty accepted the upgrade", func() {
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(types.UNORDERED, upgradeVersion, timeout))
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			channelCap = suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			timeout = types.NewUpgradeTimeout(clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+
+			// the upgrade is initialized in the malleate functions that require the counterparty to accept it
+			tc.malleate()
+
+			if path.EndpointA.GetChannel().State == types.OPEN {
+				suite.Require().NoError(path.EndpointA.ChanUpgradeInit(types.UNORDERED, upgradeVersion, timeout))
+			}
+			suite.coordinator.CommitBlock(suite.chainB)
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+
+			channelKey := host.ChannelKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			proof, proofHeight := suite.chainB.QueryProof(channelKey)
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeTimeout(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap,
+				proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestChanUpgradeCancel tests the aborting of an upgrade on chainB after the upgrade
+// was aborted on chainA.
+func (suite *KeeperTestSuite) TestChanUpgradeCancel() {
+	var (
+		path       *ibctesting.Path
+		channelCap *capabilitytypes.Capability
+	)
+
+	testCases := []testCase{
+		{"success", func() {}, true},
+		{"channel capability not found", func() {
+			channelCap = capabilitytypes.NewCapability(100)
+		}, false},
+		{"counterparty upgrade was not aborted", func() {
+			channel := path.EndpointA.GetChannel()
+			channel.State = types.INITUPGRADE
+			path.EndpointA.SetChannel(channel)
+			suite.coordinator.CommitBlock(suite.chainA)
+		}, false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			suite.Require().NoError(path.EndpointA.ChanUpgradeInit(types.UNORDERED, upgradeVersion, defaultUpgradeTimeout))
+			suite.Require().NoError(path.EndpointB.ChanUpgradeTry())
+
+			// abort the upgrade on chainA as if the application rejected the counterparty version
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.RestoreChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.coordinator.CommitBlock(suite.chainA)
+
+			channelCap = suite.chainB.GetChannelCapability(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			channelKey := host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			proof, proofHeight := suite.chainA.QueryProof(channelKey)
+
+			err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeCancel(
+				suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, channelCap,
+				proof, proofHeight,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+// TestUpgradeHandshake tests a full channel upgrade handshake using message passing.
+// Packets cannot be sent and the channel cannot be closed while the upgrade is in
+// progress.
+func (suite *KeeperTestSuite) TestUpgradeHandshake() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrdered()
+	suite.coordinator.Setup(path)
+
+	err := path.EndpointA.ChanUpgradeInit(types.UNORDERED, upgradeVersion, defaultUpgradeTimeout)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.INITUPGRADE, path.EndpointA.GetChannel().State)
+
+	upgrade, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(types.OPEN, upgrade.RestoreChannel.State)
+	suite.Require().Equal(types.ORDERED, upgrade.RestoreChannel.Ordering)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+	suite.Require().Error(path.EndpointA.SendPacket(packet))
+
+	channelCap := suite.chainA.GetChannelCapability(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.ChanCloseInit(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, channelCap)
+	suite.Require().Error(err)
+
+	err = path.EndpointB.ChanUpgradeTry()
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.TRYUPGRADE, path.EndpointB.GetChannel().State)
+
+	err = path.EndpointA.ChanUpgradeAck()
+	suite.Require().NoError(err)
+
+	err = path.EndpointB.ChanUpgradeConfirm()
+	suite.Require().NoError(err)
+
+	for _, endpoint := range []*ibctesting.Endpoint{path.EndpointA, path.EndpointB} {
+		channel := endpoint.GetChannel()
+		suite.Require().Equal(types.OPEN, channel.State)
+		suite.Require().Equal(types.UNORDERED, channel.Ordering)
+		suite.Require().Equal(upgradeVersion, channel.Version)
+
+		_, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetUpgrade(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+		suite.Require().False(found)
+	}
+
+	// packets can be relayed on the upgraded channel
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+	suite.Require().NoError(path.RelayPacket(packet))
+}
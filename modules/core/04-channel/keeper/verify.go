@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// The functions in this file verify state of the counterparty channel end. A
+// channel with a single connection hop is verified by the client of its
+// connection, a channel with multiple connection hops is verified using a
+// multi-hop proof starting at the client of the connection of the first hop.
+
+// getCounterpartyConnectionHops returns the connection hops which are expected
+// to be stored in the counterparty channel end.
+func (k Keeper) getCounterpartyConnectionHops(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proof []byte,
+) ([]string, error) {
+	if len(connectionHops) == 1 {
+		return []string{connectionEnd.GetCounterparty().GetConnectionID()}, nil
+	}
+
+	return k.connectionKeeper.GetMultihopCounterpartyConnectionHops(ctx, connectionEnd, proof, connectionHops)
+}
+
+// getCounterpartyHeightAndTimestamp returns the height and timestamp of the
+// counterparty chain at which the proof was constructed.
+func (k Keeper) getCounterpartyHeightAndTimestamp(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+) (exported.Height, uint64, error) {
+	if len(connectionHops) == 1 {
+		timestamp, err := k.connectionKeeper.GetTimestampAtHeight(ctx, connectionEnd, proofHeight)
+		return proofHeight, timestamp, err
+	}
+
+	consensusState, consensusHeight, err := k.connectionKeeper.GetMultihopCounterpartyConsensusState(ctx, connectionEnd, proofHeight, proof, connectionHops)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return consensusHeight, consensusState.GetTimestamp(), nil
+}
+
+// verifyChannelState verifies a proof of the counterparty channel end.
+func (k Keeper) verifyChannelState(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	channel types.Channel,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyChannelState(ctx, connectionEnd, proofHeight, proof, portID, channelID, channel)
+	}
+
+	bz, err := k.cdc.Marshal(&channel)
+	if err != nil {
+		return err
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, proofHeight, proof, connectionHops,
+		commitmenttypes.NewMerklePath(host.ChannelPath(portID, channelID)), bz,
+	)
+}
+
+// verifyPacketCommitment verifies a proof of a packet commitment stored by the
+// counterparty channel end.
+func (k Keeper) verifyPacketCommitment(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketCommitment(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence, commitmentBytes)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, proofHeight, proof, connectionHops,
+		commitmenttypes.NewMerklePath(host.PacketCommitmentPath(portID, channelID, sequence)), commitmentBytes,
+	)
+}
+
+// verifyPacketAcknowledgement verifies a proof of a packet acknowledgement
+// written by the counterparty channel end.
+func (k Keeper) verifyPacketAcknowledgement(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketAcknowledgement(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence, acknowledgement)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, proofHeight, proof, connectionHops,
+		commitmenttypes.NewMerklePath(host.PacketAcknowledgementPath(portID, channelID, sequence)), types.CommitAcknowledgement(acknowledgement),
+	)
+}
+
+// verifyPacketReceiptAbsence verifies a proof of the absence of a packet receipt
+// on the counterparty channel end.
+func (k Keeper) verifyPacketReceiptAbsence(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketReceiptAbsence(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence)
+	}
+
+	return k.connectionKeeper.VerifyMultihopNonMembership(
+		ctx, connectionEnd, proofHeight, proof, connectionHops,
+		commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence)),
+	)
+}
+
+// verifyPacketReceipt verifies a proof of a packet receipt on the counterparty
+// channel end.
+func (k Keeper) verifyPacketReceipt(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketReceipt(ctx, connectionEnd, proofHeight, proof, portID, channelID, sequence, receipt)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, proofHeight, proof, connectionHops,
+		commitmenttypes.NewMerklePath(host.PacketReceiptPath(portID, channelID, sequence)), receipt,
+	)
+}
+
+// verifyNextSequenceRecv verifies a proof of the next sequence to be received
+// by the counterparty channel end.
+func (k Keeper) verifyNextSequenceRecv(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyNextSequenceRecv(ctx, connectionEnd, proofHeight, proof, portID, channelID, nextSequenceRecv)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, proofHeight, proof, connectionHops,
+		commitmenttypes.NewMerklePath(host.NextSequenceRecvPath(portID, channelID)), sdk.Uint64ToBigEndian(nextSequenceRecv),
+	)
+}
+
+// verifyNextSequenceAck verifies a proof of the next sequence to be acknowledged
+// by the counterparty channel end.
+func (k Keeper) verifyNextSequenceAck(
+	ctx sdk.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyNextSequenceAck(ctx, connectionEnd, proofHeight, proof, portID, channelID, nextSequenceAck)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, proofHeight, proof, connectionHops,
+		commitmenttypes.NewMerklePath(host.NextSequenceAckPath(portID, channelID)), sdk.Uint64ToBigEndian(nextSequenceAck),
+	)
+}
@@ -56,17 +56,16 @@ func (ch Channel) ValidateBasic() error {
 	if ch.State == UNINITIALIZED {
 		return ErrInvalidChannelState
 	}
-	if !(ch.Ordering == ORDERED || ch.Ordering == UNORDERED) {
+	if !(ch.Ordering == ORDERED || ch.Ordering == UNORDERED || ch.Ordering == ORDERED_ALLOW_TIMEOUT) {
 		return sdkerrors.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
-	if len(ch.ConnectionHops) != 1 {
-		return sdkerrors.Wrap(
-			ErrTooManyConnectionHops,
-			"current IBC version only supports one connection hop",
-		)
+	if len(ch.ConnectionHops) == 0 {
+		return sdkerrors.Wrap(ErrInvalidChannel, "connection hops cannot be empty")
 	}
-	if err := host.ConnectionIdentifierValidator(ch.ConnectionHops[0]); err != nil {
-		return sdkerrors.Wrap(err, "invalid connection hop ID")
+	for _, connectionID := range ch.ConnectionHops {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return sdkerrors.Wrap(err, "invalid connection hop ID")
+		}
 	}
 	return ch.Counterparty.ValidateBasic()
 }
@@ -25,7 +25,7 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // State defines if a channel is in one of the following states:
-// CLOSED, INIT, TRYOPEN, OPEN or UNINITIALIZED.
+// CLOSED, INIT, TRYOPEN, OPEN, INITUPGRADE, TRYUPGRADE or UNINITIALIZED.
 type State int32
 
 const (
@@ -41,6 +41,13 @@ const (
 	// A channel has been closed and can no longer be used to send or receive
 	// packets.
 	CLOSED State = 4
+	// A channel has started an upgrade handshake. Packets cannot be sent or
+	// received until the upgrade completes or is aborted.
+	INITUPGRADE State = 5
+	// A channel has acknowledged the upgrade handshake step on the counterparty
+	// chain. Packets cannot be sent or received until the upgrade completes or is
+	// aborted.
+	TRYUPGRADE State = 6
 )
 
 var State_name = map[int32]string{
@@ -49,6 +56,8 @@ var State_name = map[int32]string{
 	2: "STATE_TRYOPEN",
 	3: "STATE_OPEN",
 	4: "STATE_CLOSED",
+	5: "STATE_INITUPGRADE",
+	6: "STATE_TRYUPGRADE",
 }
 
 var State_value = map[string]int32{
@@ -57,6 +66,8 @@ var State_value = map[string]int32{
 	"STATE_TRYOPEN":                   2,
 	"STATE_OPEN":                      3,
 	"STATE_CLOSED":                    4,
+	"STATE_INITUPGRADE":               5,
+	"STATE_TRYUPGRADE":                6,
 }
 
 func (x State) String() string {
@@ -78,18 +89,23 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are delivered exactly in the order which they were sent, packets
+	// which timed out are skipped and do not close the channel
+	ORDERED_ALLOW_TIMEOUT Order = 3
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":      0,
+	"ORDER_UNORDERED":             1,
+	"ORDER_ORDERED":               2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT": 3,
 }
 
 func (x Order) String() string {
@@ -347,6 +363,51 @@ func (m *PacketState) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PacketState proto.InternalMessageInfo
 
+// PacketId is an identifer for a unique Packet
+// Source chains refer to packets by source port/channel
+// Destination chains refer to packets by destination port/channel
+type PacketId struct {
+	// channel port identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty" yaml:"port_id"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty" yaml:"channel_id"`
+	// packet sequence
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+}
+
+func (m *PacketId) Reset()         { *m = PacketId{} }
+func (m *PacketId) String() string { return proto.CompactTextString(m) }
+func (*PacketId) ProtoMessage()    {}
+func (*PacketId) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c3a07336710636a0, []int{5}
+}
+func (m *PacketId) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketId) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketId.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketId) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketId.Merge(m, src)
+}
+func (m *PacketId) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketId) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketId.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketId proto.InternalMessageInfo
+
 // Acknowledgement is the recommended acknowledgement format to be used by
 // app-specific protocols.
 // NOTE: The field numbers 21 and 22 were explicitly chosen to avoid accidental
@@ -367,7 +428,7 @@ func (m *Acknowledgement) Reset()         { *m = Acknowledgement{} }
 func (m *Acknowledgement) String() string { return proto.CompactTextString(m) }
 func (*Acknowledgement) ProtoMessage()    {}
 func (*Acknowledgement) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c3a07336710636a0, []int{5}
+	return fileDescriptor_c3a07336710636a0, []int{6}
 }
 func (m *Acknowledgement) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -449,70 +510,76 @@ func init() {
 	proto.RegisterType((*Counterparty)(nil), "ibc.core.channel.v1.Counterparty")
 	proto.RegisterType((*Packet)(nil), "ibc.core.channel.v1.Packet")
 	proto.RegisterType((*PacketState)(nil), "ibc.core.channel.v1.PacketState")
+	proto.RegisterType((*PacketId)(nil), "ibc.core.channel.v1.PacketId")
 	proto.RegisterType((*Acknowledgement)(nil), "ibc.core.channel.v1.Acknowledgement")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 911 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0xcd, 0x8e, 0xda, 0x56,
-	0x14, 0xc6, 0x83, 0xf9, 0x3b, 0x0c, 0x0c, 0x73, 0xd3, 0x21, 0xae, 0x9b, 0x60, 0x62, 0x75, 0x31,
-	0x4a, 0x15, 0xc8, 0x24, 0x51, 0xab, 0x66, 0xd5, 0xe1, 0x27, 0x1a, 0xab, 0x11, 0x8c, 0x0c, 0xb3,
-	0x68, 0x36, 0x14, 0xec, 0x5b, 0xb0, 0x02, 0xbe, 0xd4, 0xbe, 0x30, 0x9a, 0x37, 0x88, 0x58, 0xf5,
-	0x05, 0x90, 0x2a, 0x55, 0xed, 0x2b, 0xf4, 0x15, 0xb2, 0xcc, 0xb2, 0x2b, 0xab, 0x9a, 0x59, 0x74,
-	0xcf, 0x0b, 0xb4, 0xf2, 0xbd, 0xd7, 0xfc, 0x4c, 0xa2, 0x2c, 0xbb, 0xca, 0x8a, 0x7b, 0xbe, 0xef,
-	0x3b, 0x3f, 0x3e, 0xe7, 0x70, 0x2f, 0x3c, 0x70, 0x06, 0x56, 0xd5, 0x22, 0x1e, 0xae, 0x5a, 0xa3,
-	0xbe, 0xeb, 0xe2, 0x71, 0x75, 0x7e, 0x12, 0x1d, 0x2b, 0x53, 0x8f, 0x50, 0x82, 0xee, 0x38, 0x03,
-	0xab, 0x12, 0x4a, 0x2a, 0x11, 0x3e, 0x3f, 0x51, 0x3f, 0x1b, 0x92, 0x21, 0x61, 0x7c, 0x35, 0x3c,
-	0x71, 0xa9, 0xaa, 0x6d, 0xa2, 0x8d, 0x1d, 0xec, 0x52, 0x16, 0x8c, 0x9d, 0xb8, 0x40, 0xff, 0x7d,
-	0x0f, 0x52, 0x75, 0x1e, 0x05, 0x3d, 0x86, 0x84, 0x4f, 0xfb, 0x14, 0x2b, 0x52, 0x59, 0x3a, 0xce,
-	0x3f, 0x51, 0x2b, 0x1f, 0xc8, 0x53, 0xe9, 0x84, 0x0a, 0x93, 0x0b, 0xd1, 0xd7, 0x90, 0x26, 0x9e,
-	0x8d, 0x3d, 0xc7, 0x1d, 0x2a, 0x7b, 0x1f, 0x71, 0x6a, 0x87, 0x22, 0x73, 0xad, 0x45, 0xdf, 0xc3,
-	0xbe, 0x45, 0x66, 0x2e, 0xc5, 0xde, 0xb4, 0xef, 0xd1, 0x2b, 0x25, 0x5e, 0x96, 0x8e, 0xb3, 0x4f,
-	0x1e, 0x7c, 0xd0, 0xb7, 0xbe, 0x25, 0xac, 0xc9, 0x6f, 0x03, 0x2d, 0x66, 0xee, 0x38, 0xa3, 0x3a,
-	0x1c, 0x58, 0xc4, 0x75, 0xb1, 0x45, 0x1d, 0xe2, 0xf6, 0x46, 0x64, 0xea, 0x2b, 0x72, 0x39, 0x7e,
-	0x9c, 0xa9, 0xa9, 0xab, 0x40, 0x2b, 0x5e, 0xf5, 0x27, 0xe3, 0xe7, 0xfa, 0x2d, 0x81, 0x6e, 0xe6,
-	0x37, 0xc8, 0x19, 0x99, 0xfa, 0x48, 0x81, 0xd4, 0x1c, 0x7b, 0xbe, 0x43, 0x5c, 0x25, 0x51, 0x96,
-	0x8e, 0x33, 0x66, 0x64, 0x3e, 0x97, 0xdf, 0xfc, 0xaa, 0xc5, 0xf4, 0x7f, 0xf6, 0xe0, 0xd0, 0xb0,
-	0xb1, 0x4b, 0x9d, 0x9f, 0x1c, 0x6c, 0x7f, 0xea, 0xd8, 0x47, 0x3a, 0x86, 0xee, 0x42, 0x6a, 0x4a,
-	0x3c, 0xda, 0x73, 0x6c, 0x25, 0xc9, 0x98, 0x64, 0x68, 0x1a, 0x36, 0xba, 0x0f, 0x20, 0xca, 0x0c,
-	0xb9, 0x14, 0xe3, 0x32, 0x02, 0x31, 0x6c, 0xd1, 0xe9, 0x4b, 0xd8, 0xdf, 0xfe, 0x00, 0xf4, 0xd5,
-	0x26, 0x5a, 0xd8, 0xe5, 0x4c, 0x0d, 0xad, 0x02, 0x2d, 0xcf, 0x8b, 0x14, 0x84, 0xbe, 0xce, 0xf0,
-	0x6c, 0x27, 0xc3, 0x1e, 0xd3, 0x1f, 0xad, 0x02, 0xed, 0x50, 0x7c, 0xd4, 0x9a, 0xd3, 0xdf, 0x4f,
-	0xfc, 0x6f, 0x1c, 0x92, 0xe7, 0x7d, 0xeb, 0x35, 0xa6, 0x48, 0x85, 0xb4, 0x8f, 0x7f, 0x9e, 0x61,
-	0xd7, 0xe2, 0xa3, 0x95, 0xcd, 0xb5, 0x8d, 0xbe, 0x81, 0xac, 0x4f, 0x66, 0x9e, 0x85, 0x7b, 0x61,
-	0x4e, 0x91, 0xa3, 0xb8, 0x0a, 0x34, 0xc4, 0x73, 0x6c, 0x91, 0xba, 0x09, 0xdc, 0x3a, 0x27, 0x1e,
-	0x45, 0xdf, 0x41, 0x5e, 0x70, 0x22, 0x33, 0x1b, 0x62, 0xa6, 0xf6, 0xf9, 0x2a, 0xd0, 0x8e, 0x76,
-	0x7c, 0x05, 0xaf, 0x9b, 0x39, 0x0e, 0x44, 0xeb, 0xf6, 0x02, 0x0a, 0x36, 0xf6, 0xa9, 0xe3, 0xf6,
-	0xd9, 0x5c, 0x58, 0x7e, 0x99, 0xc5, 0xf8, 0x62, 0x15, 0x68, 0x77, 0x79, 0x8c, 0xdb, 0x0a, 0xdd,
-	0x3c, 0xd8, 0x82, 0x58, 0x25, 0x6d, 0xb8, 0xb3, 0xad, 0x8a, 0xca, 0x61, 0x63, 0xac, 0x95, 0x56,
-	0x81, 0xa6, 0xbe, 0x1f, 0x6a, 0x5d, 0x13, 0xda, 0x42, 0xa3, 0xc2, 0x10, 0xc8, 0x76, 0x9f, 0xf6,
-	0xd9, 0xb8, 0xf7, 0x4d, 0x76, 0x46, 0x3f, 0x42, 0x9e, 0x3a, 0x13, 0x4c, 0x66, 0xb4, 0x37, 0xc2,
-	0xce, 0x70, 0x44, 0xd9, 0xc0, 0xb3, 0x3b, 0xfb, 0xce, 0x6f, 0xa2, 0xf9, 0x49, 0xe5, 0x8c, 0x29,
-	0x6a, 0xf7, 0xc3, 0x65, 0xdd, 0xb4, 0x63, 0xd7, 0x5f, 0x37, 0x73, 0x02, 0xe0, 0x6a, 0x64, 0xc0,
-	0x61, 0xa4, 0x08, 0x7f, 0x7d, 0xda, 0x9f, 0x4c, 0x95, 0x74, 0x38, 0xae, 0xda, 0xbd, 0x55, 0xa0,
-	0x29, 0xbb, 0x41, 0xd6, 0x12, 0xdd, 0x2c, 0x08, 0xac, 0x1b, 0x41, 0x62, 0x03, 0xfe, 0x90, 0x20,
-	0xcb, 0x37, 0x80, 0xfd, 0x67, 0xff, 0x87, 0xd5, 0xdb, 0xd9, 0xb4, 0xf8, 0xad, 0x4d, 0x8b, 0xba,
-	0x2a, 0x6f, 0xba, 0x2a, 0x0a, 0x6d, 0xc3, 0xc1, 0xa9, 0xf5, 0xda, 0x25, 0x97, 0x63, 0x6c, 0x0f,
-	0xf1, 0x04, 0xbb, 0x14, 0x29, 0x90, 0xf4, 0xb0, 0x3f, 0x1b, 0x53, 0xe5, 0x28, 0x94, 0x9f, 0xc5,
-	0x4c, 0x61, 0xa3, 0x22, 0x24, 0xb0, 0xe7, 0x11, 0x4f, 0x29, 0x86, 0x35, 0x9d, 0xc5, 0x4c, 0x6e,
-	0xd6, 0x00, 0xd2, 0x1e, 0xf6, 0xa7, 0xc4, 0xf5, 0xf1, 0xc3, 0x3f, 0x25, 0x48, 0x74, 0xc4, 0x05,
-	0xa5, 0x75, 0xba, 0xa7, 0xdd, 0x66, 0xef, 0xa2, 0x65, 0xb4, 0x8c, 0xae, 0x71, 0xfa, 0xd2, 0x78,
-	0xd5, 0x6c, 0xf4, 0x2e, 0x5a, 0x9d, 0xf3, 0x66, 0xdd, 0x78, 0x61, 0x34, 0x1b, 0x85, 0x98, 0x7a,
-	0xb8, 0x58, 0x96, 0x73, 0x3b, 0x02, 0xa4, 0x00, 0x70, 0xbf, 0x10, 0x2c, 0x48, 0x6a, 0x7a, 0xb1,
-	0x2c, 0xcb, 0xe1, 0x19, 0x95, 0x20, 0xc7, 0x99, 0xae, 0xf9, 0x43, 0xfb, 0xbc, 0xd9, 0x2a, 0xec,
-	0xa9, 0xd9, 0xc5, 0xb2, 0x9c, 0x12, 0xe6, 0xc6, 0x93, 0x91, 0x71, 0xee, 0xc9, 0x98, 0x7b, 0xb0,
-	0xcf, 0x99, 0xfa, 0xcb, 0x76, 0xa7, 0xd9, 0x28, 0xc8, 0x2a, 0x2c, 0x96, 0xe5, 0x24, 0xb7, 0x54,
-	0xf9, 0xcd, 0x6f, 0xa5, 0xd8, 0xc3, 0x4b, 0x48, 0xb0, 0xbb, 0x12, 0x7d, 0x09, 0xc5, 0xb6, 0xd9,
-	0x68, 0x9a, 0xbd, 0x56, 0xbb, 0xd5, 0xbc, 0x55, 0x2f, 0x0b, 0x19, 0xe2, 0x48, 0x87, 0x03, 0xae,
-	0xba, 0x68, 0xb1, 0xdf, 0x66, 0xa3, 0x20, 0xa9, 0xb9, 0xc5, 0xb2, 0x9c, 0x59, 0x03, 0x61, 0xc1,
-	0x5c, 0x13, 0x29, 0x44, 0xc1, 0xc2, 0xe4, 0x89, 0x6b, 0x9d, 0xb7, 0xd7, 0x25, 0xe9, 0xdd, 0x75,
-	0x49, 0xfa, 0xfb, 0xba, 0x24, 0xfd, 0x72, 0x53, 0x8a, 0xbd, 0xbb, 0x29, 0xc5, 0xfe, 0xba, 0x29,
-	0xc5, 0x5e, 0x7d, 0x3b, 0x74, 0xe8, 0x68, 0x36, 0xa8, 0x58, 0x64, 0x52, 0xb5, 0x88, 0x3f, 0x21,
-	0x7e, 0xd5, 0x19, 0x58, 0x8f, 0x86, 0xa4, 0x3a, 0x7f, 0x5a, 0x9d, 0x10, 0x7b, 0x36, 0xc6, 0x3e,
-	0x7f, 0x94, 0x1f, 0x3f, 0x7b, 0x14, 0xbd, 0xf2, 0xf4, 0x6a, 0x8a, 0xfd, 0x41, 0x92, 0xbd, 0xca,
-	0x4f, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xa8, 0x31, 0x31, 0xe5, 0x06, 0x08, 0x00, 0x00,
+	// 990 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x15, 0x25, 0x4a, 0x96, 0xae, 0x6c, 0x99, 0x9e, 0x7c, 0x76, 0x18, 0x26, 0x11, 0x19, 0x22,
+	0xf8, 0x60, 0xa4, 0x88, 0x14, 0x27, 0x41, 0x8b, 0x7a, 0x55, 0xcb, 0x62, 0x6a, 0xa2, 0xae, 0x64,
+	0x50, 0x32, 0x8a, 0x66, 0xa3, 0x4a, 0xe4, 0x54, 0x22, 0x22, 0x71, 0x54, 0x72, 0xa4, 0xc0, 0x0f,
+	0x50, 0x20, 0xd0, 0xa6, 0x7d, 0x01, 0x01, 0x05, 0x8a, 0xf6, 0x05, 0xfa, 0x12, 0x59, 0x66, 0xd9,
+	0x95, 0x50, 0xd8, 0x8b, 0xee, 0xf5, 0x02, 0x2d, 0x38, 0x43, 0xea, 0xc7, 0x31, 0xb2, 0x6c, 0x37,
+	0x5d, 0x71, 0xee, 0x39, 0xe7, 0xfe, 0xcc, 0xbd, 0x57, 0x14, 0xe1, 0x81, 0xdb, 0xb1, 0xcb, 0x36,
+	0xf1, 0x71, 0xd9, 0xee, 0xb5, 0x3d, 0x0f, 0xf7, 0xcb, 0xe3, 0x83, 0xf8, 0x58, 0x1a, 0xfa, 0x84,
+	0x12, 0x74, 0xcb, 0xed, 0xd8, 0xa5, 0x50, 0x52, 0x8a, 0xf1, 0xf1, 0x81, 0xf2, 0xbf, 0x2e, 0xe9,
+	0x12, 0xc6, 0x97, 0xc3, 0x13, 0x97, 0x2a, 0xea, 0x32, 0x5a, 0xdf, 0xc5, 0x1e, 0x65, 0xc1, 0xd8,
+	0x89, 0x0b, 0xf4, 0x5f, 0x92, 0xb0, 0x71, 0xcc, 0xa3, 0xa0, 0x27, 0x90, 0x0e, 0x68, 0x9b, 0x62,
+	0x59, 0xd0, 0x84, 0xfd, 0xc2, 0x53, 0xa5, 0x74, 0x43, 0x9e, 0x52, 0x23, 0x54, 0x58, 0x5c, 0x88,
+	0x3e, 0x86, 0x2c, 0xf1, 0x1d, 0xec, 0xbb, 0x5e, 0x57, 0x4e, 0x7e, 0xc0, 0xa9, 0x1e, 0x8a, 0xac,
+	0x85, 0x16, 0x7d, 0x01, 0x9b, 0x36, 0x19, 0x79, 0x14, 0xfb, 0xc3, 0xb6, 0x4f, 0x2f, 0xe4, 0x94,
+	0x26, 0xec, 0xe7, 0x9f, 0x3e, 0xb8, 0xd1, 0xf7, 0x78, 0x45, 0x58, 0x11, 0xdf, 0xce, 0xd4, 0x84,
+	0xb5, 0xe6, 0x8c, 0x8e, 0x61, 0xdb, 0x26, 0x9e, 0x87, 0x6d, 0xea, 0x12, 0xaf, 0xd5, 0x23, 0xc3,
+	0x40, 0x16, 0xb5, 0xd4, 0x7e, 0xae, 0xa2, 0xcc, 0x67, 0xea, 0xde, 0x45, 0x7b, 0xd0, 0x3f, 0xd4,
+	0xaf, 0x09, 0x74, 0xab, 0xb0, 0x44, 0x4e, 0xc8, 0x30, 0x40, 0x32, 0x6c, 0x8c, 0xb1, 0x1f, 0xb8,
+	0xc4, 0x93, 0xd3, 0x9a, 0xb0, 0x9f, 0xb3, 0x62, 0xf3, 0x50, 0x7c, 0xf3, 0x93, 0x9a, 0xd0, 0xff,
+	0x4c, 0xc2, 0x8e, 0xe9, 0x60, 0x8f, 0xba, 0xdf, 0xba, 0xd8, 0xf9, 0xaf, 0x63, 0x1f, 0xe8, 0x18,
+	0xba, 0x0d, 0x1b, 0x43, 0xe2, 0xd3, 0x96, 0xeb, 0xc8, 0x19, 0xc6, 0x64, 0x42, 0xd3, 0x74, 0xd0,
+	0x7d, 0x80, 0xa8, 0xcc, 0x90, 0xdb, 0x60, 0x5c, 0x2e, 0x42, 0x4c, 0x27, 0xea, 0xf4, 0x6b, 0xd8,
+	0x5c, 0xbd, 0x00, 0xfa, 0x68, 0x19, 0x2d, 0xec, 0x72, 0xae, 0x82, 0xe6, 0x33, 0xb5, 0xc0, 0x8b,
+	0x8c, 0x08, 0x7d, 0x91, 0xe1, 0xf9, 0x5a, 0x86, 0x24, 0xd3, 0xef, 0xce, 0x67, 0xea, 0x4e, 0x74,
+	0xa9, 0x05, 0xa7, 0xbf, 0x9f, 0xf8, 0xaf, 0x14, 0x64, 0xce, 0xda, 0xf6, 0x2b, 0x4c, 0x91, 0x02,
+	0xd9, 0x00, 0x7f, 0x37, 0xc2, 0x9e, 0xcd, 0x47, 0x2b, 0x5a, 0x0b, 0x1b, 0x7d, 0x02, 0xf9, 0x80,
+	0x8c, 0x7c, 0x1b, 0xb7, 0xc2, 0x9c, 0x51, 0x8e, 0xbd, 0xf9, 0x4c, 0x45, 0x3c, 0xc7, 0x0a, 0xa9,
+	0x5b, 0xc0, 0xad, 0x33, 0xe2, 0x53, 0xf4, 0x19, 0x14, 0x22, 0x2e, 0xca, 0xcc, 0x86, 0x98, 0xab,
+	0xdc, 0x99, 0xcf, 0xd4, 0xdd, 0x35, 0xdf, 0x88, 0xd7, 0xad, 0x2d, 0x0e, 0xc4, 0xeb, 0xf6, 0x02,
+	0x24, 0x07, 0x07, 0xd4, 0xf5, 0xda, 0x6c, 0x2e, 0x2c, 0xbf, 0xc8, 0x62, 0xdc, 0x9d, 0xcf, 0xd4,
+	0xdb, 0x3c, 0xc6, 0x75, 0x85, 0x6e, 0x6d, 0xaf, 0x40, 0xac, 0x92, 0x3a, 0xdc, 0x5a, 0x55, 0xc5,
+	0xe5, 0xb0, 0x31, 0x56, 0x8a, 0xf3, 0x99, 0xaa, 0xbc, 0x1f, 0x6a, 0x51, 0x13, 0x5a, 0x41, 0xe3,
+	0xc2, 0x10, 0x88, 0x4e, 0x9b, 0xb6, 0xd9, 0xb8, 0x37, 0x2d, 0x76, 0x46, 0xdf, 0x40, 0x81, 0xba,
+	0x03, 0x4c, 0x46, 0xb4, 0xd5, 0xc3, 0x6e, 0xb7, 0x47, 0xd9, 0xc0, 0xf3, 0x6b, 0xfb, 0xce, 0xdf,
+	0x44, 0xe3, 0x83, 0xd2, 0x09, 0x53, 0x54, 0xee, 0x87, 0xcb, 0xba, 0x6c, 0xc7, 0xba, 0xbf, 0x6e,
+	0x6d, 0x45, 0x00, 0x57, 0x23, 0x13, 0x76, 0x62, 0x45, 0xf8, 0x0c, 0x68, 0x7b, 0x30, 0x94, 0xb3,
+	0xe1, 0xb8, 0x2a, 0xf7, 0xe6, 0x33, 0x55, 0x5e, 0x0f, 0xb2, 0x90, 0xe8, 0x96, 0x14, 0x61, 0xcd,
+	0x18, 0x8a, 0x36, 0xe0, 0x57, 0x01, 0xf2, 0x7c, 0x03, 0xd8, 0x6f, 0xf6, 0x1f, 0x58, 0xbd, 0xb5,
+	0x4d, 0x4b, 0x5d, 0xdb, 0xb4, 0xb8, 0xab, 0xe2, 0xb2, 0xab, 0x51, 0xa1, 0x3f, 0x08, 0x90, 0xe5,
+	0x85, 0x9a, 0xce, 0xbf, 0x5c, 0x65, 0x54, 0x51, 0x1d, 0xb6, 0x8f, 0xec, 0x57, 0x1e, 0x79, 0xdd,
+	0xc7, 0x4e, 0x17, 0x0f, 0xb0, 0x47, 0x91, 0x0c, 0x19, 0x1f, 0x07, 0xa3, 0x3e, 0x95, 0x77, 0xc3,
+	0x0b, 0x9c, 0x24, 0xac, 0xc8, 0x46, 0x7b, 0x90, 0xc6, 0xbe, 0x4f, 0x7c, 0x79, 0x2f, 0xcc, 0x7f,
+	0x92, 0xb0, 0xb8, 0x59, 0x01, 0xc8, 0xfa, 0x38, 0x18, 0x12, 0x2f, 0xc0, 0x8f, 0xbe, 0x4f, 0x42,
+	0xba, 0x11, 0xbd, 0x32, 0xd5, 0x46, 0xf3, 0xa8, 0x69, 0xb4, 0xce, 0x6b, 0x66, 0xcd, 0x6c, 0x9a,
+	0x47, 0xa7, 0xe6, 0x4b, 0xa3, 0xda, 0x3a, 0xaf, 0x35, 0xce, 0x8c, 0x63, 0xf3, 0x85, 0x69, 0x54,
+	0xa5, 0x84, 0xb2, 0x33, 0x99, 0x6a, 0x5b, 0x6b, 0x02, 0x24, 0x03, 0x70, 0xbf, 0x10, 0x94, 0x04,
+	0x25, 0x3b, 0x99, 0x6a, 0x62, 0x78, 0x46, 0x45, 0xd8, 0xe2, 0x4c, 0xd3, 0xfa, 0xba, 0x7e, 0x66,
+	0xd4, 0xa4, 0xa4, 0x92, 0x9f, 0x4c, 0xb5, 0x8d, 0xc8, 0x5c, 0x7a, 0x32, 0x32, 0xc5, 0x3d, 0x19,
+	0x73, 0x0f, 0x36, 0x39, 0x73, 0x7c, 0x5a, 0x6f, 0x18, 0x55, 0x49, 0x54, 0x60, 0x32, 0xd5, 0x32,
+	0xdc, 0x42, 0xff, 0x87, 0x9d, 0x65, 0xc6, 0xf3, 0xb3, 0xcf, 0xad, 0xa3, 0xaa, 0x21, 0xa5, 0x95,
+	0xed, 0xc9, 0x54, 0xcb, 0xaf, 0x40, 0xe8, 0x21, 0x48, 0x8b, 0xfc, 0xb1, 0x2c, 0xa3, 0x14, 0x26,
+	0x53, 0x0d, 0x96, 0x88, 0x22, 0xbe, 0xf9, 0xb9, 0x98, 0x78, 0xf4, 0x9b, 0x00, 0x69, 0xf6, 0x67,
+	0x80, 0x1e, 0xc2, 0x5e, 0xdd, 0xaa, 0x1a, 0x56, 0xab, 0x56, 0xaf, 0x19, 0xd7, 0xae, 0xcf, 0x2a,
+	0x0c, 0x71, 0xa4, 0xc3, 0x36, 0x57, 0x9d, 0xd7, 0xd8, 0xd3, 0xa8, 0x4a, 0x82, 0xb2, 0x35, 0x99,
+	0x6a, 0xb9, 0x05, 0x10, 0xde, 0x9f, 0x6b, 0x62, 0x45, 0x74, 0xff, 0x98, 0x3f, 0x84, 0xbb, 0x6b,
+	0x7c, 0xeb, 0xe8, 0xf4, 0xb4, 0xfe, 0x55, 0xab, 0x69, 0x7e, 0x69, 0xd4, 0xcf, 0x9b, 0x52, 0x4a,
+	0xb9, 0x33, 0x99, 0x6a, 0xbb, 0x37, 0x92, 0xbc, 0xea, 0x4a, 0xe3, 0xed, 0x65, 0x51, 0x78, 0x77,
+	0x59, 0x14, 0xfe, 0xb8, 0x2c, 0x0a, 0x3f, 0x5e, 0x15, 0x13, 0xef, 0xae, 0x8a, 0x89, 0xdf, 0xaf,
+	0x8a, 0x89, 0x97, 0x9f, 0x76, 0x5d, 0xda, 0x1b, 0x75, 0x4a, 0x36, 0x19, 0x94, 0x6d, 0x12, 0x0c,
+	0x48, 0x50, 0x76, 0x3b, 0xf6, 0xe3, 0x2e, 0x29, 0x8f, 0x9f, 0x95, 0x07, 0xc4, 0x19, 0xf5, 0x71,
+	0xc0, 0xbf, 0x58, 0x9e, 0x3c, 0x7f, 0x1c, 0x7f, 0x02, 0xd1, 0x8b, 0x21, 0x0e, 0x3a, 0x19, 0xf6,
+	0xc9, 0xf2, 0xec, 0xef, 0x01, 0x00, 0x00, 0xeb, 0xe6, 0xf5, 0x23, 0x09, 0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -811,6 +878,48 @@ func (m *PacketState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketId) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketId) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketId) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *Acknowledgement) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1028,6 +1137,26 @@ func (m *PacketState) Size() (n int) {
 	return n
 }
 
+func (m *PacketId) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovChannel(uint64(m.Sequence))
+	}
+	return n
+}
+
 func (m *Acknowledgement) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2067,6 +2196,139 @@ func (m *PacketState) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketId) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowChannel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketId: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketId: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipChannel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Acknowledgement) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -17,8 +17,11 @@ func TestChannelValidateBasic(t *testing.T) {
 	}{
 		{"valid channel", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, connHops, version), true},
 		{"invalid state", types.NewChannel(types.UNINITIALIZED, types.ORDERED, counterparty, connHops, version), false},
+		{"valid ordered allow timeout channel", types.NewChannel(types.TRYOPEN, types.ORDERED_ALLOW_TIMEOUT, counterparty, connHops, version), true},
 		{"invalid order", types.NewChannel(types.TRYOPEN, types.NONE, counterparty, connHops, version), false},
-		{"more than 1 connection hop", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"connection1", "connection2"}, version), false},
+		{"valid multi-hop channel", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"connection1", "connection2"}, version), true},
+		{"empty connection hops", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{}, version), false},
+		{"invalid multi-hop connection identifier", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"connection1", "(invalid)"}, version), false},
 		{"invalid connection hop identifier", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"(invalid)"}, version), false},
 		{"invalid counterparty", types.NewChannel(types.TRYOPEN, types.ORDERED, types.NewCounterparty("(invalidport)", "channelidone"), connHops, version), false},
 	}
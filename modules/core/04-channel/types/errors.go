@@ -38,4 +38,14 @@ var (
 	ErrNoOpMsg = sdkerrors.Register(SubModuleName, 23, "message is redundant, no-op will be performed")
 
 	ErrInvalidChannelVersion = sdkerrors.Register(SubModuleName, 24, "invalid channel version")
+
+	// channel upgrade errors
+	ErrInvalidUpgrade           = sdkerrors.Register(SubModuleName, 25, "invalid channel upgrade")
+	ErrUpgradeNotFound          = sdkerrors.Register(SubModuleName, 26, "channel upgrade not found")
+	ErrInvalidUpgradeTimeout    = sdkerrors.Register(SubModuleName, 27, "invalid channel upgrade timeout")
+	ErrUpgradeTimeoutNotReached = sdkerrors.Register(SubModuleName, 28, "channel upgrade timeout has not been reached")
+	ErrPacketsInFlight          = sdkerrors.Register(SubModuleName, 29, "channel has packets in flight")
+
+	// Record a timeout receipt instead of receiving the current Msg
+	ErrTimeoutReceiptWritten = sdkerrors.Register(SubModuleName, 30, "packet timed out, timeout receipt written instead of receiving the packet")
 )
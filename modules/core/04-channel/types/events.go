@@ -13,6 +13,9 @@ const (
 	AttributeKeyChannelID          = "channel_id"
 	AttributeCounterpartyPortID    = "counterparty_port_id"
 	AttributeCounterpartyChannelID = "counterparty_channel_id"
+	AttributeKeyChannelState       = "channel_state"
+	AttributeKeyOrdering           = "ordering"
+	AttributeKeyVersion            = "version"
 
 	EventTypeSendPacket           = "send_packet"
 	EventTypeRecvPacket           = "recv_packet"
@@ -20,6 +23,7 @@ const (
 	EventTypeAcknowledgePacket    = "acknowledge_packet"
 	EventTypeTimeoutPacket        = "timeout_packet"
 	EventTypeTimeoutPacketOnClose = "timeout_on_close_packet"
+	EventTypeWriteTimeoutReceipt  = "write_timeout_receipt"
 
 	// NOTE: DEPRECATED in favor of AttributeKeyDataHex
 	AttributeKeyData = "packet_data"
@@ -37,6 +41,9 @@ const (
 	AttributeKeyDstChannel       = "packet_dst_channel"
 	AttributeKeyChannelOrdering  = "packet_channel_ordering"
 	AttributeKeyConnection       = "packet_connection"
+
+	AttributeKeyRecvStartSequence    = "recv_start_sequence"
+	AttributeKeyPruningSequenceStart = "pruning_sequence_start"
 )
 
 // IBC channel events vars
@@ -48,5 +55,13 @@ var (
 	EventTypeChannelCloseInit    = "channel_close_init"
 	EventTypeChannelCloseConfirm = "channel_close_confirm"
 
+	EventTypeChannelUpgradeInit    = "channel_upgrade_init"
+	EventTypeChannelUpgradeTry     = "channel_upgrade_try"
+	EventTypeChannelUpgradeAck     = "channel_upgrade_ack"
+	EventTypeChannelUpgradeConfirm = "channel_upgrade_confirm"
+	EventTypeChannelUpgradeAbort   = "channel_upgrade_abort"
+
+	EventTypePruneAcknowledgements = "prune_acknowledgements"
+
 	AttributeValueCategory = fmt.Sprintf("%s_%s", host.ModuleName, SubModuleName)
 )
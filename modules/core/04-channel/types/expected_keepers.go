@@ -5,6 +5,7 @@ import (
 	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
 
 	connectiontypes "github.com/cosmos/ibc-go/v3/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
 	"github.com/cosmos/ibc-go/v3/modules/core/exported"
 )
 
@@ -61,6 +62,16 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketReceipt(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyNextSequenceRecv(
 		ctx sdk.Context,
 		connection exported.ConnectionI,
@@ -70,6 +81,45 @@ type ConnectionKeeper interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyNextSequenceAck(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
+	VerifyMultihopMembership(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		path commitmenttypes.MerklePath,
+		value []byte,
+	) error
+	VerifyMultihopNonMembership(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		path commitmenttypes.MerklePath,
+	) error
+	GetMultihopCounterpartyConsensusState(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+	) (exported.ConsensusState, exported.Height, error)
+	GetMultihopCounterpartyConnectionHops(
+		ctx sdk.Context,
+		connection exported.ConnectionI,
+		proof []byte,
+		connectionHops []string,
+	) ([]string, error)
 }
 
 // PortKeeper expected account IBC port keeper
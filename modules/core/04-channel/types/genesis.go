@@ -60,14 +60,17 @@ func NewGenesisState(
 // DefaultGenesisState returns the ibc channel submodule's default genesis state.
 func DefaultGenesisState() GenesisState {
 	return GenesisState{
-		Channels:            []IdentifiedChannel{},
-		Acknowledgements:    []PacketState{},
-		Receipts:            []PacketState{},
-		Commitments:         []PacketState{},
-		SendSequences:       []PacketSequence{},
-		RecvSequences:       []PacketSequence{},
-		AckSequences:        []PacketSequence{},
-		NextChannelSequence: 0,
+		Channels:              []IdentifiedChannel{},
+		Acknowledgements:      []PacketState{},
+		Receipts:              []PacketState{},
+		Commitments:           []PacketState{},
+		SendSequences:         []PacketSequence{},
+		RecvSequences:         []PacketSequence{},
+		AckSequences:          []PacketSequence{},
+		NextChannelSequence:   0,
+		Upgrades:              []IdentifiedUpgrade{},
+		RecvStartSequences:    []PacketSequence{},
+		PruningSequenceStarts: []PacketSequence{},
 	}
 }
 
@@ -139,6 +142,24 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for i, rs := range gs.RecvStartSequences {
+		if err := rs.Validate(); err != nil {
+			return fmt.Errorf("invalid receive start sequence %v index %d: %w", rs, i, err)
+		}
+	}
+
+	for i, ps := range gs.PruningSequenceStarts {
+		if err := ps.Validate(); err != nil {
+			return fmt.Errorf("invalid pruning start sequence %v index %d: %w", ps, i, err)
+		}
+	}
+
+	for i, upgrade := range gs.Upgrades {
+		if err := upgrade.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid upgrade %v index %d: %w", upgrade, i, err)
+		}
+	}
+
 	return nil
 }
 
@@ -34,6 +34,14 @@ type GenesisState struct {
 	AckSequences     []PacketSequence    `protobuf:"bytes,7,rep,name=ack_sequences,json=ackSequences,proto3" json:"ack_sequences" yaml:"ack_sequences"`
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty" yaml:"next_channel_sequence"`
+	// the channel upgrades which are in progress
+	Upgrades []IdentifiedUpgrade `protobuf:"bytes,9,rep,name=upgrades,proto3" json:"upgrades"`
+	// the sequences below which packets can no longer be received on UNORDERED
+	// channels
+	RecvStartSequences []PacketSequence `protobuf:"bytes,10,rep,name=recv_start_sequences,json=recvStartSequences,proto3" json:"recv_start_sequences" yaml:"recv_start_sequences"`
+	// the next sequences whose packet receipts and acknowledgements will be
+	// pruned on UNORDERED channels
+	PruningSequenceStarts []PacketSequence `protobuf:"bytes,11,rep,name=pruning_sequence_starts,json=pruningSequenceStarts,proto3" json:"pruning_sequence_starts" yaml:"pruning_sequence_starts"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +133,27 @@ func (m *GenesisState) GetNextChannelSequence() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetUpgrades() []IdentifiedUpgrade {
+	if m != nil {
+		return m.Upgrades
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecvStartSequences() []PacketSequence {
+	if m != nil {
+		return m.RecvStartSequences
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPruningSequenceStarts() []PacketSequence {
+	if m != nil {
+		return m.PruningSequenceStarts
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,39 +224,45 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 506 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0x87, 0xe3, 0x36, 0x4d, 0xd3, 0x6d, 0x13, 0xd1, 0x6d, 0x23, 0x99, 0xa8, 0xd8, 0xc6, 0x48,
-	0x28, 0x12, 0xaa, 0x4d, 0x69, 0x2f, 0x70, 0x34, 0x07, 0xc8, 0x0d, 0x6d, 0x39, 0x21, 0xa1, 0xc8,
-	0x5e, 0x4f, 0xdd, 0x55, 0x62, 0x6f, 0xf0, 0x6e, 0x02, 0x7d, 0x0a, 0x78, 0xac, 0x1e, 0x7b, 0xe4,
-	0x64, 0xa1, 0xe4, 0x0d, 0x72, 0xe4, 0x84, 0xfc, 0x37, 0x89, 0x1a, 0x21, 0xca, 0xcd, 0x3b, 0xf3,
-	0x9b, 0xef, 0x9b, 0x83, 0x07, 0x3d, 0x65, 0x1e, 0xb5, 0x29, 0x8f, 0xc1, 0xa6, 0xd7, 0x6e, 0x14,
-	0xc1, 0xc8, 0x9e, 0x9e, 0xd9, 0x01, 0x44, 0x20, 0x98, 0xb0, 0xc6, 0x31, 0x97, 0x1c, 0x1f, 0x31,
-	0x8f, 0x5a, 0x69, 0xc4, 0x2a, 0x22, 0xd6, 0xf4, 0xac, 0x7b, 0x1c, 0xf0, 0x80, 0x67, 0x7d, 0x3b,
-	0xfd, 0xca, 0xa3, 0xdd, 0x8d, 0xb4, 0x72, 0x2a, 0x8b, 0x98, 0xf3, 0x1d, 0x74, 0xf0, 0x2e, 0xe7,
-	0x5f, 0x4a, 0x57, 0x02, 0xfe, 0x8c, 0x9a, 0x45, 0x42, 0xa8, 0x8a, 0xb1, 0xdd, 0xdb, 0x7f, 0xf5,
-	0xdc, 0xda, 0x60, 0xb4, 0xfa, 0x3e, 0x44, 0x92, 0x5d, 0x31, 0xf0, 0xdf, 0xe6, 0x45, 0xe7, 0xf1,
-	0x6d, 0xa2, 0xd7, 0x7e, 0x27, 0xfa, 0xe1, 0xbd, 0x16, 0xa9, 0x90, 0x98, 0xa0, 0x47, 0x2e, 0x1d,
-	0x46, 0xfc, 0xeb, 0x08, 0xfc, 0x00, 0x42, 0x88, 0xa4, 0x50, 0xb7, 0x32, 0x8d, 0xb1, 0x51, 0xf3,
-	0xc1, 0xa5, 0x43, 0x90, 0xd9, 0x6a, 0x4e, 0x3d, 0x15, 0x90, 0x7b, 0xf3, 0xf8, 0x3d, 0xda, 0xa7,
-	0x3c, 0x0c, 0x99, 0xcc, 0x71, 0xdb, 0x0f, 0xc2, 0xad, 0x8e, 0x62, 0x07, 0x35, 0x63, 0xa0, 0xc0,
-	0xc6, 0x52, 0xa8, 0xf5, 0x07, 0x61, 0xaa, 0x39, 0xcc, 0x50, 0x5b, 0x40, 0xe4, 0x0f, 0x04, 0x7c,
-	0x99, 0x40, 0x44, 0x41, 0xa8, 0x3b, 0x19, 0xe9, 0xd9, 0xdf, 0x48, 0x45, 0xd6, 0x79, 0x92, 0xc2,
-	0x16, 0x89, 0xde, 0xb9, 0x71, 0xc3, 0xd1, 0x1b, 0x73, 0x1d, 0x64, 0x92, 0x56, 0x5a, 0x28, 0xc3,
-	0x99, 0x2a, 0x06, 0x3a, 0x5d, 0x51, 0x35, 0xfe, 0x5b, 0xb5, 0x0e, 0x32, 0x49, 0x2b, 0x2d, 0x2c,
-	0x55, 0x57, 0xa8, 0xe5, 0xd2, 0xe1, 0x8a, 0x69, 0xf7, 0xdf, 0x4d, 0x27, 0x85, 0xe9, 0x38, 0x37,
-	0xad, 0x71, 0x4c, 0x72, 0xe0, 0xd2, 0xe1, 0xd2, 0xf3, 0x11, 0x75, 0x22, 0xf8, 0x26, 0x07, 0x05,
-	0xad, 0x0a, 0xaa, 0x4d, 0x43, 0xe9, 0xd5, 0x1d, 0x63, 0x91, 0xe8, 0x27, 0x39, 0x66, 0x63, 0xcc,
-	0x24, 0x47, 0x69, 0xbd, 0xf8, 0xef, 0x4a, 0xac, 0xf9, 0x5d, 0x41, 0xed, 0xf5, 0xa5, 0xf0, 0x0b,
-	0xb4, 0x3b, 0xe6, 0xb1, 0x1c, 0x30, 0x5f, 0x55, 0x0c, 0xa5, 0xb7, 0xe7, 0xe0, 0x45, 0xa2, 0xb7,
-	0x73, 0x74, 0xd1, 0x30, 0x49, 0x23, 0xfd, 0xea, 0xfb, 0xf8, 0x02, 0xa1, 0xd2, 0xc4, 0x7c, 0x75,
-	0x2b, 0xcb, 0x77, 0x16, 0x89, 0x7e, 0x98, 0xe7, 0x97, 0x3d, 0x93, 0xec, 0x15, 0x8f, 0xbe, 0x8f,
-	0xbb, 0xa8, 0x59, 0xad, 0xbf, 0x9d, 0xae, 0x4f, 0xaa, 0xb7, 0x73, 0x79, 0x3b, 0xd3, 0x94, 0xbb,
-	0x99, 0xa6, 0xfc, 0x9a, 0x69, 0xca, 0x8f, 0xb9, 0x56, 0xbb, 0x9b, 0x6b, 0xb5, 0x9f, 0x73, 0xad,
-	0xf6, 0xe9, 0x75, 0xc0, 0xe4, 0xf5, 0xc4, 0xb3, 0x28, 0x0f, 0x6d, 0xca, 0x45, 0xc8, 0x85, 0xcd,
-	0x3c, 0x7a, 0x1a, 0x70, 0x7b, 0x7a, 0x6e, 0x87, 0xdc, 0x9f, 0x8c, 0x40, 0xe4, 0x47, 0xfd, 0xf2,
-	0xe2, 0xb4, 0xbc, 0x6b, 0x79, 0x33, 0x06, 0xe1, 0x35, 0xb2, 0x9b, 0x3e, 0xff, 0x13, 0x00, 0x00,
-	0xff, 0xff, 0x29, 0xa1, 0xc4, 0xd2, 0x46, 0x04, 0x00, 0x00,
+	// 601 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0xb6, 0x5f, 0xeb, 0x4e, 0xff, 0xe8, 0xeb, 0xb4, 0x11, 0xa6, 0x14, 0x3b, 0xb8,
+	0x52, 0x55, 0x09, 0xd5, 0xa6, 0xb4, 0x1b, 0x58, 0x9a, 0x05, 0xed, 0x0e, 0x4d, 0x60, 0x83, 0x84,
+	0x22, 0x67, 0x3c, 0x75, 0x47, 0x89, 0x3d, 0xc6, 0x33, 0x09, 0x94, 0x2d, 0x0f, 0x00, 0x6f, 0xc2,
+	0x6b, 0x74, 0xd9, 0x25, 0x2b, 0x0b, 0x25, 0x6f, 0x90, 0x25, 0x2b, 0x64, 0xcf, 0xd8, 0x49, 0xd4,
+	0x50, 0xa5, 0xec, 0xec, 0x7b, 0xcf, 0xfd, 0x9d, 0xd1, 0x99, 0xab, 0x01, 0x4f, 0x68, 0x1b, 0xbb,
+	0x98, 0xa5, 0xc4, 0xc5, 0x97, 0x7e, 0x1c, 0x93, 0xae, 0xdb, 0x3f, 0x76, 0x43, 0x12, 0x13, 0x4e,
+	0xb9, 0x93, 0xa4, 0x4c, 0x30, 0xb8, 0x4d, 0xdb, 0xd8, 0xc9, 0x25, 0x8e, 0x92, 0x38, 0xfd, 0xe3,
+	0xdd, 0x9d, 0x90, 0x85, 0xac, 0xe8, 0xbb, 0xf9, 0x97, 0x94, 0xee, 0xce, 0xa4, 0x95, 0x53, 0x77,
+	0x48, 0x7a, 0x49, 0x98, 0xfa, 0x01, 0x91, 0x12, 0xfb, 0x87, 0x0e, 0xd6, 0x5f, 0xcb, 0x23, 0x34,
+	0x85, 0x2f, 0x08, 0xfc, 0x00, 0x74, 0x25, 0xe6, 0x86, 0xd6, 0x58, 0x3c, 0x5c, 0x7b, 0x7e, 0xe0,
+	0xcc, 0x38, 0x94, 0x73, 0x1e, 0x90, 0x58, 0xd0, 0x0b, 0x4a, 0x82, 0x57, 0xb2, 0xe8, 0x3d, 0xbc,
+	0xce, 0xac, 0xda, 0xef, 0xcc, 0xda, 0xba, 0xd5, 0x42, 0x15, 0x12, 0x22, 0xf0, 0xbf, 0x8f, 0x3b,
+	0x31, 0xfb, 0xd4, 0x25, 0x41, 0x48, 0x22, 0x12, 0x0b, 0x6e, 0x2c, 0x14, 0x36, 0x8d, 0x99, 0x36,
+	0x6f, 0x7c, 0xdc, 0x21, 0xa2, 0x38, 0x9a, 0xb7, 0x94, 0x1b, 0xa0, 0x5b, 0xf3, 0xf0, 0x0c, 0xac,
+	0x61, 0x16, 0x45, 0x54, 0x48, 0xdc, 0xe2, 0xbd, 0x70, 0x93, 0xa3, 0xd0, 0x03, 0x7a, 0x4a, 0x30,
+	0xa1, 0x89, 0xe0, 0xc6, 0xd2, 0xbd, 0x30, 0xd5, 0x1c, 0xa4, 0x60, 0x93, 0x93, 0x38, 0x68, 0x71,
+	0xf2, 0xb1, 0x47, 0x62, 0x4c, 0xb8, 0xf1, 0x5f, 0x41, 0xda, 0xbf, 0x8b, 0xa4, 0xb4, 0xde, 0xe3,
+	0x1c, 0x36, 0xca, 0xac, 0xfa, 0x95, 0x1f, 0x75, 0x5f, 0xda, 0xd3, 0x20, 0x1b, 0x6d, 0xe4, 0x85,
+	0x52, 0x5c, 0x58, 0xa5, 0x04, 0xf7, 0x27, 0xac, 0x96, 0xff, 0xd9, 0x6a, 0x1a, 0x64, 0xa3, 0x8d,
+	0xbc, 0x30, 0xb6, 0xba, 0x00, 0x1b, 0x3e, 0xee, 0x4c, 0x38, 0xad, 0xcc, 0xef, 0xb4, 0xa7, 0x9c,
+	0x76, 0xa4, 0xd3, 0x14, 0xc7, 0x46, 0xeb, 0x3e, 0xee, 0x8c, 0x7d, 0xde, 0x82, 0x7a, 0x4c, 0x3e,
+	0x8b, 0x96, 0xa2, 0x55, 0x42, 0x43, 0x6f, 0x68, 0x87, 0x4b, 0x5e, 0x63, 0x94, 0x59, 0x7b, 0x12,
+	0x33, 0x53, 0x66, 0xa3, 0xed, 0xbc, 0xae, 0xf6, 0xae, 0xc4, 0xc2, 0x33, 0xa0, 0xab, 0xb5, 0xe7,
+	0xc6, 0xea, 0x5c, 0x4b, 0xfd, 0x4e, 0xca, 0xcb, 0xdb, 0x2d, 0xa7, 0xe1, 0x17, 0xb0, 0x23, 0x93,
+	0x12, 0x7e, 0x2a, 0x26, 0xe2, 0x00, 0xf3, 0xc7, 0xb1, 0xaf, 0xe2, 0x78, 0x34, 0x19, 0xfc, 0x34,
+	0xce, 0x46, 0xb0, 0x88, 0x3f, 0xaf, 0x8e, 0xb3, 0xf9, 0xaa, 0x81, 0x07, 0x49, 0xda, 0x8b, 0x69,
+	0x1c, 0x56, 0x52, 0x39, 0xc9, 0x8d, 0xb5, 0xf9, 0xfd, 0x0f, 0x94, 0xbf, 0x29, 0xfd, 0xff, 0x42,
+	0xb4, 0x51, 0x5d, 0x75, 0xca, 0xc1, 0xa6, 0xac, 0x7f, 0xd3, 0xc0, 0xe6, 0x34, 0x11, 0x3e, 0x05,
+	0x2b, 0x09, 0x4b, 0x45, 0x8b, 0x06, 0x86, 0xd6, 0xd0, 0x0e, 0x57, 0x3d, 0x38, 0xca, 0xac, 0x4d,
+	0x85, 0x97, 0x0d, 0x1b, 0x2d, 0xe7, 0x5f, 0xe7, 0x01, 0x3c, 0x05, 0xa0, 0xbc, 0x35, 0x1a, 0x18,
+	0x0b, 0x85, 0xbe, 0x3e, 0xca, 0xac, 0x2d, 0xa9, 0x1f, 0xf7, 0x6c, 0xb4, 0xaa, 0x7e, 0xce, 0x03,
+	0xb8, 0x0b, 0xf4, 0x6a, 0x15, 0x16, 0xf3, 0x55, 0x40, 0xd5, 0xbf, 0xd7, 0xbc, 0x1e, 0x98, 0xda,
+	0xcd, 0xc0, 0xd4, 0x7e, 0x0d, 0x4c, 0xed, 0xfb, 0xd0, 0xac, 0xdd, 0x0c, 0xcd, 0xda, 0xcf, 0xa1,
+	0x59, 0x7b, 0xff, 0x22, 0xa4, 0xe2, 0xb2, 0xd7, 0x76, 0x30, 0x8b, 0x5c, 0xcc, 0x78, 0xc4, 0xb8,
+	0x4b, 0xdb, 0xf8, 0x28, 0x64, 0x6e, 0xff, 0xc4, 0x8d, 0x58, 0xd0, 0xeb, 0x12, 0x2e, 0x1f, 0xc8,
+	0x67, 0xa7, 0x47, 0xe5, 0x1b, 0x29, 0xae, 0x12, 0xc2, 0xdb, 0xcb, 0xc5, 0xfb, 0x78, 0xf2, 0x67,
+	0x00, 0x93, 0xa7, 0x82, 0x63, 0xb5, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -250,6 +285,48 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PruningSequenceStarts) > 0 {
+		for iNdEx := len(m.PruningSequenceStarts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PruningSequenceStarts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RecvStartSequences) > 0 {
+		for iNdEx := len(m.RecvStartSequences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecvStartSequences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Upgrades) > 0 {
+		for iNdEx := len(m.Upgrades) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Upgrades[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextChannelSequence != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextChannelSequence))
 		i--
@@ -460,6 +537,24 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextChannelSequence != 0 {
 		n += 1 + sovGenesis(uint64(m.NextChannelSequence))
 	}
+	if len(m.Upgrades) > 0 {
+		for _, e := range m.Upgrades {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecvStartSequences) > 0 {
+		for _, e := range m.RecvStartSequences {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PruningSequenceStarts) > 0 {
+		for _, e := range m.PruningSequenceStarts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -775,6 +870,108 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrades", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Upgrades = append(m.Upgrades, IdentifiedUpgrade{})
+			if err := m.Upgrades[len(m.Upgrades)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvStartSequences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecvStartSequences = append(m.RecvStartSequences, PacketSequence{})
+			if err := m.RecvStartSequences[len(m.RecvStartSequences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningSequenceStarts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PruningSequenceStarts = append(m.PruningSequenceStarts, PacketSequence{})
+			if err := m.PruningSequenceStarts[len(m.PruningSequenceStarts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -137,6 +137,36 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "valid recv start seq and pruning seq start",
+			genState: types.GenesisState{
+				RecvStartSequences: []types.PacketSequence{
+					types.NewPacketSequence(testPort2, testChannel2, 5),
+				},
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence(testPort2, testChannel2, 3),
+				},
+			},
+			expPass: true,
+		},
+		{
+			name: "invalid recv start seq",
+			genState: types.GenesisState{
+				RecvStartSequences: []types.PacketSequence{
+					types.NewPacketSequence(testPort2, "(testChannel2)", 5),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid pruning seq start",
+			genState: types.GenesisState{
+				PruningSequenceStarts: []types.PacketSequence{
+					types.NewPacketSequence("(testPort2)", testChannel2, 3),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
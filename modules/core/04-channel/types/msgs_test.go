@@ -63,7 +63,7 @@ var (
 	emptyAddr string
 
 	connHops             = []string{"testconnection"}
-	invalidConnHops      = []string{"testconnection", "testconnection"}
+	multihopConnHops     = []string{"testconnection", "testconnection"}
 	invalidShortConnHops = []string{invalidShortConnection}
 	invalidLongConnHops  = []string{invalidLongConnection}
 )
@@ -118,8 +118,9 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		{"too short port id", types.NewMsgChannelOpenInit(invalidShortPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"too long port id", types.NewMsgChannelOpenInit(invalidLongPort, version, types.ORDERED, connHops, cpportid, addr), false},
 		{"port id contains non-alpha", types.NewMsgChannelOpenInit(invalidPort, version, types.ORDERED, connHops, cpportid, addr), false},
-		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(3), connHops, cpportid, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr), false},
+		{"invalid channel order", types.NewMsgChannelOpenInit(portid, version, types.Order(4), connHops, cpportid, addr), false},
+		{"ordered allow timeout channel order", types.NewMsgChannelOpenInit(portid, version, types.ORDERED_ALLOW_TIMEOUT, connHops, cpportid, addr), true},
+		{"multi-hop connection hops", types.NewMsgChannelOpenInit(portid, version, types.ORDERED, multihopConnHops, cpportid, addr), true},
 		{"too short connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidShortConnHops, cpportid, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, invalidLongConnHops, cpportid, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenInit(portid, version, types.UNORDERED, []string{invalidConnection}, cpportid, addr), false},
@@ -161,7 +162,7 @@ func (suite *TypesTestSuite) TestMsgChannelOpenTryValidateBasic() {
 		{"", types.NewMsgChannelOpenTry(portid, chanid, version, types.ORDERED, connHops, cpportid, cpchanid, "", suite.proof, height, addr), true},
 		{"proof height is zero", types.NewMsgChannelOpenTry(portid, chanid, version, types.ORDERED, connHops, cpportid, cpchanid, version, suite.proof, clienttypes.ZeroHeight(), addr), false},
 		{"invalid channel order", types.NewMsgChannelOpenTry(portid, chanid, version, types.Order(4), connHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
-		{"connection hops more than 1 ", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, invalidConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
+		{"multi-hop connection hops", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, multihopConnHops, cpportid, cpchanid, version, suite.proof, height, addr), true},
 		{"too short connection id", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, invalidShortConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"too long connection id", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, invalidLongConnHops, cpportid, cpchanid, version, suite.proof, height, addr), false},
 		{"connection id contains non-alpha", types.NewMsgChannelOpenTry(portid, chanid, version, types.UNORDERED, []string{invalidConnection}, cpportid, cpchanid, version, suite.proof, height, addr), false},
@@ -438,3 +439,210 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementValidateBasic() {
 		})
 	}
 }
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeInitValidateBasic() {
+	upgradeTimeout := types.NewUpgradeTimeout(timeoutHeight, 0)
+
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeInit
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeInit(portid, chanid, types.UNORDERED, connHops, version, upgradeTimeout, addr), true},
+		{"timeout timestamp only", types.NewMsgChannelUpgradeInit(portid, chanid, types.UNORDERED, connHops, version, types.NewUpgradeTimeout(disabledTimeout, timeoutTimestamp), addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeInit(invalidShortPort, chanid, types.UNORDERED, connHops, version, upgradeTimeout, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeInit(portid, invalidChannel, types.UNORDERED, connHops, version, upgradeTimeout, addr), false},
+		{"invalid channel order", types.NewMsgChannelUpgradeInit(portid, chanid, types.Order(4), connHops, version, upgradeTimeout, addr), false},
+		{"ordered allow timeout channel order", types.NewMsgChannelUpgradeInit(portid, chanid, types.ORDERED_ALLOW_TIMEOUT, connHops, version, upgradeTimeout, addr), true},
+		{"connection hops more than 1 ", types.NewMsgChannelUpgradeInit(portid, chanid, types.UNORDERED, multihopConnHops, version, upgradeTimeout, addr), false},
+		{"too short connection id", types.NewMsgChannelUpgradeInit(portid, chanid, types.UNORDERED, invalidShortConnHops, version, upgradeTimeout, addr), false},
+		{"timeout height and timestamp are zero", types.NewMsgChannelUpgradeInit(portid, chanid, types.UNORDERED, connHops, version, types.NewUpgradeTimeout(disabledTimeout, 0), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTryValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTry
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeTry(invalidShortPort, chanid, types.UNORDERED, connHops, version, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeTry(portid, invalidChannel, types.UNORDERED, connHops, version, suite.proof, height, addr), false},
+		{"invalid channel order", types.NewMsgChannelUpgradeTry(portid, chanid, types.NONE, connHops, version, suite.proof, height, addr), false},
+		{"connection hops more than 1 ", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, multihopConnHops, version, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTry(portid, chanid, types.UNORDERED, connHops, version, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeAckValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeAck
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeAck(portid, chanid, version, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeAck(invalidShortPort, chanid, version, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeAck(portid, invalidChannel, version, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeAck(portid, chanid, version, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeAck(portid, chanid, version, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeConfirmValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeConfirm
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeConfirm(portid, chanid, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeConfirm(invalidShortPort, chanid, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeConfirm(portid, invalidChannel, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeConfirm(portid, chanid, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeConfirm(portid, chanid, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeTimeoutValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeTimeout
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeTimeout(portid, chanid, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeTimeout(invalidShortPort, chanid, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeTimeout(portid, invalidChannel, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeTimeout(portid, chanid, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeTimeout(portid, chanid, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgChannelUpgradeCancelValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgChannelUpgradeCancel
+		expPass bool
+	}{
+		{"", types.NewMsgChannelUpgradeCancel(portid, chanid, suite.proof, height, addr), true},
+		{"too short port id", types.NewMsgChannelUpgradeCancel(invalidShortPort, chanid, suite.proof, height, addr), false},
+		{"channel id contains non-alpha", types.NewMsgChannelUpgradeCancel(portid, invalidChannel, suite.proof, height, addr), false},
+		{"empty proof", types.NewMsgChannelUpgradeCancel(portid, chanid, emptyProof, height, addr), false},
+		{"proof height is zero", types.NewMsgChannelUpgradeCancel(portid, chanid, suite.proof, clienttypes.ZeroHeight(), addr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
+	testCases := []struct {
+		name    string
+		msg     *types.MsgPruneAcknowledgements
+		expPass bool
+	}{
+		{"success", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 100, addr), true},
+		{"success: next sequence ack is not proven", types.NewMsgPruneAcknowledgements(portid, chanid, 0, nil, clienttypes.ZeroHeight(), 100, addr), true},
+		{"too short port id", types.NewMsgPruneAcknowledgements(invalidShortPort, chanid, 10, suite.proof, height, 100, addr), false},
+		{"channel id contains non-alpha", types.NewMsgPruneAcknowledgements(portid, invalidChannel, 10, suite.proof, height, 100, addr), false},
+		{"empty proof", types.NewMsgPruneAcknowledgements(portid, chanid, 10, emptyProof, height, 100, addr), false},
+		{"proof height is zero", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, clienttypes.ZeroHeight(), 100, addr), false},
+		{"limit is zero", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 0, addr), false},
+		{"missing signer address", types.NewMsgPruneAcknowledgements(portid, chanid, 10, suite.proof, height, 100, emptyAddr), false},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
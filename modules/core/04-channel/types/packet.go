@@ -40,6 +40,11 @@ func CommitAcknowledgement(data []byte) []byte {
 	return hash[:]
 }
 
+// TimeoutReceipt is the packet receipt written for a packet which timed out
+// before it was received on an ORDERED_ALLOW_TIMEOUT channel. It is distinct
+// from the packet receipt written for received packets on UNORDERED channels.
+var TimeoutReceipt = []byte{byte(2)}
+
 var _ exported.PacketI = (*Packet)(nil)
 
 // NewPacket creates a new Packet instance. It panics if the provided
@@ -111,3 +116,26 @@ func (p Packet) ValidateBasic() error {
 	}
 	return nil
 }
+
+// NewPacketId returns a new instance of PacketId
+func NewPacketId(portID, channelID string, seq uint64) PacketId {
+	return PacketId{
+		PortId:    portID,
+		ChannelId: channelID,
+		Sequence:  seq,
+	}
+}
+
+// Validate performs basic validation of the PacketId fields
+func (p PacketId) Validate() error {
+	if err := host.PortIdentifierValidator(p.PortId); err != nil {
+		return sdkerrors.Wrap(err, "invalid port ID")
+	}
+	if err := host.ChannelIdentifierValidator(p.ChannelId); err != nil {
+		return sdkerrors.Wrap(err, "invalid channel ID")
+	}
+	if p.Sequence == 0 {
+		return sdkerrors.Wrap(ErrInvalidPacket, "packet sequence cannot be 0")
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+package types
+
+import (
+	"fmt"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// NewUpgradeTimeout creates a new UpgradeTimeout instance.
+func NewUpgradeTimeout(timeoutHeight clienttypes.Height, timeoutTimestamp uint64) UpgradeTimeout {
+	return UpgradeTimeout{
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+	}
+}
+
+// ValidateBasic returns an error if neither the timeout height nor the timeout
+// timestamp is set.
+func (t UpgradeTimeout) ValidateBasic() error {
+	if t.TimeoutHeight.IsZero() && t.TimeoutTimestamp == 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgradeTimeout, "timeout height and timeout timestamp cannot both be 0")
+	}
+	return nil
+}
+
+// HasPassed returns true if the upgrade timeout has been reached on the
+// counterparty chain at the provided height and timestamp.
+func (t UpgradeTimeout) HasPassed(height exported.Height, timestamp uint64) bool {
+	if !t.TimeoutHeight.IsZero() && height.GTE(t.TimeoutHeight) {
+		return true
+	}
+	return t.TimeoutTimestamp != 0 && timestamp >= t.TimeoutTimestamp
+}
+
+// NewUpgrade creates a new Upgrade instance.
+func NewUpgrade(restoreChannel Channel, timeout UpgradeTimeout, counterpartyInitHeight clienttypes.Height) Upgrade {
+	return Upgrade{
+		RestoreChannel:         restoreChannel,
+		Timeout:                timeout,
+		CounterpartyInitHeight: counterpartyInitHeight,
+	}
+}
+
+// ValidateBasic performs a basic validation of the upgrade fields.
+func (u Upgrade) ValidateBasic() error {
+	if u.RestoreChannel.State != OPEN {
+		return sdkerrors.Wrapf(ErrInvalidChannelState, "restore channel must be OPEN (got %s)", u.RestoreChannel.State)
+	}
+	if err := u.RestoreChannel.ValidateBasic(); err != nil {
+		return sdkerrors.Wrap(err, "invalid restore channel")
+	}
+
+	// exactly one of the timeout and the counterparty init height is set,
+	// depending on which end of the channel initialized the upgrade
+	if u.CounterpartyInitHeight.IsZero() {
+		return u.Timeout.ValidateBasic()
+	}
+	if !u.Timeout.TimeoutHeight.IsZero() || u.Timeout.TimeoutTimestamp != 0 {
+		return sdkerrors.Wrap(ErrInvalidUpgrade, "timeout must be empty if the counterparty init height is set")
+	}
+	return nil
+}
+
+// NewIdentifiedUpgrade creates a new IdentifiedUpgrade instance.
+func NewIdentifiedUpgrade(portID, channelID string, upgrade Upgrade) IdentifiedUpgrade {
+	return IdentifiedUpgrade{
+		PortId:    portID,
+		ChannelId: channelID,
+		Upgrade:   upgrade,
+	}
+}
+
+// ValidateBasic performs a basic validation of the identifiers and the upgrade.
+func (u IdentifiedUpgrade) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(u.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(u.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	return u.Upgrade.ValidateBasic()
+}
+
+// ValidateUpgradeOrdering returns an error if a channel with the current ordering
+// cannot be upgraded to the proposed ordering. The ordering of a channel may only
+// stay the same or be relaxed from ORDERED to ORDERED_ALLOW_TIMEOUT or UNORDERED,
+// and from ORDERED_ALLOW_TIMEOUT to UNORDERED, since packets on an UNORDERED
+// channel may have been received out of order and the receive sequence can
+// therefore not be used to enforce an ordering, and packets on an
+// ORDERED_ALLOW_TIMEOUT channel may have been skipped.
+func ValidateUpgradeOrdering(current, proposed Order) error {
+	if current == proposed {
+		return nil
+	}
+	if current == ORDERED && (proposed == UNORDERED || proposed == ORDERED_ALLOW_TIMEOUT) {
+		return nil
+	}
+	if current == ORDERED_ALLOW_TIMEOUT && proposed == UNORDERED {
+		return nil
+	}
+	return sdkerrors.Wrapf(ErrInvalidChannelOrdering, "cannot upgrade channel ordering from %s to %s", current, proposed)
+}
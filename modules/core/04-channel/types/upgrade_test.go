@@ -0,0 +1,95 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+)
+
+func TestUpgradeTimeoutHasPassed(t *testing.T) {
+	testCases := []struct {
+		name      string
+		timeout   types.UpgradeTimeout
+		height    clienttypes.Height
+		timestamp uint64
+		expPassed bool
+	}{
+		{"timeout height reached", types.NewUpgradeTimeout(clienttypes.NewHeight(0, 10), 0), clienttypes.NewHeight(0, 10), 0, true},
+		{"timeout height not reached", types.NewUpgradeTimeout(clienttypes.NewHeight(0, 10), 0), clienttypes.NewHeight(0, 9), 100, false},
+		{"timeout timestamp reached", types.NewUpgradeTimeout(clienttypes.ZeroHeight(), 100), clienttypes.NewHeight(0, 1), 100, true},
+		{"timeout timestamp not reached", types.NewUpgradeTimeout(clienttypes.ZeroHeight(), 100), clienttypes.NewHeight(0, 100), 99, false},
+		{"timeout height reached before timestamp", types.NewUpgradeTimeout(clienttypes.NewHeight(0, 10), 100), clienttypes.NewHeight(0, 10), 99, true},
+		{"timeout timestamp reached before height", types.NewUpgradeTimeout(clienttypes.NewHeight(0, 10), 100), clienttypes.NewHeight(0, 9), 100, true},
+	}
+
+	for _, tc := range testCases {
+		require.Equal(t, tc.expPassed, tc.timeout.HasPassed(tc.height, tc.timestamp), tc.name)
+	}
+}
+
+func TestUpgradeValidateBasic(t *testing.T) {
+	counterparty := types.NewCounterparty(testPort2, testChannel2)
+	openChannel := types.NewChannel(types.OPEN, testChannelOrder, counterparty, []string{testConnectionIDA}, testChannelVersion)
+	timeout := types.NewUpgradeTimeout(clienttypes.NewHeight(0, 100), 0)
+
+	testCases := []struct {
+		name    string
+		upgrade types.Upgrade
+		expPass bool
+	}{
+		{"valid initialized upgrade", types.NewUpgrade(openChannel, timeout, clienttypes.ZeroHeight()), true},
+		{"valid accepted upgrade", types.NewUpgrade(openChannel, types.UpgradeTimeout{}, clienttypes.NewHeight(0, 10)), true},
+		{"restore channel is not OPEN", types.NewUpgrade(types.NewChannel(types.INITUPGRADE, testChannelOrder, counterparty, []string{testConnectionIDA}, testChannelVersion), timeout, clienttypes.ZeroHeight()), false},
+		{"invalid restore channel", types.NewUpgrade(types.NewChannel(types.OPEN, testChannelOrder, counterparty, []string{}, testChannelVersion), timeout, clienttypes.ZeroHeight()), false},
+		{"timeout and counterparty init height are empty", types.NewUpgrade(openChannel, types.UpgradeTimeout{}, clienttypes.ZeroHeight()), false},
+		{"timeout and counterparty init height are both set", types.NewUpgrade(openChannel, timeout, clienttypes.NewHeight(0, 10)), false},
+	}
+
+	for _, tc := range testCases {
+		err := tc.upgrade.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+
+		genState := types.DefaultGenesisState()
+		genState.Upgrades = []types.IdentifiedUpgrade{types.NewIdentifiedUpgrade(testPort1, testChannel1, tc.upgrade)}
+		err = genState.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestValidateUpgradeOrdering(t *testing.T) {
+	testCases := []struct {
+		name     string
+		current  types.Order
+		proposed types.Order
+		expPass  bool
+	}{
+		{"ORDERED to ORDERED", types.ORDERED, types.ORDERED, true},
+		{"UNORDERED to UNORDERED", types.UNORDERED, types.UNORDERED, true},
+		{"ORDERED to UNORDERED", types.ORDERED, types.UNORDERED, true},
+		{"UNORDERED to ORDERED", types.UNORDERED, types.ORDERED, false},
+		{"ORDERED to ORDERED_ALLOW_TIMEOUT", types.ORDERED, types.ORDERED_ALLOW_TIMEOUT, true},
+		{"ORDERED_ALLOW_TIMEOUT to UNORDERED", types.ORDERED_ALLOW_TIMEOUT, types.UNORDERED, true},
+		{"ORDERED_ALLOW_TIMEOUT to ORDERED", types.ORDERED_ALLOW_TIMEOUT, types.ORDERED, false},
+		{"UNORDERED to ORDERED_ALLOW_TIMEOUT", types.UNORDERED, types.ORDERED_ALLOW_TIMEOUT, false},
+	}
+
+	for _, tc := range testCases {
+		err := types.ValidateUpgradeOrdering(tc.current, tc.proposed)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -74,6 +74,56 @@ type IBCModule interface {
 		channelID string,
 	) error
 
+	// OnChanUpgradeInit will verify that the proposed ordering, connection hops and
+	// version of a channel upgrade are valid and return the version to propose to the
+	// counterparty. It may return an error to abort the upgrade. The callback must not
+	// modify application state, since the upgrade may still be aborted.
+	OnChanUpgradeInit(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	) (string, error)
+
+	// OnChanUpgradeTry will verify the relayer-chosen ordering and connection hops
+	// along with the counterparty-proposed version of a channel upgrade. If the
+	// upgrade is acceptable, the callback must select the upgraded version string
+	// and return it to core IBC. Otherwise it must return an error to reject the
+	// upgrade. The callback must not modify application state.
+	OnChanUpgradeTry(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		counterpartyVersion string,
+	) (version string, err error)
+
+	// OnChanUpgradeAck will error if the counterparty selected version string is
+	// invalid, in which case the upgrade is aborted and the channel is restored.
+	// The callback must not modify application state.
+	OnChanUpgradeAck(
+		ctx sdk.Context,
+		portID,
+		channelID,
+		counterpartyVersion string,
+	) error
+
+	// OnChanUpgradeOpen is called once the upgrade of the channel end has
+	// completed. The application may migrate any state associated with the channel
+	// to the upgraded ordering, connection hops and version. The callback cannot
+	// abort the upgrade, since the counterparty may have already completed it.
+	OnChanUpgradeOpen(
+		ctx sdk.Context,
+		portID,
+		channelID string,
+		order channeltypes.Order,
+		connectionHops []string,
+		version string,
+	)
+
 	// OnRecvPacket must return an acknowledgement that implements the Acknowledgement interface.
 	// In the case of an asynchronous acknowledgement, nil should be returned.
 	// If the acknowledgement returned is successful, the state changes on callback are written,
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+
+	ics23 "github.com/confio/ics23/go"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// ICS 033 Multi-hop Proof Types Implementation
+//
+// This file defines the proofs used to verify state of the counterparty chain
+// of a channel over multiple connection hops.
+
+// VerifyMembership verifies that the proof proves the given value at the given
+// prefixed path against the provided root.
+func (p MultihopProof) VerifyMembership(specs []*ics23.ProofSpec, root exported.Root, path MerklePath, value []byte) error {
+	if err := p.validatePath(path); err != nil {
+		return err
+	}
+
+	if !bytes.Equal(p.Value, value) {
+		return sdkerrors.Wrapf(ErrInvalidProof, "proof value %X does not match expected value %X", p.Value, value)
+	}
+
+	var merkleProof MerkleProof
+	if err := proto.Unmarshal(p.Proof, &merkleProof); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	}
+
+	return merkleProof.VerifyMembership(specs, root, path, value)
+}
+
+// VerifyNonMembership verifies that the proof proves the absence of a value at
+// the given prefixed path against the provided root.
+func (p MultihopProof) VerifyNonMembership(specs []*ics23.ProofSpec, root exported.Root, path MerklePath) error {
+	if err := p.validatePath(path); err != nil {
+		return err
+	}
+
+	if len(p.Value) != 0 {
+		return sdkerrors.Wrap(ErrInvalidProof, "value must be empty in non-membership proof")
+	}
+
+	var merkleProof MerkleProof
+	if err := proto.Unmarshal(p.Proof, &merkleProof); err != nil {
+		return sdkerrors.Wrap(ErrInvalidProof, "failed to unmarshal proof into commitment merkle proof")
+	}
+
+	return merkleProof.VerifyNonMembership(specs, root, path)
+}
+
+// validatePath returns an error if the prefixed key of the proof is not equal to
+// the given path.
+func (p MultihopProof) validatePath(path MerklePath) error {
+	if p.PrefixedKey == nil || !proto.Equal(p.PrefixedKey, &path) {
+		return sdkerrors.Wrapf(ErrInvalidProof, "proof key %s does not match expected key %s", p.PrefixedKey, path)
+	}
+	return nil
+}
+
+// ValidateBasic checks that the key proof is set and that a consensus proof
+// exists for every connection proof.
+func (p MultihopProofs) ValidateBasic() error {
+	if p.KeyProof == nil || len(p.KeyProof.Proof) == 0 {
+		return sdkerrors.Wrap(ErrInvalidProof, "key proof cannot be empty")
+	}
+
+	if len(p.ConnectionProofs) != len(p.ConsensusProofs) {
+		return sdkerrors.Wrapf(
+			ErrInvalidProof, "number of connection proofs (%d) must equal the number of consensus proofs (%d)",
+			len(p.ConnectionProofs), len(p.ConsensusProofs),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,761 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/commitment/v1/multihop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultihopProof defines a proof of a value stored at a key on a chain along a
+// multi-hop channel path.
+type MultihopProof struct {
+	// merkle proof of the value
+	Proof []byte `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	// value stored at the prefixed key
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// key path of the value, including the key prefix of the chain
+	PrefixedKey *MerklePath `protobuf:"bytes,3,opt,name=prefixed_key,json=prefixedKey,proto3" json:"prefixed_key,omitempty" yaml:"prefixed_key"`
+}
+
+func (m *MultihopProof) Reset()         { *m = MultihopProof{} }
+func (m *MultihopProof) String() string { return proto.CompactTextString(m) }
+func (*MultihopProof) ProtoMessage()    {}
+func (*MultihopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e44fa2c51f2daaa, []int{0}
+}
+func (m *MultihopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultihopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultihopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultihopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultihopProof.Merge(m, src)
+}
+func (m *MultihopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultihopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultihopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultihopProof proto.InternalMessageInfo
+
+func (m *MultihopProof) GetProof() []byte {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+func (m *MultihopProof) GetValue() []byte {
+	if m != nil {
+		return m.Value
+	}
+	return nil
+}
+
+func (m *MultihopProof) GetPrefixedKey() *MerklePath {
+	if m != nil {
+		return m.PrefixedKey
+	}
+	return nil
+}
+
+// MultihopProofs defines the proofs required to verify a key on the counterparty
+// chain of a multi-hop channel. The connection and consensus proofs are ordered
+// from the chain following the first connection hop to the chain preceding the
+// counterparty chain. Each consensus proof proves the consensus state of the next
+// chain on the path, and the key proof is verified against the consensus state of
+// the counterparty chain.
+type MultihopProofs struct {
+	KeyProof         *MultihopProof  `protobuf:"bytes,1,opt,name=key_proof,json=keyProof,proto3" json:"key_proof,omitempty" yaml:"key_proof"`
+	ConnectionProofs []MultihopProof `protobuf:"bytes,2,rep,name=connection_proofs,json=connectionProofs,proto3" json:"connection_proofs" yaml:"connection_proofs"`
+	ConsensusProofs  []MultihopProof `protobuf:"bytes,3,rep,name=consensus_proofs,json=consensusProofs,proto3" json:"consensus_proofs" yaml:"consensus_proofs"`
+}
+
+func (m *MultihopProofs) Reset()         { *m = MultihopProofs{} }
+func (m *MultihopProofs) String() string { return proto.CompactTextString(m) }
+func (*MultihopProofs) ProtoMessage()    {}
+func (*MultihopProofs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9e44fa2c51f2daaa, []int{1}
+}
+func (m *MultihopProofs) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultihopProofs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultihopProofs.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultihopProofs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultihopProofs.Merge(m, src)
+}
+func (m *MultihopProofs) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultihopProofs) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultihopProofs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultihopProofs proto.InternalMessageInfo
+
+func (m *MultihopProofs) GetKeyProof() *MultihopProof {
+	if m != nil {
+		return m.KeyProof
+	}
+	return nil
+}
+
+func (m *MultihopProofs) GetConnectionProofs() []MultihopProof {
+	if m != nil {
+		return m.ConnectionProofs
+	}
+	return nil
+}
+
+func (m *MultihopProofs) GetConsensusProofs() []MultihopProof {
+	if m != nil {
+		return m.ConsensusProofs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultihopProof)(nil), "ibc.core.commitment.v1.MultihopProof")
+	proto.RegisterType((*MultihopProofs)(nil), "ibc.core.commitment.v1.MultihopProofs")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/commitment/v1/multihop.proto", fileDescriptor_9e44fa2c51f2daaa)
+}
+
+var fileDescriptor_9e44fa2c51f2daaa = []byte{
+	// 393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
+	0x14, 0xc6, 0x93, 0x96, 0x2b, 0x3a, 0xbd, 0xea, 0x35, 0x16, 0x1b, 0xba, 0x48, 0xc2, 0x40, 0xb1,
+	0x9b, 0x66, 0x68, 0xbb, 0x13, 0x57, 0xdd, 0x4a, 0xa1, 0x64, 0x21, 0xe2, 0xc2, 0xd2, 0x4c, 0xa7,
+	0xe9, 0x90, 0x3f, 0x13, 0x33, 0x93, 0x60, 0xde, 0xc2, 0xbd, 0x2f, 0xd4, 0x65, 0xc1, 0x8d, 0xab,
+	0x20, 0xed, 0x1b, 0xf4, 0x09, 0x24, 0x99, 0xfe, 0x49, 0xd1, 0x82, 0x77, 0x37, 0xe7, 0x9b, 0xef,
+	0x7c, 0xbf, 0x73, 0xe0, 0x80, 0x1e, 0x75, 0x31, 0xc2, 0x2c, 0x21, 0x08, 0xb3, 0x30, 0xa4, 0x22,
+	0x24, 0x91, 0x40, 0xd9, 0x10, 0x85, 0x69, 0x20, 0xe8, 0x9a, 0xc5, 0x76, 0x9c, 0x30, 0xc1, 0xb4,
+	0x37, 0xd4, 0xc5, 0x76, 0x69, 0xb3, 0x2f, 0x36, 0x3b, 0x1b, 0x76, 0xdb, 0x1e, 0xf3, 0x58, 0x65,
+	0x41, 0xe5, 0x4b, 0xba, 0xbb, 0x6f, 0x6f, 0x84, 0xd6, 0x7a, 0x2b, 0x23, 0xfc, 0xa1, 0x82, 0xe7,
+	0xd3, 0x23, 0x69, 0x96, 0x30, 0xb6, 0xd2, 0xda, 0xe0, 0x2e, 0x2e, 0x1f, 0xba, 0x6a, 0xa9, 0xfd,
+	0x7b, 0xe7, 0x2e, 0x3e, 0xa9, 0xd9, 0x22, 0x48, 0x89, 0xde, 0x90, 0x6a, 0x55, 0x68, 0x5f, 0xc0,
+	0x7d, 0x9c, 0x90, 0x15, 0xfd, 0x46, 0x96, 0x73, 0x9f, 0xe4, 0x7a, 0xd3, 0x52, 0xfb, 0xad, 0x11,
+	0xb4, 0xff, 0x3d, 0xab, 0x3d, 0x25, 0x89, 0x1f, 0x90, 0xd9, 0x42, 0xac, 0x27, 0x9d, 0x43, 0x61,
+	0xbe, 0xce, 0x17, 0x61, 0xf0, 0x0e, 0xd6, 0x13, 0xa0, 0xd3, 0x3a, 0x95, 0x1f, 0x48, 0x0e, 0x7f,
+	0x36, 0xc0, 0x8b, 0xab, 0xe9, 0xb8, 0xf6, 0x09, 0x3c, 0xf3, 0x49, 0x3e, 0xbf, 0x8c, 0xd8, 0x1a,
+	0xf5, 0x6e, 0xf2, 0xea, 0xad, 0x93, 0xf6, 0xa1, 0x30, 0x1f, 0x24, 0xf2, 0x9c, 0x00, 0x9d, 0xa7,
+	0x3e, 0xc9, 0xe5, 0xe2, 0x02, 0xbc, 0xc2, 0x2c, 0x8a, 0x08, 0x16, 0x94, 0x45, 0xf2, 0x9b, 0xeb,
+	0x0d, 0xab, 0xf9, 0xff, 0x04, 0x6b, 0x53, 0x98, 0xca, 0xa1, 0x30, 0x75, 0x49, 0xf9, 0x2b, 0x0d,
+	0x3a, 0x0f, 0x17, 0xed, 0xb8, 0xcf, 0x57, 0x50, 0x6a, 0x9c, 0x44, 0x3c, 0xe5, 0x27, 0x68, 0xf3,
+	0x31, 0x50, 0xf3, 0x08, 0xed, 0x9c, 0xa1, 0x57, 0x61, 0xd0, 0x79, 0x79, 0x96, 0x24, 0x72, 0xf2,
+	0x71, 0xb3, 0x33, 0xd4, 0xed, 0xce, 0x50, 0x7f, 0xef, 0x0c, 0xf5, 0xfb, 0xde, 0x50, 0xb6, 0x7b,
+	0x43, 0xf9, 0xb5, 0x37, 0x94, 0xcf, 0xef, 0x3d, 0x2a, 0xd6, 0xa9, 0x5b, 0xf2, 0x10, 0x66, 0x3c,
+	0x64, 0x1c, 0x51, 0x17, 0x0f, 0x3c, 0x86, 0xb2, 0x31, 0x0a, 0xd9, 0x32, 0x0d, 0x08, 0x97, 0x67,
+	0x35, 0x1a, 0x0f, 0x6a, 0x97, 0x25, 0xf2, 0x98, 0x70, 0xf7, 0x49, 0x75, 0x52, 0xe3, 0x3f, 0x03,
+	0x00, 0xb8, 0x79, 0x40, 0xe2, 0xd2, 0x02, 0x00, 0x00,
+}
+
+func (m *MultihopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultihopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultihopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PrefixedKey != nil {
+		{
+			size, err := m.PrefixedKey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultihop(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proof) > 0 {
+		i -= len(m.Proof)
+		copy(dAtA[i:], m.Proof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.Proof)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MultihopProofs) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultihopProofs) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultihopProofs) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusProofs) > 0 {
+		for iNdEx := len(m.ConsensusProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConsensusProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultihop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ConnectionProofs) > 0 {
+		for iNdEx := len(m.ConnectionProofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ConnectionProofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultihop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.KeyProof != nil {
+		{
+			size, err := m.KeyProof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMultihop(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultihop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultihop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultihopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	if m.PrefixedKey != nil {
+		l = m.PrefixedKey.Size()
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	return n
+}
+
+func (m *MultihopProofs) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyProof != nil {
+		l = m.KeyProof.Size()
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	if len(m.ConnectionProofs) > 0 {
+		for _, e := range m.ConnectionProofs {
+			l = e.Size()
+			n += 1 + l + sovMultihop(uint64(l))
+		}
+	}
+	if len(m.ConsensusProofs) > 0 {
+		for _, e := range m.ConsensusProofs {
+			l = e.Size()
+			n += 1 + l + sovMultihop(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMultihop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultihop(x uint64) (n int) {
+	return sovMultihop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultihopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultihopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultihopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = append(m.Value[:0], dAtA[iNdEx:postIndex]...)
+			if m.Value == nil {
+				m.Value = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrefixedKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PrefixedKey == nil {
+				m.PrefixedKey = &MerklePath{}
+			}
+			if err := m.PrefixedKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MultihopProofs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultihopProofs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultihopProofs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyProof", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.KeyProof == nil {
+				m.KeyProof = &MultihopProof{}
+			}
+			if err := m.KeyProof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionProofs = append(m.ConnectionProofs, MultihopProof{})
+			if err := m.ConnectionProofs[len(m.ConnectionProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusProofs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusProofs = append(m.ConsensusProofs, MultihopProof{})
+			if err := m.ConsensusProofs[len(m.ConsensusProofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultihop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultihop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultihop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultihop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultihop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultihop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultihop = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,78 @@
+package types_test
+
+import (
+	"fmt"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+)
+
+func (suite *MerkleTestSuite) TestMultihopProofVerifyMembership() {
+	suite.iavlStore.Set([]byte("MYKEY"), []byte("MYVALUE"))
+	cid := suite.store.Commit()
+
+	res := suite.store.Query(abci.RequestQuery{
+		Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+		Data:  []byte("MYKEY"),
+		Prove: true,
+	})
+
+	merkleProof, err := types.ConvertProofs(res.ProofOps)
+	suite.Require().NoError(err)
+	proofBz, err := merkleProof.Marshal()
+	suite.Require().NoError(err)
+
+	path := types.NewMerklePath(suite.storeKey.Name(), "MYKEY")
+
+	cases := []struct {
+		name       string
+		proof      types.MultihopProof
+		value      []byte
+		shouldPass bool
+	}{
+		{"valid proof", types.MultihopProof{Proof: proofBz, Value: []byte("MYVALUE"), PrefixedKey: &path}, []byte("MYVALUE"), true},
+		{"value does not match the proof value", types.MultihopProof{Proof: proofBz, Value: []byte("MYVALUE"), PrefixedKey: &path}, []byte("WRONGVALUE"), false},
+		{"wrong value in proof", types.MultihopProof{Proof: proofBz, Value: []byte("WRONGVALUE"), PrefixedKey: &path}, []byte("WRONGVALUE"), false},
+		{"missing prefixed key", types.MultihopProof{Proof: proofBz, Value: []byte("MYVALUE")}, []byte("MYVALUE"), false},
+		{"prefixed key does not match the path", types.MultihopProof{Proof: proofBz, Value: []byte("MYVALUE"), PrefixedKey: &types.MerklePath{KeyPath: []string{suite.storeKey.Name(), "OTHERKEY"}}}, []byte("MYVALUE"), false},
+		{"invalid proof bytes", types.MultihopProof{Proof: []byte("invalid proof"), Value: []byte("MYVALUE"), PrefixedKey: &path}, []byte("MYVALUE"), false},
+	}
+
+	for i, tc := range cases {
+		tc := tc
+
+		err := tc.proof.VerifyMembership(types.GetSDKSpecs(), types.NewMerkleRoot(cid.Hash), path, tc.value)
+		if tc.shouldPass {
+			suite.Require().NoError(err, "test case %d should have passed", i)
+		} else {
+			suite.Require().Error(err, "test case %d should have failed", i)
+		}
+	}
+}
+
+func (suite *MerkleTestSuite) TestMultihopProofsValidateBasic() {
+	keyProof := &types.MultihopProof{Proof: []byte("proof"), Value: []byte("value")}
+
+	cases := []struct {
+		name       string
+		proofs     types.MultihopProofs
+		shouldPass bool
+	}{
+		{"valid proofs", types.MultihopProofs{KeyProof: keyProof, ConnectionProofs: []types.MultihopProof{{}}, ConsensusProofs: []types.MultihopProof{{}}}, true},
+		{"missing key proof", types.MultihopProofs{ConnectionProofs: []types.MultihopProof{{}}, ConsensusProofs: []types.MultihopProof{{}}}, false},
+		{"empty key proof", types.MultihopProofs{KeyProof: &types.MultihopProof{}, ConnectionProofs: []types.MultihopProof{{}}, ConsensusProofs: []types.MultihopProof{{}}}, false},
+		{"missing consensus proof", types.MultihopProofs{KeyProof: keyProof, ConnectionProofs: []types.MultihopProof{{}}}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		err := tc.proofs.ValidateBasic()
+		if tc.shouldPass {
+			suite.Require().NoError(err, tc.name)
+		} else {
+			suite.Require().Error(err, tc.name)
+		}
+	}
+}
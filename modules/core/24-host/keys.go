@@ -31,6 +31,7 @@ const (
 	KeyConsensusStatePrefix    = "consensusStates"
 	KeyConnectionPrefix        = "connections"
 	KeyChannelEndPrefix        = "channelEnds"
+	KeyChannelUpgradePrefix    = "channelUpgrades"
 	KeyChannelPrefix           = "channels"
 	KeyPortPrefix              = "ports"
 	KeySequencePrefix          = "sequences"
@@ -38,6 +39,8 @@ const (
 	KeyNextSeqSendPrefix       = "nextSequenceSend"
 	KeyNextSeqRecvPrefix       = "nextSequenceRecv"
 	KeyNextSeqAckPrefix        = "nextSequenceAck"
+	KeyRecvStartSeqPrefix      = "recvStartSequence"
+	KeyPruningSeqStartPrefix   = "pruningSequenceStart"
 	KeyPacketCommitmentPrefix  = "commitments"
 	KeyPacketAckPrefix         = "acks"
 	KeyPacketReceiptPrefix     = "receipts"
@@ -142,6 +145,18 @@ func ChannelCapabilityPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyChannelCapabilityPrefix, channelPath(portID, channelID))
 }
 
+// ChannelUpgradePath defines the path under which the state of an in-progress
+// channel upgrade is stored
+func ChannelUpgradePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyChannelUpgradePrefix, channelPath(portID, channelID))
+}
+
+// ChannelUpgradeKey returns the store key for the upgrade state of a particular
+// channel
+func ChannelUpgradeKey(portID, channelID string) []byte {
+	return []byte(ChannelUpgradePath(portID, channelID))
+}
+
 // NextSequenceSendPath defines the next send sequence counter store path
 func NextSequenceSendPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s", KeyNextSeqSendPrefix, channelPath(portID, channelID))
@@ -175,6 +190,30 @@ func NextSequenceAckKey(portID, channelID string) []byte {
 	return []byte(NextSequenceAckPath(portID, channelID))
 }
 
+// RecvStartSequencePath defines the store path of the sequence below which
+// packets can no longer be received on a particular channel.
+func RecvStartSequencePath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyRecvStartSeqPrefix, channelPath(portID, channelID))
+}
+
+// RecvStartSequenceKey returns the store key for the receive start sequence of
+// a particular channel binded to a specific port.
+func RecvStartSequenceKey(portID, channelID string) []byte {
+	return []byte(RecvStartSequencePath(portID, channelID))
+}
+
+// PruningSequenceStartPath defines the store path of the next sequence whose
+// packet receipt and acknowledgement will be pruned on a particular channel.
+func PruningSequenceStartPath(portID, channelID string) string {
+	return fmt.Sprintf("%s/%s", KeyPruningSeqStartPrefix, channelPath(portID, channelID))
+}
+
+// PruningSequenceStartKey returns the store key for the pruning start sequence
+// of a particular channel binded to a specific port.
+func PruningSequenceStartKey(portID, channelID string) []byte {
+	return []byte(PruningSequenceStartPath(portID, channelID))
+}
+
 // PacketCommitmentPath defines the commitments to packet data fields store path
 func PacketCommitmentPath(portID, channelID string, sequence uint64) string {
 	return fmt.Sprintf("%s/%d", PacketCommitmentPrefixPath(portID, channelID), sequence)
@@ -360,6 +360,208 @@ func (k Keeper) ChannelCloseConfirm(goCtx context.Context, msg *channeltypes.Msg
 	return &channeltypes.MsgChannelCloseConfirmResponse{}, nil
 }
 
+// ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
+func (k Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeInit(
+		ctx, msg.PortId, msg.ChannelId, cap, msg.Ordering, msg.ConnectionHops, msg.Version,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade init failed")
+	}
+
+	// Perform application logic callback
+	version, err := cbs.OnChanUpgradeInit(ctx, msg.PortId, msg.ChannelId, msg.Ordering, msg.ConnectionHops, msg.Version)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade init callback failed")
+	}
+
+	// Write upgrade into state
+	k.ChannelKeeper.WriteUpgradeInitChannel(ctx, msg.PortId, msg.ChannelId, msg.Ordering, msg.ConnectionHops, version, msg.Timeout)
+
+	return &channeltypes.MsgChannelUpgradeInitResponse{}, nil
+}
+
+// ChannelUpgradeTry defines a rpc handler method for MsgChannelUpgradeTry.
+func (k Keeper) ChannelUpgradeTry(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTry) (*channeltypes.MsgChannelUpgradeTryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeTry(
+		ctx, msg.PortId, msg.ChannelId, cap, msg.Ordering, msg.ConnectionHops, msg.CounterpartyVersion, msg.ProofInit, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade try failed")
+	}
+
+	// Perform application logic callback
+	version, err := cbs.OnChanUpgradeTry(ctx, msg.PortId, msg.ChannelId, msg.Ordering, msg.ConnectionHops, msg.CounterpartyVersion)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade try callback failed")
+	}
+
+	// Write upgrade into state
+	k.ChannelKeeper.WriteUpgradeTryChannel(ctx, msg.PortId, msg.ChannelId, msg.Ordering, msg.ConnectionHops, version, msg.ProofHeight)
+
+	return &channeltypes.MsgChannelUpgradeTryResponse{}, nil
+}
+
+// ChannelUpgradeAck defines a rpc handler method for MsgChannelUpgradeAck.
+// If the application rejects the version selected by the counterparty, the
+// channel is restored and a FAILURE result is returned.
+func (k Keeper) ChannelUpgradeAck(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeAck) (*channeltypes.MsgChannelUpgradeAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeAck(
+		ctx, msg.PortId, msg.ChannelId, cap, msg.CounterpartyVersion, msg.ProofTry, msg.ProofHeight,
+	); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade ack failed")
+	}
+
+	// Perform application logic callback, the application state changes are only
+	// written if the callback succeeds
+	cacheCtx, writeFn := ctx.CacheContext()
+	if err = cbs.OnChanUpgradeAck(cacheCtx, msg.PortId, msg.ChannelId, msg.CounterpartyVersion); err != nil {
+		ctx.Logger().Info("channel upgrade ack callback failed, restoring channel", "port-id", msg.PortId, "channel-id", msg.ChannelId, "error", err.Error())
+		k.ChannelKeeper.RestoreChannel(ctx, msg.PortId, msg.ChannelId)
+
+		return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.FAILURE}, nil
+	}
+	writeFn()
+
+	// Write channel into state
+	k.ChannelKeeper.WriteUpgradeAckChannel(ctx, msg.PortId, msg.ChannelId, msg.CounterpartyVersion)
+
+	channel, _ := k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	cbs.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, channel.Ordering, channel.ConnectionHops, channel.Version)
+
+	return &channeltypes.MsgChannelUpgradeAckResponse{Result: channeltypes.SUCCESS}, nil
+}
+
+// ChannelUpgradeConfirm defines a rpc handler method for MsgChannelUpgradeConfirm.
+func (k Keeper) ChannelUpgradeConfirm(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeConfirm) (*channeltypes.MsgChannelUpgradeConfirmResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	module, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Retrieve application callbacks from router
+	cbs, ok := k.Router.GetRoute(module)
+	if !ok {
+		return nil, sdkerrors.Wrapf(porttypes.ErrInvalidRoute, "route not found to module: %s", module)
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeConfirm(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofAck, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade confirm failed")
+	}
+
+	// Write channel into state
+	k.ChannelKeeper.WriteUpgradeConfirmChannel(ctx, msg.PortId, msg.ChannelId)
+
+	channel, _ := k.ChannelKeeper.GetChannel(ctx, msg.PortId, msg.ChannelId)
+	cbs.OnChanUpgradeOpen(ctx, msg.PortId, msg.ChannelId, channel.Ordering, channel.ConnectionHops, channel.Version)
+
+	return &channeltypes.MsgChannelUpgradeConfirmResponse{}, nil
+}
+
+// ChannelUpgradeTimeout defines a rpc handler method for MsgChannelUpgradeTimeout.
+func (k Keeper) ChannelUpgradeTimeout(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeTimeout) (*channeltypes.MsgChannelUpgradeTimeoutResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	_, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeTimeout(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofChannel, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade timeout failed")
+	}
+
+	k.ChannelKeeper.RestoreChannel(ctx, msg.PortId, msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeTimeoutResponse{}, nil
+}
+
+// ChannelUpgradeCancel defines a rpc handler method for MsgChannelUpgradeCancel.
+func (k Keeper) ChannelUpgradeCancel(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeCancel) (*channeltypes.MsgChannelUpgradeCancelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Lookup module by channel capability
+	_, cap, err := k.ChannelKeeper.LookupModuleByChannel(ctx, msg.PortId, msg.ChannelId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "could not retrieve module from port-id")
+	}
+
+	// Perform 04-channel verification
+	if err = k.ChannelKeeper.ChanUpgradeCancel(ctx, msg.PortId, msg.ChannelId, cap, msg.ProofChannel, msg.ProofHeight); err != nil {
+		return nil, sdkerrors.Wrap(err, "channel upgrade cancel failed")
+	}
+
+	k.ChannelKeeper.RestoreChannel(ctx, msg.PortId, msg.ChannelId)
+
+	return &channeltypes.MsgChannelUpgradeCancelResponse{}, nil
+}
+
+// PruneAcknowledgements defines a rpc handler method for MsgPruneAcknowledgements.
+func (k Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.MsgPruneAcknowledgements) (*channeltypes.MsgPruneAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	totalPruned, totalRemaining, err := k.ChannelKeeper.PruneAcknowledgements(
+		ctx, msg.PortId, msg.ChannelId, msg.NextSequenceAck, msg.ProofNextSequenceAck, msg.ProofHeight, msg.Limit,
+	)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "prune acknowledgements failed")
+	}
+
+	return &channeltypes.MsgPruneAcknowledgementsResponse{
+		TotalPrunedSequences:    totalPruned,
+		TotalRemainingSequences: totalRemaining,
+	}, nil
+}
+
 // RecvPacket defines a rpc handler method for MsgRecvPacket.
 func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -396,6 +598,11 @@ func (k Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPacke
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+	case channeltypes.ErrTimeoutReceiptWritten:
+		// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, it is skipped
+		// without executing the application callback
+		writeFn()
+		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
 	default:
 		return nil, sdkerrors.Wrap(err, "receive packet verification failed")
 	}
@@ -224,6 +224,46 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// tests the IBC handler receiving a timed out packet on an ORDERED_ALLOW_TIMEOUT channel.
+// It verifies that the packet is skipped without executing the application callback and
+// that a timeout receipt is written.
+func (suite *KeeperTestSuite) TestHandleRecvPacketTimeoutReceipt() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	suite.coordinator.Setup(path)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.GetSelfHeight(suite.chainB.GetContext()), 0)
+	suite.Require().NoError(path.EndpointA.SendPacket(packet))
+
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := path.EndpointA.QueryProof(packetKey)
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+	res, err := keeper.Keeper.RecvPacket(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+
+	// replay should not fail since it will be treated as a no-op
+	res, err = keeper.Keeper.RecvPacket(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.NOOP, res.Result)
+
+	// the application callback is not executed and no acknowledgement is written
+	_, exists := suite.chainB.GetSimApp().ScopedIBCMockKeeper.GetCapability(suite.chainB.GetContext(), ibcmock.GetMockRecvCanaryCapabilityName(packet))
+	suite.Require().False(exists)
+
+	channelKeeper := suite.chainB.App.GetIBCKeeper().ChannelKeeper
+	suite.Require().False(channelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+
+	receipt, found := channelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(string(channeltypes.TimeoutReceipt), receipt)
+
+	nextSeqRecv, found := channelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel())
+	suite.Require().True(found)
+	suite.Require().Equal(uint64(2), nextSeqRecv)
+}
+
 // tests the IBC handler acknowledgement of a packet on ordered and unordered
 // channels. It verifies that the deletion of packet commitments from state
 // occurs. It test high level properties like ordering and basic sanity
@@ -657,6 +697,86 @@ func (suite *KeeperTestSuite) TestHandleTimeoutOnClosePacket() {
 	}
 }
 
+// tests the IBC handler pruning the packet receipts and acknowledgements of an
+// unordered channel. It verifies that the deletion of the packet receipts and
+// acknowledgements occurs.
+// More rigorous testing of 'PruneAcknowledgements' can be found in the
+// 04-channel/keeper/pruning_test.go.
+func (suite *KeeperTestSuite) TestHandlePruneAcknowledgements() {
+	var (
+		path    *ibctesting.Path
+		packets []channeltypes.Packet
+		limit   uint64
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		expPass      bool
+		expPruned    uint64
+		expRemaining uint64
+	}{
+		{"success", func() {}, true, maxSequence - 1, 0},
+		{"success: limit is lower than the number of prunable sequences", func() {
+			limit = 3
+		}, true, 3, maxSequence - 4},
+		{"channel is ORDERED", func() {
+			channel := path.EndpointB.GetChannel()
+			channel.Ordering = channeltypes.ORDERED
+			path.EndpointB.SetChannel(channel)
+		}, false, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			suite.coordinator.Setup(path)
+
+			packets = nil
+			for i := uint64(1); i < maxSequence; i++ {
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, i, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				suite.Require().NoError(path.EndpointA.SendPacket(packet))
+				suite.Require().NoError(path.EndpointB.RecvPacket(packet))
+				suite.Require().NoError(path.EndpointA.AcknowledgePacket(packet, ibcmock.MockAcknowledgement.Acknowledgement()))
+				packets = append(packets, packet)
+			}
+			limit = 100
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+			nextSequenceAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().True(found)
+			proof, proofHeight := path.EndpointA.QueryProof(host.NextSequenceAckKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+			msg := channeltypes.NewMsgPruneAcknowledgements(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, nextSequenceAck, proof, proofHeight, limit, suite.chainB.SenderAccount.GetAddress().String())
+
+			res, err := keeper.Keeper.PruneAcknowledgements(*suite.chainB.App.GetIBCKeeper(), sdk.WrapSDKContext(suite.chainB.GetContext()), msg)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPruned, res.TotalPrunedSequences)
+				suite.Require().Equal(tc.expRemaining, res.TotalRemainingSequences)
+
+				// verify packet receipts and acknowledgements were deleted up to the limit
+				for i, packet := range packets {
+					_, hasReceipt := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					hasAck := suite.chainB.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+					pruned := uint64(i) < tc.expPruned
+					suite.Require().Equal(!pruned, hasReceipt)
+					suite.Require().Equal(!pruned, hasAck)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUpgradeClient() {
 	var (
 		path              *ibctesting.Path
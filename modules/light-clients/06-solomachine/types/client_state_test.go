@@ -769,6 +769,95 @@ func (suite *SoloMachineTestSuite) TestVerifyPacketReceiptAbsence() {
 	}
 }
 
+func (suite *SoloMachineTestSuite) TestVerifyPacketReceipt() {
+	receipt := channeltypes.TimeoutReceipt
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		path := solomachine.GetPacketReceiptPath(testPortID, testChannelID)
+
+		value, err := types.PacketReceiptSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, receipt)
+		suite.Require().NoError(err)
+
+		sig := solomachine.GenerateSignature(value)
+		signatureDoc := &types.TimestampedSignatureData{
+			SignatureData: sig,
+			Timestamp:     solomachine.Time,
+		}
+
+		proof, err := suite.chainA.Codec.Marshal(signatureDoc)
+		suite.Require().NoError(err)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			prefix      exported.Prefix
+			proof       []byte
+			receipt     []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientState(),
+				prefix,
+				proof,
+				receipt,
+				true,
+			},
+			{
+				"ApplyPrefix failed",
+				solomachine.ClientState(),
+				commitmenttypes.NewMerklePrefix([]byte{}),
+				proof,
+				receipt,
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientState(),
+				prefix,
+				nil,
+				receipt,
+				false,
+			},
+			{
+				"receipt does not match",
+				solomachine.ClientState(),
+				prefix,
+				proof,
+				[]byte{byte(1)},
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientState(),
+				prefix,
+				suite.GetInvalidProof(),
+				receipt,
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+			ctx := suite.chainA.GetContext()
+
+			err := tc.clientState.VerifyPacketReceipt(
+				ctx, suite.store, suite.chainA.Codec, solomachine.GetHeight(), 0, 0, tc.prefix, tc.proof, testPortID, testChannelID, solomachine.Sequence, tc.receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
+
 func (suite *SoloMachineTestSuite) TestVerifyNextSeqRecv() {
 	// test singlesig and multisig public keys
 	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
@@ -845,3 +934,80 @@ func (suite *SoloMachineTestSuite) TestVerifyNextSeqRecv() {
 		}
 	}
 }
+
+func (suite *SoloMachineTestSuite) TestVerifyNextSeqAck() {
+	// test singlesig and multisig public keys
+	for _, solomachine := range []*ibctesting.Solomachine{suite.solomachine, suite.solomachineMulti} {
+
+		nextSeqAck := solomachine.Sequence + 1
+		path := solomachine.GetNextSequenceAckPath(testPortID, testChannelID)
+
+		value, err := types.NextSequenceAckSignBytes(suite.chainA.Codec, solomachine.Sequence, solomachine.Time, solomachine.Diversifier, path, nextSeqAck)
+		suite.Require().NoError(err)
+
+		sig := solomachine.GenerateSignature(value)
+		signatureDoc := &types.TimestampedSignatureData{
+			SignatureData: sig,
+			Timestamp:     solomachine.Time,
+		}
+
+		proof, err := suite.chainA.Codec.Marshal(signatureDoc)
+		suite.Require().NoError(err)
+
+		testCases := []struct {
+			name        string
+			clientState *types.ClientState
+			prefix      exported.Prefix
+			proof       []byte
+			expPass     bool
+		}{
+			{
+				"successful verification",
+				solomachine.ClientState(),
+				prefix,
+				proof,
+				true,
+			},
+			{
+				"ApplyPrefix failed",
+				solomachine.ClientState(),
+				commitmenttypes.NewMerklePrefix([]byte{}),
+				proof,
+				false,
+			},
+			{
+				"proof is nil",
+				solomachine.ClientState(),
+				prefix,
+				nil,
+				false,
+			},
+			{
+				"proof verification failed",
+				solomachine.ClientState(),
+				prefix,
+				suite.GetInvalidProof(),
+				false,
+			},
+		}
+
+		for i, tc := range testCases {
+			tc := tc
+
+			expSeq := tc.clientState.Sequence + 1
+			ctx := suite.chainA.GetContext()
+
+			err := tc.clientState.VerifyNextSequenceAck(
+				ctx, suite.store, suite.chainA.Codec, solomachine.GetHeight(), 0, 0, tc.prefix, tc.proof, testPortID, testChannelID, nextSeqAck,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err, "valid test case %d failed: %s", i, tc.name)
+				suite.Require().Equal(expSeq, tc.clientState.Sequence)
+				suite.Require().Equal(expSeq, suite.GetSequenceFromStore(), "sequence not updated in the store (%d) on valid test case %d: %s", suite.GetSequenceFromStore(), i, tc.name)
+			} else {
+				suite.Require().Error(err, "invalid test case %d passed: %s", i, tc.name)
+			}
+		}
+	}
+}
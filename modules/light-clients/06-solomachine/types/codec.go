@@ -125,6 +125,22 @@ func UnmarshalDataByType(cdc codec.BinaryCodec, dataType DataType, data []byte)
 
 		return nextSeqRecvData, nil
 
+	case NEXTSEQUENCEACK:
+		nextSeqAckData := &NextSequenceAckData{}
+		if err := cdc.Unmarshal(data, nextSeqAckData); err != nil {
+			return nil, err
+		}
+
+		return nextSeqAckData, nil
+
+	case PACKETRECEIPT:
+		packetReceiptData := &PacketReceiptData{}
+		if err := cdc.Unmarshal(data, packetReceiptData); err != nil {
+			return nil, err
+		}
+
+		return packetReceiptData, nil
+
 	default:
 		return nil, sdkerrors.Wrapf(ErrInvalidDataType, "unsupported data type %T", dataType)
 	}
@@ -149,6 +149,22 @@ func (suite SoloMachineTestSuite) TestUnmarshalDataByType() {
 					suite.Require().NoError(err)
 				}, true,
 			},
+			{
+				"packet receipt", types.PACKETRECEIPT, func() {
+					path := solomachine.GetPacketReceiptPath("portID", "channelID")
+
+					data, err = types.PacketReceiptDataBytes(cdc, path, []byte{byte(2)})
+					suite.Require().NoError(err)
+				}, true,
+			},
+			{
+				"bad packet receipt (uses next seq recv)", types.PACKETRECEIPT, func() {
+					path := solomachine.GetNextSequenceRecvPath("portID", "channelID")
+
+					data, err = types.NextSequenceRecvDataBytes(cdc, path, 10)
+					suite.Require().NoError(err)
+				}, false,
+			},
 			{
 				"next sequence recv", types.NEXTSEQUENCERECV, func() {
 					path := solomachine.GetNextSequenceRecvPath("portID", "channelID")
@@ -162,6 +178,23 @@ func (suite SoloMachineTestSuite) TestUnmarshalDataByType() {
 					commitment := []byte("packet commitment")
 					path := solomachine.GetPacketCommitmentPath("portID", "channelID")
 
+					data, err = types.PacketCommitmentDataBytes(cdc, path, commitment)
+					suite.Require().NoError(err)
+				}, false,
+			},
+			{
+				"next sequence ack", types.NEXTSEQUENCEACK, func() {
+					path := solomachine.GetNextSequenceAckPath("portID", "channelID")
+
+					data, err = types.NextSequenceAckDataBytes(cdc, path, 10)
+					suite.Require().NoError(err)
+				}, true,
+			},
+			{
+				"bad next sequence ack (uses packet commitment)", types.NEXTSEQUENCEACK, func() {
+					commitment := []byte("packet commitment")
+					path := solomachine.GetPacketCommitmentPath("portID", "channelID")
+
 					data, err = types.PacketCommitmentDataBytes(cdc, path, commitment)
 					suite.Require().NoError(err)
 				}, false,
@@ -430,6 +430,51 @@ func PacketReceiptAbsenceDataBytes(
 	return dataBz, nil
 }
 
+// PacketReceiptSignBytes returns the sign bytes for verification of a
+// packet receipt.
+func PacketReceiptSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	path commitmenttypes.MerklePath,
+	receipt []byte,
+) ([]byte, error) {
+	dataBz, err := PacketReceiptDataBytes(cdc, path, receipt)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    PACKETRECEIPT,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// PacketReceiptDataBytes returns the packet receipt data bytes used in
+// constructing SignBytes.
+func PacketReceiptDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	receipt []byte,
+) ([]byte, error) {
+	data := &PacketReceiptData{
+		Path:    []byte(path.String()),
+		Receipt: receipt,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
+
 // NextSequenceRecvSignBytes returns the sign bytes for verification of the next
 // sequence to be received.
 func NextSequenceRecvSignBytes(
@@ -474,3 +519,48 @@ func NextSequenceRecvDataBytes(
 
 	return dataBz, nil
 }
+
+// NextSequenceAckSignBytes returns the sign bytes for verification of the next
+// sequence to be acknowledged.
+func NextSequenceAckSignBytes(
+	cdc codec.BinaryCodec,
+	sequence, timestamp uint64,
+	diversifier string,
+	path commitmenttypes.MerklePath,
+	nextSequenceAck uint64,
+) ([]byte, error) {
+	dataBz, err := NextSequenceAckDataBytes(cdc, path, nextSequenceAck)
+	if err != nil {
+		return nil, err
+	}
+
+	signBytes := &SignBytes{
+		Sequence:    sequence,
+		Timestamp:   timestamp,
+		Diversifier: diversifier,
+		DataType:    NEXTSEQUENCEACK,
+		Data:        dataBz,
+	}
+
+	return cdc.Marshal(signBytes)
+}
+
+// NextSequenceAckDataBytes returns the next sequence ack data bytes used in constructing
+// SignBytes.
+func NextSequenceAckDataBytes(
+	cdc codec.BinaryCodec,
+	path commitmenttypes.MerklePath, // nolint: interfacer
+	nextSequenceAck uint64,
+) ([]byte, error) {
+	data := &NextSequenceAckData{
+		Path:       []byte(path.String()),
+		NextSeqAck: nextSequenceAck,
+	}
+
+	dataBz, err := cdc.Marshal(data)
+	if err != nil {
+		return nil, err
+	}
+
+	return dataBz, nil
+}
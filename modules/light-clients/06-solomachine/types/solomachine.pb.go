@@ -51,19 +51,25 @@ const (
 	NEXTSEQUENCERECV DataType = 8
 	// Data type for header verification
 	HEADER DataType = 9
+	// Data type for next sequence ack verification
+	NEXTSEQUENCEACK DataType = 10
+	// Data type for packet receipt verification
+	PACKETRECEIPT DataType = 11
 )
 
 var DataType_name = map[int32]string{
-	0: "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
-	1: "DATA_TYPE_CLIENT_STATE",
-	2: "DATA_TYPE_CONSENSUS_STATE",
-	3: "DATA_TYPE_CONNECTION_STATE",
-	4: "DATA_TYPE_CHANNEL_STATE",
-	5: "DATA_TYPE_PACKET_COMMITMENT",
-	6: "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
-	7: "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
-	8: "DATA_TYPE_NEXT_SEQUENCE_RECV",
-	9: "DATA_TYPE_HEADER",
+	0:  "DATA_TYPE_UNINITIALIZED_UNSPECIFIED",
+	1:  "DATA_TYPE_CLIENT_STATE",
+	2:  "DATA_TYPE_CONSENSUS_STATE",
+	3:  "DATA_TYPE_CONNECTION_STATE",
+	4:  "DATA_TYPE_CHANNEL_STATE",
+	5:  "DATA_TYPE_PACKET_COMMITMENT",
+	6:  "DATA_TYPE_PACKET_ACKNOWLEDGEMENT",
+	7:  "DATA_TYPE_PACKET_RECEIPT_ABSENCE",
+	8:  "DATA_TYPE_NEXT_SEQUENCE_RECV",
+	9:  "DATA_TYPE_HEADER",
+	10: "DATA_TYPE_NEXT_SEQUENCE_ACK",
+	11: "DATA_TYPE_PACKET_RECEIPT",
 }
 
 var DataType_value = map[string]int32{
@@ -77,6 +83,8 @@ var DataType_value = map[string]int32{
 	"DATA_TYPE_PACKET_RECEIPT_ABSENCE":    7,
 	"DATA_TYPE_NEXT_SEQUENCE_RECV":        8,
 	"DATA_TYPE_HEADER":                    9,
+	"DATA_TYPE_NEXT_SEQUENCE_ACK":         10,
+	"DATA_TYPE_PACKET_RECEIPT":            11,
 }
 
 func (x DataType) String() string {
@@ -744,6 +752,60 @@ func (m *PacketReceiptAbsenceData) GetPath() []byte {
 	return nil
 }
 
+// PacketReceiptData returns the SignBytes data for packet receipt
+// verification.
+type PacketReceiptData struct {
+	Path    []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Receipt []byte `protobuf:"bytes,2,opt,name=receipt,proto3" json:"receipt,omitempty"`
+}
+
+func (m *PacketReceiptData) Reset()         { *m = PacketReceiptData{} }
+func (m *PacketReceiptData) String() string { return proto.CompactTextString(m) }
+func (*PacketReceiptData) ProtoMessage()    {}
+func (*PacketReceiptData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{15}
+}
+func (m *PacketReceiptData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PacketReceiptData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PacketReceiptData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PacketReceiptData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PacketReceiptData.Merge(m, src)
+}
+func (m *PacketReceiptData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PacketReceiptData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PacketReceiptData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PacketReceiptData proto.InternalMessageInfo
+
+func (m *PacketReceiptData) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *PacketReceiptData) GetReceipt() []byte {
+	if m != nil {
+		return m.Receipt
+	}
+	return nil
+}
+
 // NextSequenceRecvData returns the SignBytes data for verification of the next
 // sequence to be received.
 type NextSequenceRecvData struct {
@@ -755,7 +817,7 @@ func (m *NextSequenceRecvData) Reset()         { *m = NextSequenceRecvData{} }
 func (m *NextSequenceRecvData) String() string { return proto.CompactTextString(m) }
 func (*NextSequenceRecvData) ProtoMessage()    {}
 func (*NextSequenceRecvData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_141333b361aae010, []int{15}
+	return fileDescriptor_141333b361aae010, []int{16}
 }
 func (m *NextSequenceRecvData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -798,6 +860,60 @@ func (m *NextSequenceRecvData) GetNextSeqRecv() uint64 {
 	return 0
 }
 
+// NextSequenceAckData returns the SignBytes data for verification of the next
+// sequence to be acknowledged.
+type NextSequenceAckData struct {
+	Path       []byte `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	NextSeqAck uint64 `protobuf:"varint,2,opt,name=next_seq_ack,json=nextSeqAck,proto3" json:"next_seq_ack,omitempty" yaml:"next_seq_ack"`
+}
+
+func (m *NextSequenceAckData) Reset()         { *m = NextSequenceAckData{} }
+func (m *NextSequenceAckData) String() string { return proto.CompactTextString(m) }
+func (*NextSequenceAckData) ProtoMessage()    {}
+func (*NextSequenceAckData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_141333b361aae010, []int{17}
+}
+func (m *NextSequenceAckData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NextSequenceAckData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NextSequenceAckData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NextSequenceAckData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NextSequenceAckData.Merge(m, src)
+}
+func (m *NextSequenceAckData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NextSequenceAckData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NextSequenceAckData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NextSequenceAckData proto.InternalMessageInfo
+
+func (m *NextSequenceAckData) GetPath() []byte {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+func (m *NextSequenceAckData) GetNextSeqAck() uint64 {
+	if m != nil {
+		return m.NextSeqAck
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.lightclients.solomachine.v2.DataType", DataType_name, DataType_value)
 	proto.RegisterType((*ClientState)(nil), "ibc.lightclients.solomachine.v2.ClientState")
@@ -815,7 +931,9 @@ func init() {
 	proto.RegisterType((*PacketCommitmentData)(nil), "ibc.lightclients.solomachine.v2.PacketCommitmentData")
 	proto.RegisterType((*PacketAcknowledgementData)(nil), "ibc.lightclients.solomachine.v2.PacketAcknowledgementData")
 	proto.RegisterType((*PacketReceiptAbsenceData)(nil), "ibc.lightclients.solomachine.v2.PacketReceiptAbsenceData")
+	proto.RegisterType((*PacketReceiptData)(nil), "ibc.lightclients.solomachine.v2.PacketReceiptData")
 	proto.RegisterType((*NextSequenceRecvData)(nil), "ibc.lightclients.solomachine.v2.NextSequenceRecvData")
+	proto.RegisterType((*NextSequenceAckData)(nil), "ibc.lightclients.solomachine.v2.NextSequenceAckData")
 }
 
 func init() {
@@ -823,93 +941,98 @@ func init() {
 }
 
 var fileDescriptor_141333b361aae010 = []byte{
-	// 1370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x5f, 0x8f, 0xdb, 0x44,
-	0x10, 0x3f, 0xa7, 0xe9, 0xf5, 0x32, 0xb9, 0xde, 0x05, 0x37, 0x6d, 0x73, 0x6e, 0x95, 0x18, 0x23,
-	0xca, 0x81, 0x68, 0xc2, 0x5d, 0x45, 0x85, 0x2a, 0x04, 0x38, 0x8e, 0x4b, 0xd3, 0xde, 0xf9, 0x82,
-	0xe3, 0x03, 0x5a, 0x21, 0x19, 0xc7, 0xd9, 0x4b, 0xac, 0x26, 0xde, 0x34, 0x76, 0x92, 0x06, 0x09,
-	0x09, 0xf1, 0x54, 0x22, 0x1e, 0xf8, 0x02, 0x91, 0x10, 0x88, 0xcf, 0xc1, 0x1b, 0xf0, 0xd8, 0x47,
-	0x9e, 0x02, 0x6a, 0xbf, 0x41, 0x3e, 0x01, 0xb2, 0x77, 0x13, 0xdb, 0xb9, 0x5e, 0x4e, 0xfc, 0x7b,
-	0xdb, 0x9d, 0xdf, 0xcc, 0x6f, 0x66, 0x67, 0xc6, 0xb3, 0x6b, 0xd8, 0xb1, 0x6a, 0x66, 0xa1, 0x65,
-	0x35, 0x9a, 0xae, 0xd9, 0xb2, 0x90, 0xed, 0x3a, 0x05, 0x07, 0xb7, 0x70, 0xdb, 0x30, 0x9b, 0x96,
-	0x8d, 0x0a, 0xfd, 0xdd, 0xf0, 0x36, 0xdf, 0xe9, 0x62, 0x17, 0xb3, 0x39, 0xab, 0x66, 0xe6, 0xc3,
-	0x26, 0xf9, 0xb0, 0x4e, 0x7f, 0x97, 0x7b, 0xcd, 0xe3, 0x34, 0x71, 0x17, 0x15, 0x4c, 0x6c, 0xdb,
-	0xc8, 0x74, 0x2d, 0x6c, 0x17, 0xfa, 0x3b, 0xa1, 0x1d, 0x61, 0xe2, 0x5e, 0x0e, 0x14, 0x9b, 0x86,
-	0x6d, 0xa3, 0x96, 0xaf, 0x45, 0x96, 0x54, 0x25, 0xdd, 0xc0, 0x0d, 0xec, 0x2f, 0x0b, 0xde, 0x8a,
-	0x4a, 0xb7, 0x1a, 0x18, 0x37, 0x5a, 0xa8, 0xe0, 0xef, 0x6a, 0xbd, 0xa3, 0x82, 0x61, 0x0f, 0x09,
-	0x24, 0xfc, 0x1c, 0x83, 0xa4, 0xe4, 0xc7, 0x55, 0x75, 0x0d, 0x17, 0xb1, 0x1c, 0xac, 0x39, 0xe8,
-	0x51, 0x0f, 0xd9, 0x26, 0xca, 0x30, 0x3c, 0xb3, 0x1d, 0x57, 0xe7, 0x7b, 0x76, 0x07, 0x12, 0x96,
-	0xa3, 0x1f, 0x75, 0xf1, 0x17, 0xc8, 0xce, 0xc4, 0x78, 0x66, 0x7b, 0xad, 0x98, 0x9e, 0x4e, 0x72,
-	0xa9, 0xa1, 0xd1, 0x6e, 0xdd, 0x12, 0xe6, 0x90, 0xa0, 0xae, 0x59, 0xce, 0x6d, 0x7f, 0xc9, 0xba,
-	0xb0, 0x69, 0x62, 0xdb, 0x41, 0xb6, 0xd3, 0x73, 0x74, 0xc7, 0xf3, 0x90, 0x39, 0xc3, 0x33, 0xdb,
-	0xc9, 0xdd, 0x42, 0xfe, 0x94, 0xb4, 0xe4, 0xa5, 0x99, 0x9d, 0x1f, 0x58, 0x91, 0x9b, 0x4e, 0x72,
-	0x97, 0x88, 0xa7, 0x05, 0x46, 0x41, 0xdd, 0x30, 0x23, 0xba, 0x2c, 0x82, 0x2b, 0x46, 0xab, 0x85,
-	0x07, 0x7a, 0xaf, 0x53, 0x37, 0x5c, 0xa4, 0x1b, 0x47, 0x2e, 0xea, 0xea, 0x9d, 0x2e, 0xee, 0x60,
-	0xc7, 0x68, 0x65, 0xe2, 0x7e, 0xe8, 0xd7, 0xa6, 0x93, 0x9c, 0x40, 0x08, 0x97, 0x28, 0x0b, 0x6a,
-	0xc6, 0x47, 0x0f, 0x7d, 0x50, 0xf4, 0xb0, 0x0a, 0x85, 0x6e, 0xc5, 0x9f, 0x7c, 0x9f, 0x5b, 0x11,
-	0x7e, 0x60, 0x60, 0x23, 0x1a, 0x2b, 0x7b, 0x17, 0xa0, 0xd3, 0xab, 0xb5, 0x2c, 0x53, 0x7f, 0x88,
-	0x86, 0x7e, 0x1a, 0x93, 0xbb, 0xe9, 0x3c, 0x29, 0x42, 0x7e, 0x56, 0x84, 0xbc, 0x68, 0x0f, 0x8b,
-	0x17, 0xa7, 0x93, 0xdc, 0x4b, 0x24, 0x88, 0xc0, 0x42, 0x50, 0x13, 0x64, 0x73, 0x0f, 0x0d, 0x59,
-	0x1e, 0x92, 0x75, 0xab, 0x8f, 0xba, 0x8e, 0x75, 0x64, 0xa1, 0xae, 0x9f, 0xf6, 0x84, 0x1a, 0x16,
-	0xb1, 0x57, 0x21, 0xe1, 0x5a, 0x6d, 0xe4, 0xb8, 0x46, 0xbb, 0xe3, 0x67, 0x37, 0xae, 0x06, 0x02,
-	0x1a, 0xe4, 0xd7, 0x31, 0x58, 0xbd, 0x83, 0x8c, 0x3a, 0xea, 0x2e, 0xad, 0x70, 0x84, 0x2a, 0xb6,
-	0x40, 0xe5, 0xa1, 0x8e, 0xd5, 0xb0, 0x0d, 0xb7, 0xd7, 0x25, 0x65, 0x5c, 0x57, 0x03, 0x01, 0x7b,
-	0x08, 0x1b, 0x36, 0x1a, 0xe8, 0xa1, 0x83, 0xc7, 0x97, 0x1c, 0x7c, 0x6b, 0x3a, 0xc9, 0x5d, 0x24,
-	0x07, 0x8f, 0x5a, 0x09, 0xea, 0xba, 0x8d, 0x06, 0x95, 0xf9, 0xf9, 0x25, 0xd8, 0xf4, 0x14, 0xc2,
-	0x39, 0x38, 0xeb, 0xe5, 0x20, 0xdc, 0x10, 0x0b, 0x0a, 0x82, 0xea, 0x45, 0x52, 0x0a, 0x04, 0x34,
-	0x09, 0xbf, 0xc6, 0x60, 0x7d, 0xdf, 0x72, 0x6a, 0xa8, 0x69, 0xf4, 0x2d, 0xdc, 0xeb, 0x7a, 0x0d,
-	0x4d, 0x9a, 0x4f, 0xb7, 0xea, 0x7e, 0x2e, 0x12, 0xe1, 0x86, 0x9e, 0x43, 0x82, 0xba, 0x46, 0xd6,
-	0xe5, 0x7a, 0x24, 0x7b, 0xb1, 0x85, 0xec, 0x75, 0xe0, 0xfc, 0x3c, 0x1d, 0x3a, 0xb6, 0x67, 0xad,
-	0xbe, 0x73, 0x6a, 0xab, 0x57, 0x67, 0x56, 0xa2, 0x5d, 0x2f, 0x19, 0xae, 0x51, 0xcc, 0x4c, 0x27,
-	0xb9, 0x34, 0x89, 0x22, 0xc2, 0x28, 0xa8, 0xeb, 0xf3, 0xfd, 0x81, 0xbd, 0xe0, 0xd1, 0x1d, 0x60,
-	0x9a, 0xf2, 0xff, 0xca, 0xa3, 0x3b, 0xc0, 0x61, 0x8f, 0xda, 0x00, 0xd3, 0x4c, 0xfe, 0xc2, 0x40,
-	0x6a, 0x91, 0x22, 0xda, 0x1e, 0xcc, 0x62, 0x7b, 0x7c, 0x06, 0x89, 0xba, 0xe1, 0x1a, 0xba, 0x3b,
-	0xec, 0x90, 0xcc, 0x6d, 0xec, 0xbe, 0x7e, 0x6a, 0x98, 0x1e, 0xaf, 0x36, 0xec, 0xa0, 0x70, 0x59,
-	0xe6, 0x2c, 0x82, 0xba, 0x56, 0xa7, 0x38, 0xcb, 0x42, 0xdc, 0x5b, 0xd3, 0xae, 0xf4, 0xd7, 0xd1,
-	0x66, 0x8e, 0xbf, 0xf8, 0xbb, 0xf8, 0x8a, 0x81, 0x8c, 0x36, 0x93, 0xa1, 0xfa, 0xfc, 0x4c, 0xfe,
-	0x81, 0x3e, 0x80, 0x8d, 0x20, 0x17, 0x3e, 0xbd, 0x7f, 0xaa, 0x70, 0xef, 0x46, 0x71, 0x41, 0x0d,
-	0xca, 0x51, 0x3a, 0x16, 0x42, 0xec, 0xc5, 0x21, 0xfc, 0xc1, 0x40, 0xc2, 0xf3, 0x5b, 0x1c, 0xba,
-	0xc8, 0xf9, 0x17, 0x5f, 0xe7, 0xc2, 0xa0, 0x38, 0x73, 0x7c, 0x50, 0x44, 0x4a, 0x10, 0xff, 0xbf,
-	0x4a, 0x70, 0x36, 0x28, 0x01, 0x3d, 0xe1, 0x4f, 0x0c, 0x00, 0x19, 0x3e, 0x7e, 0x52, 0xf6, 0x20,
-	0x49, 0x3f, 0xf9, 0x53, 0xc7, 0xe3, 0xa5, 0xe9, 0x24, 0xc7, 0x46, 0xa6, 0x04, 0x9d, 0x8f, 0x64,
-	0x44, 0x9c, 0x30, 0x1f, 0x62, 0xff, 0x70, 0x3e, 0x7c, 0x09, 0x9b, 0xa1, 0xab, 0xd0, 0x8f, 0x95,
-	0x85, 0x78, 0xc7, 0x70, 0x9b, 0xb4, 0x9d, 0xfd, 0x35, 0x5b, 0x81, 0x75, 0x3a, 0x1a, 0xc8, 0x85,
-	0x16, 0x5b, 0x72, 0x80, 0xcb, 0xd3, 0x49, 0xee, 0x42, 0x64, 0x9c, 0xd0, 0x2b, 0x2b, 0x69, 0x06,
-	0x9e, 0xa8, 0xfb, 0x6f, 0x18, 0x60, 0xa3, 0x17, 0xc9, 0x89, 0x21, 0xdc, 0x3f, 0x7e, 0xad, 0x2e,
-	0x8b, 0xe2, 0x6f, 0xdc, 0x9d, 0x34, 0x96, 0x3e, 0x5c, 0x90, 0xe6, 0xcf, 0x8f, 0xe5, 0xb1, 0xc8,
-	0x00, 0xc1, 0x4b, 0x85, 0x86, 0xf1, 0xaa, 0xdf, 0x56, 0xde, 0x53, 0x25, 0x1f, 0x7a, 0xc5, 0xf4,
-	0x77, 0xf2, 0x01, 0xa9, 0x6c, 0xd7, 0xd5, 0x90, 0x21, 0xf5, 0x5b, 0x87, 0x94, 0x44, 0x1e, 0x34,
-	0xcb, 0x9d, 0xde, 0x84, 0x73, 0xf4, 0xe1, 0x43, 0x3d, 0x5e, 0x0d, 0x79, 0xa4, 0x2f, 0x22, 0xcf,
-	0x1d, 0x59, 0xaa, 0x33, 0x65, 0xea, 0xe5, 0x2e, 0xa4, 0x2b, 0x86, 0xf9, 0x10, 0xb9, 0x12, 0x6e,
-	0xb7, 0x2d, 0xb7, 0x8d, 0x6c, 0xf7, 0x44, 0x4f, 0x59, 0xef, 0x78, 0x33, 0x2d, 0xdf, 0xd9, 0xba,
-	0x1a, 0x92, 0x08, 0xf7, 0x61, 0x8b, 0x70, 0x89, 0xe6, 0x43, 0x1b, 0x0f, 0x5a, 0xa8, 0xde, 0x40,
-	0x4b, 0x09, 0xb7, 0x61, 0xd3, 0x88, 0xaa, 0x52, 0xd6, 0x45, 0xb1, 0x90, 0x87, 0x0c, 0xa1, 0x56,
-	0x91, 0x89, 0xac, 0x8e, 0x2b, 0xd6, 0x1c, 0x6f, 0x0e, 0x9c, 0xc4, 0x2c, 0x34, 0x21, 0xad, 0xa0,
-	0xc7, 0x6e, 0x95, 0xce, 0x0b, 0x15, 0x99, 0xfd, 0x13, 0xa3, 0x78, 0x17, 0xce, 0xdb, 0xe8, 0xb1,
-	0xab, 0x3b, 0xe8, 0x91, 0xde, 0x45, 0x66, 0x9f, 0xcc, 0x93, 0xf0, 0x35, 0x10, 0x81, 0x05, 0x35,
-	0x69, 0x13, 0x6a, 0x8f, 0xf5, 0x8d, 0x6f, 0xe3, 0xb0, 0x36, 0x1b, 0x0c, 0xec, 0x3b, 0xf0, 0x4a,
-	0x49, 0xd4, 0x44, 0x5d, 0xbb, 0x5f, 0x91, 0xf5, 0x43, 0xa5, 0xac, 0x94, 0xb5, 0xb2, 0xb8, 0x57,
-	0x7e, 0x20, 0x97, 0xf4, 0x43, 0xa5, 0x5a, 0x91, 0xa5, 0xf2, 0xed, 0xb2, 0x5c, 0x4a, 0xad, 0x70,
-	0x9b, 0xa3, 0x31, 0x9f, 0x0c, 0x89, 0xd8, 0x6b, 0x70, 0x29, 0xb0, 0x94, 0xf6, 0xca, 0xb2, 0xa2,
-	0xe9, 0x55, 0x4d, 0xd4, 0xe4, 0x14, 0xc3, 0xc1, 0x68, 0xcc, 0xaf, 0x12, 0x19, 0xfb, 0x26, 0x6c,
-	0x85, 0xf4, 0x0e, 0x94, 0xaa, 0xac, 0x54, 0x0f, 0xab, 0x54, 0x35, 0xc6, 0x9d, 0x1f, 0x8d, 0xf9,
-	0xc4, 0x5c, 0xcc, 0xe6, 0x81, 0x8b, 0x68, 0x2b, 0xb2, 0xa4, 0x95, 0x0f, 0x14, 0xaa, 0x7e, 0x86,
-	0xdb, 0x18, 0x8d, 0x79, 0x08, 0xe4, 0xec, 0x36, 0x5c, 0x0e, 0xe9, 0xdf, 0x11, 0x15, 0x45, 0xde,
-	0xa3, 0xca, 0x71, 0x2e, 0x39, 0x1a, 0xf3, 0xe7, 0xa8, 0x90, 0x7d, 0x1b, 0xae, 0x04, 0x9a, 0x15,
-	0x51, 0xba, 0x27, 0x6b, 0xba, 0x74, 0xb0, 0xbf, 0x5f, 0xd6, 0xf6, 0x65, 0x45, 0x4b, 0x9d, 0xe5,
-	0xd2, 0xa3, 0x31, 0x9f, 0x22, 0x40, 0x20, 0x67, 0xdf, 0x07, 0xfe, 0x98, 0x99, 0x28, 0xdd, 0x53,
-	0x0e, 0x3e, 0xd9, 0x93, 0x4b, 0x1f, 0xca, 0xbe, 0xed, 0x2a, 0xb7, 0x35, 0x1a, 0xf3, 0x17, 0x09,
-	0xba, 0x00, 0xb2, 0xef, 0xbd, 0x80, 0x40, 0x95, 0x25, 0xb9, 0x5c, 0xd1, 0x74, 0xb1, 0x58, 0x95,
-	0x15, 0x49, 0x4e, 0x9d, 0xe3, 0x32, 0xa3, 0x31, 0x9f, 0x26, 0x28, 0x05, 0x29, 0xc6, 0xde, 0x84,
-	0xab, 0x81, 0xbd, 0x22, 0x7f, 0xaa, 0xe9, 0x55, 0xf9, 0xa3, 0x43, 0x0f, 0xf2, 0x68, 0x3e, 0x4e,
-	0xad, 0x91, 0xc0, 0x3d, 0x64, 0x06, 0x78, 0x72, 0x96, 0x87, 0x54, 0x60, 0x77, 0x47, 0x16, 0x4b,
-	0xb2, 0x9a, 0x4a, 0x90, 0xca, 0x90, 0x1d, 0x17, 0x7f, 0xf2, 0x63, 0x76, 0xa5, 0xf8, 0xf9, 0x6f,
-	0xcf, 0xb2, 0xcc, 0xd3, 0x67, 0x59, 0xe6, 0xcf, 0x67, 0x59, 0xe6, 0xbb, 0xe7, 0xd9, 0x95, 0xa7,
-	0xcf, 0xb3, 0x2b, 0xbf, 0x3f, 0xcf, 0xae, 0x3c, 0xb8, 0xdd, 0xb0, 0xdc, 0x66, 0xaf, 0x96, 0x37,
-	0x71, 0xbb, 0x60, 0x62, 0xa7, 0x8d, 0x9d, 0x82, 0x55, 0x33, 0xaf, 0x37, 0x70, 0xa1, 0x7f, 0xa3,
-	0xd0, 0xc6, 0xf5, 0x5e, 0x0b, 0x39, 0xe4, 0x7f, 0xea, 0xfa, 0xec, 0x87, 0xea, 0xad, 0x9b, 0xd7,
-	0xc3, 0xff, 0x54, 0xde, 0x35, 0xe3, 0xd4, 0x56, 0xfd, 0x79, 0x76, 0xe3, 0xaf, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x5d, 0xd4, 0x6c, 0xfb, 0x80, 0x0d, 0x00, 0x00,
+	// 1449 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0xdd, 0x8e, 0xdb, 0xd4,
+	0x16, 0x1e, 0xa7, 0xe9, 0xcc, 0x64, 0x65, 0x7e, 0x52, 0x4f, 0xda, 0x66, 0xdc, 0x2a, 0xf1, 0xf1,
+	0xd1, 0xe9, 0x99, 0x73, 0x44, 0x63, 0x66, 0x0a, 0x15, 0x54, 0x08, 0xf0, 0x78, 0x5c, 0x9a, 0xce,
+	0x8c, 0x27, 0x38, 0x1e, 0xa0, 0x15, 0x92, 0x71, 0xec, 0x3d, 0x19, 0x6b, 0x12, 0x3b, 0x8d, 0x9d,
+	0xa4, 0x41, 0x42, 0x42, 0x5c, 0x95, 0x5c, 0xf1, 0x02, 0x91, 0x10, 0x88, 0xe7, 0xe0, 0x0e, 0xb8,
+	0xec, 0x25, 0x57, 0x01, 0xb5, 0x6f, 0x10, 0x5e, 0x00, 0xd9, 0x7b, 0x27, 0xb6, 0xd3, 0x26, 0x23,
+	0xfe, 0xee, 0xf6, 0x5e, 0xdf, 0x5a, 0xdf, 0xfa, 0xd9, 0xcb, 0x6b, 0x6f, 0xc3, 0xb6, 0x55, 0x35,
+	0xf8, 0xba, 0x55, 0x3b, 0xf5, 0x8c, 0xba, 0x85, 0x6c, 0xcf, 0xe5, 0x5d, 0xa7, 0xee, 0x34, 0x74,
+	0xe3, 0xd4, 0xb2, 0x11, 0xdf, 0xd9, 0x89, 0x6e, 0x8b, 0xcd, 0x96, 0xe3, 0x39, 0x74, 0xc1, 0xaa,
+	0x1a, 0xc5, 0xa8, 0x49, 0x31, 0xaa, 0xd3, 0xd9, 0x61, 0xfe, 0xeb, 0x73, 0x1a, 0x4e, 0x0b, 0xf1,
+	0x86, 0x63, 0xdb, 0xc8, 0xf0, 0x2c, 0xc7, 0xe6, 0x3b, 0xdb, 0x91, 0x1d, 0x66, 0x62, 0xfe, 0x15,
+	0x2a, 0x9e, 0xea, 0xb6, 0x8d, 0xea, 0x81, 0x16, 0x5e, 0x12, 0x95, 0x6c, 0xcd, 0xa9, 0x39, 0xc1,
+	0x92, 0xf7, 0x57, 0x44, 0xba, 0x59, 0x73, 0x9c, 0x5a, 0x1d, 0xf1, 0xc1, 0xae, 0xda, 0x3e, 0xe1,
+	0x75, 0xbb, 0x87, 0x21, 0xee, 0xfb, 0x04, 0xa4, 0xc5, 0x20, 0xae, 0x8a, 0xa7, 0x7b, 0x88, 0x66,
+	0x60, 0xd9, 0x45, 0x8f, 0xda, 0xc8, 0x36, 0x50, 0x8e, 0x62, 0xa9, 0xad, 0xa4, 0x32, 0xd9, 0xd3,
+	0xdb, 0x90, 0xb2, 0x5c, 0xed, 0xa4, 0xe5, 0x7c, 0x8a, 0xec, 0x5c, 0x82, 0xa5, 0xb6, 0x96, 0x77,
+	0xb3, 0xa3, 0x61, 0x21, 0xd3, 0xd3, 0x1b, 0xf5, 0x3b, 0xdc, 0x04, 0xe2, 0x94, 0x65, 0xcb, 0xbd,
+	0x1b, 0x2c, 0x69, 0x0f, 0xd6, 0x0d, 0xc7, 0x76, 0x91, 0xed, 0xb6, 0x5d, 0xcd, 0xf5, 0x3d, 0xe4,
+	0x2e, 0xb0, 0xd4, 0x56, 0x7a, 0x87, 0x2f, 0x9e, 0x53, 0x96, 0xa2, 0x38, 0xb6, 0x0b, 0x02, 0xdb,
+	0x65, 0x46, 0xc3, 0xc2, 0x15, 0xec, 0x69, 0x8a, 0x91, 0x53, 0xd6, 0x8c, 0x98, 0x2e, 0x8d, 0xe0,
+	0x9a, 0x5e, 0xaf, 0x3b, 0x5d, 0xad, 0xdd, 0x34, 0x75, 0x0f, 0x69, 0xfa, 0x89, 0x87, 0x5a, 0x5a,
+	0xb3, 0xe5, 0x34, 0x1d, 0x57, 0xaf, 0xe7, 0x92, 0x41, 0xe8, 0x37, 0x46, 0xc3, 0x02, 0x87, 0x09,
+	0xe7, 0x28, 0x73, 0x4a, 0x2e, 0x40, 0x8f, 0x03, 0x50, 0xf0, 0xb1, 0x32, 0x81, 0xee, 0x24, 0x9f,
+	0x7c, 0x5d, 0x58, 0xe0, 0xbe, 0xa1, 0x60, 0x2d, 0x1e, 0x2b, 0x7d, 0x1f, 0xa0, 0xd9, 0xae, 0xd6,
+	0x2d, 0x43, 0x3b, 0x43, 0xbd, 0xa0, 0x8c, 0xe9, 0x9d, 0x6c, 0x11, 0x1f, 0x42, 0x71, 0x7c, 0x08,
+	0x45, 0xc1, 0xee, 0xed, 0x5e, 0x1e, 0x0d, 0x0b, 0x97, 0x70, 0x10, 0xa1, 0x05, 0xa7, 0xa4, 0xf0,
+	0x66, 0x1f, 0xf5, 0x68, 0x16, 0xd2, 0xa6, 0xd5, 0x41, 0x2d, 0xd7, 0x3a, 0xb1, 0x50, 0x2b, 0x28,
+	0x7b, 0x4a, 0x89, 0x8a, 0xe8, 0xeb, 0x90, 0xf2, 0xac, 0x06, 0x72, 0x3d, 0xbd, 0xd1, 0x0c, 0xaa,
+	0x9b, 0x54, 0x42, 0x01, 0x09, 0xf2, 0x8b, 0x04, 0x2c, 0xde, 0x43, 0xba, 0x89, 0x5a, 0x73, 0x4f,
+	0x38, 0x46, 0x95, 0x98, 0xa2, 0xf2, 0x51, 0xd7, 0xaa, 0xd9, 0xba, 0xd7, 0x6e, 0xe1, 0x63, 0x5c,
+	0x51, 0x42, 0x01, 0x7d, 0x0c, 0x6b, 0x36, 0xea, 0x6a, 0x91, 0xc4, 0x93, 0x73, 0x12, 0xdf, 0x1c,
+	0x0d, 0x0b, 0x97, 0x71, 0xe2, 0x71, 0x2b, 0x4e, 0x59, 0xb1, 0x51, 0xb7, 0x3c, 0xc9, 0x5f, 0x84,
+	0x75, 0x5f, 0x21, 0x5a, 0x83, 0x8b, 0x7e, 0x0d, 0xa2, 0x0d, 0x31, 0xa5, 0xc0, 0x29, 0x7e, 0x24,
+	0x7b, 0xa1, 0x80, 0x14, 0xe1, 0xc7, 0x04, 0xac, 0x1c, 0x5a, 0x6e, 0x15, 0x9d, 0xea, 0x1d, 0xcb,
+	0x69, 0xb7, 0xfc, 0x86, 0xc6, 0xcd, 0xa7, 0x59, 0x66, 0x50, 0x8b, 0x54, 0xb4, 0xa1, 0x27, 0x10,
+	0xa7, 0x2c, 0xe3, 0x75, 0xc9, 0x8c, 0x55, 0x2f, 0x31, 0x55, 0xbd, 0x26, 0xac, 0x4e, 0xca, 0xa1,
+	0x39, 0xf6, 0xb8, 0xd5, 0xb7, 0xcf, 0x6d, 0xf5, 0xca, 0xd8, 0x4a, 0xb0, 0xcd, 0x3d, 0xdd, 0xd3,
+	0x77, 0x73, 0xa3, 0x61, 0x21, 0x8b, 0xa3, 0x88, 0x31, 0x72, 0xca, 0xca, 0x64, 0x7f, 0x64, 0x4f,
+	0x79, 0xf4, 0xba, 0x4e, 0x2e, 0xf9, 0xb7, 0x7a, 0xf4, 0xba, 0x4e, 0xd4, 0xa3, 0xda, 0x75, 0x48,
+	0x25, 0x7f, 0xa0, 0x20, 0x33, 0x4d, 0x11, 0x6f, 0x0f, 0x6a, 0xba, 0x3d, 0x3e, 0x86, 0x94, 0xa9,
+	0x7b, 0xba, 0xe6, 0xf5, 0x9a, 0xb8, 0x72, 0x6b, 0x3b, 0xff, 0x3b, 0x37, 0x4c, 0x9f, 0x57, 0xed,
+	0x35, 0x51, 0xf4, 0x58, 0x26, 0x2c, 0x9c, 0xb2, 0x6c, 0x12, 0x9c, 0xa6, 0x21, 0xe9, 0xaf, 0x49,
+	0x57, 0x26, 0x4d, 0x12, 0x4f, 0xd8, 0xcc, 0xc9, 0x97, 0x7f, 0x17, 0x9f, 0x53, 0x90, 0x53, 0xc7,
+	0x32, 0x64, 0x4e, 0x72, 0x0a, 0x12, 0x7a, 0x17, 0xd6, 0xc2, 0x5a, 0x04, 0xf4, 0x41, 0x56, 0xd1,
+	0xde, 0x8d, 0xe3, 0x9c, 0xb2, 0xea, 0xc6, 0x18, 0xe6, 0x7e, 0x4f, 0x24, 0x84, 0x5f, 0x28, 0x48,
+	0xf9, 0x7e, 0x77, 0x7b, 0x1e, 0x72, 0xff, 0xc2, 0xd7, 0x39, 0x35, 0x28, 0x2e, 0xbc, 0x38, 0x28,
+	0x62, 0x47, 0x90, 0xfc, 0xa7, 0x8e, 0xe0, 0x62, 0x78, 0x04, 0x24, 0xc3, 0xef, 0x28, 0x00, 0x3c,
+	0x7c, 0x82, 0xa2, 0x1c, 0x40, 0x9a, 0x7c, 0xf2, 0xe7, 0x8e, 0xc7, 0x2b, 0xa3, 0x61, 0x81, 0x8e,
+	0x4d, 0x09, 0x32, 0x1f, 0xf1, 0x88, 0x98, 0x31, 0x1f, 0x12, 0x7f, 0x72, 0x3e, 0x7c, 0x06, 0xeb,
+	0x91, 0xab, 0x30, 0x88, 0x95, 0x86, 0x64, 0x53, 0xf7, 0x4e, 0x49, 0x3b, 0x07, 0x6b, 0xba, 0x0c,
+	0x2b, 0x64, 0x34, 0xe0, 0x0b, 0x2d, 0x31, 0x27, 0x81, 0xab, 0xa3, 0x61, 0x61, 0x23, 0x36, 0x4e,
+	0xc8, 0x95, 0x95, 0x36, 0x42, 0x4f, 0xc4, 0xfd, 0x97, 0x14, 0xd0, 0xf1, 0x8b, 0x64, 0x66, 0x08,
+	0x0f, 0x5e, 0xbc, 0x56, 0xe7, 0x45, 0xf1, 0x07, 0xee, 0x4e, 0x12, 0x4b, 0x07, 0x36, 0xc4, 0xc9,
+	0xf3, 0x63, 0x7e, 0x2c, 0x12, 0x40, 0xf8, 0x52, 0x21, 0x61, 0xfc, 0x27, 0x68, 0x2b, 0xff, 0xa9,
+	0x52, 0x0c, 0xb1, 0x62, 0x67, 0xbb, 0x18, 0x92, 0x4a, 0xb6, 0xa9, 0x44, 0x0c, 0x89, 0x5f, 0x13,
+	0x32, 0x22, 0x7e, 0xd0, 0xcc, 0x77, 0x7a, 0x1b, 0x96, 0xc8, 0xc3, 0x87, 0x78, 0xbc, 0x1e, 0xf1,
+	0x88, 0x81, 0xc0, 0x1d, 0x5e, 0x2a, 0x63, 0x65, 0xe2, 0xe5, 0x3e, 0x64, 0xcb, 0xba, 0x71, 0x86,
+	0x3c, 0xd1, 0x69, 0x34, 0x2c, 0xaf, 0x81, 0x6c, 0x6f, 0xa6, 0xa7, 0xbc, 0x9f, 0xde, 0x58, 0x2b,
+	0x70, 0xb6, 0xa2, 0x44, 0x24, 0xdc, 0x03, 0xd8, 0xc4, 0x5c, 0x82, 0x71, 0x66, 0x3b, 0xdd, 0x3a,
+	0x32, 0x6b, 0x68, 0x2e, 0xe1, 0x16, 0xac, 0xeb, 0x71, 0x55, 0xc2, 0x3a, 0x2d, 0xe6, 0x8a, 0x90,
+	0xc3, 0xd4, 0x0a, 0x32, 0x90, 0xd5, 0xf4, 0x84, 0xaa, 0xeb, 0xcf, 0x81, 0x59, 0xcc, 0x9c, 0x00,
+	0x97, 0x62, 0xfa, 0x33, 0x43, 0xc8, 0xc1, 0x52, 0x0b, 0xab, 0x10, 0xd7, 0xe3, 0x2d, 0x77, 0x0a,
+	0x59, 0x19, 0x3d, 0xf6, 0x2a, 0x64, 0xe4, 0x28, 0xc8, 0xe8, 0xcc, 0x64, 0x79, 0x0b, 0x56, 0x6d,
+	0xf4, 0xd8, 0xd3, 0x5c, 0xf4, 0x48, 0x6b, 0x21, 0xa3, 0x83, 0x47, 0x52, 0xf4, 0x26, 0x89, 0xc1,
+	0x9c, 0x92, 0xb6, 0x31, 0xb5, 0xcf, 0xca, 0x99, 0xb0, 0x11, 0xf5, 0x24, 0x18, 0x67, 0x33, 0x1d,
+	0xbd, 0x09, 0x2b, 0x13, 0x26, 0xdd, 0x38, 0x23, 0x7e, 0x22, 0x9f, 0x56, 0x14, 0xe5, 0x14, 0x20,
+	0x6e, 0x04, 0xe3, 0xec, 0xff, 0xbf, 0x25, 0x61, 0x79, 0x3c, 0xc1, 0xe8, 0x37, 0xe0, 0xdf, 0x7b,
+	0x82, 0x2a, 0x68, 0xea, 0x83, 0xb2, 0xa4, 0x1d, 0xcb, 0x25, 0xb9, 0xa4, 0x96, 0x84, 0x83, 0xd2,
+	0x43, 0x69, 0x4f, 0x3b, 0x96, 0x2b, 0x65, 0x49, 0x2c, 0xdd, 0x2d, 0x49, 0x7b, 0x99, 0x05, 0x66,
+	0xbd, 0x3f, 0x60, 0xd3, 0x11, 0x11, 0x7d, 0x03, 0xae, 0x84, 0x96, 0xe2, 0x41, 0x49, 0x92, 0x55,
+	0xad, 0xa2, 0x0a, 0xaa, 0x94, 0xa1, 0x18, 0xe8, 0x0f, 0xd8, 0x45, 0x2c, 0xa3, 0x5f, 0x81, 0xcd,
+	0x88, 0xde, 0x91, 0x5c, 0x91, 0xe4, 0xca, 0x71, 0x85, 0xa8, 0x26, 0x98, 0xd5, 0xfe, 0x80, 0x4d,
+	0x4d, 0xc4, 0x74, 0x11, 0x98, 0x98, 0xb6, 0x2c, 0x89, 0x6a, 0xe9, 0x48, 0x26, 0xea, 0x17, 0x98,
+	0xb5, 0xfe, 0x80, 0x85, 0x50, 0x4e, 0x6f, 0xc1, 0xd5, 0x88, 0xfe, 0x3d, 0x41, 0x96, 0xa5, 0x03,
+	0xa2, 0x9c, 0x64, 0xd2, 0xfd, 0x01, 0xbb, 0x44, 0x84, 0xf4, 0xeb, 0x70, 0x2d, 0xd4, 0x2c, 0x0b,
+	0xe2, 0xbe, 0xa4, 0x6a, 0xe2, 0xd1, 0xe1, 0x61, 0x49, 0x3d, 0x94, 0x64, 0x35, 0x73, 0x91, 0xc9,
+	0xf6, 0x07, 0x6c, 0x06, 0x03, 0xa1, 0x9c, 0x7e, 0x07, 0xd8, 0x17, 0xcc, 0x04, 0x71, 0x5f, 0x3e,
+	0xfa, 0xf0, 0x40, 0xda, 0x7b, 0x4f, 0x0a, 0x6c, 0x17, 0x99, 0xcd, 0xfe, 0x80, 0xbd, 0x8c, 0xd1,
+	0x29, 0x90, 0x7e, 0xfb, 0x25, 0x04, 0x8a, 0x24, 0x4a, 0xa5, 0xb2, 0xaa, 0x09, 0xbb, 0x15, 0x49,
+	0x16, 0xa5, 0xcc, 0x12, 0x93, 0xeb, 0x0f, 0xd8, 0x2c, 0x46, 0x09, 0x48, 0x30, 0xfa, 0x36, 0x5c,
+	0x0f, 0xed, 0x65, 0xe9, 0x23, 0x55, 0xab, 0x48, 0xef, 0x1f, 0xfb, 0x90, 0x4f, 0xf3, 0x41, 0x66,
+	0x19, 0x07, 0xee, 0x23, 0x63, 0xc0, 0x97, 0xd3, 0x2c, 0x64, 0x42, 0xbb, 0x7b, 0x92, 0xb0, 0x27,
+	0x29, 0x99, 0x14, 0x3e, 0x19, 0xbc, 0xa3, 0x5f, 0x8b, 0x56, 0x24, 0xce, 0x2c, 0x88, 0xfb, 0x19,
+	0x60, 0x36, 0xfa, 0x03, 0x76, 0x3d, 0x4a, 0x2c, 0x88, 0xfb, 0x34, 0x0f, 0xb9, 0x59, 0xf9, 0x64,
+	0xd2, 0xcc, 0xa5, 0xfe, 0x80, 0x5d, 0x8d, 0xe5, 0xc1, 0x24, 0x9f, 0x7c, 0x9b, 0x5f, 0xd8, 0xfd,
+	0xe4, 0xa7, 0x67, 0x79, 0xea, 0xe9, 0xb3, 0x3c, 0xf5, 0xeb, 0xb3, 0x3c, 0xf5, 0xd5, 0xf3, 0xfc,
+	0xc2, 0xd3, 0xe7, 0xf9, 0x85, 0x9f, 0x9f, 0xe7, 0x17, 0x1e, 0xde, 0xad, 0x59, 0xde, 0x69, 0xbb,
+	0x5a, 0x34, 0x9c, 0x06, 0x6f, 0x38, 0x6e, 0xc3, 0x71, 0x79, 0xab, 0x6a, 0xdc, 0xac, 0x39, 0x7c,
+	0xe7, 0x16, 0xdf, 0x70, 0xcc, 0x76, 0x1d, 0xb9, 0xf8, 0xff, 0xf2, 0xe6, 0xf8, 0x07, 0xf3, 0xd5,
+	0xdb, 0x37, 0xa3, 0xff, 0x98, 0xfe, 0xb5, 0xeb, 0x56, 0x17, 0x83, 0xf9, 0x7e, 0xeb, 0xf7, 0x01,
+	0x00, 0xa6, 0x86, 0xc5, 0xca, 0x90, 0x0e, 0x00, 0x00,
 }
 
 func (m *ClientState) Marshal() (dAtA []byte, err error) {
@@ -1585,6 +1708,43 @@ func (m *PacketReceiptAbsenceData) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *PacketReceiptData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PacketReceiptData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PacketReceiptData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Receipt) > 0 {
+		i -= len(m.Receipt)
+		copy(dAtA[i:], m.Receipt)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Receipt)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *NextSequenceRecvData) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1620,6 +1780,41 @@ func (m *NextSequenceRecvData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *NextSequenceAckData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NextSequenceAckData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NextSequenceAckData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NextSeqAck != 0 {
+		i = encodeVarintSolomachine(dAtA, i, uint64(m.NextSeqAck))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Path) > 0 {
+		i -= len(m.Path)
+		copy(dAtA[i:], m.Path)
+		i = encodeVarintSolomachine(dAtA, i, uint64(len(m.Path)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintSolomachine(dAtA []byte, offset int, v uint64) int {
 	offset -= sovSolomachine(v)
 	base := offset
@@ -1921,6 +2116,23 @@ func (m *PacketReceiptAbsenceData) Size() (n int) {
 	return n
 }
 
+func (m *PacketReceiptData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	l = len(m.Receipt)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	return n
+}
+
 func (m *NextSequenceRecvData) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1937,6 +2149,22 @@ func (m *NextSequenceRecvData) Size() (n int) {
 	return n
 }
 
+func (m *NextSequenceAckData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Path)
+	if l > 0 {
+		n += 1 + l + sovSolomachine(uint64(l))
+	}
+	if m.NextSeqAck != 0 {
+		n += 1 + sovSolomachine(uint64(m.NextSeqAck))
+	}
+	return n
+}
+
 func sovSolomachine(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3938,6 +4166,124 @@ func (m *PacketReceiptAbsenceData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PacketReceiptData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PacketReceiptData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PacketReceiptData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receipt", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receipt = append(m.Receipt[:0], dAtA[iNdEx:postIndex]...)
+			if m.Receipt == nil {
+				m.Receipt = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *NextSequenceRecvData) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4041,6 +4387,109 @@ func (m *NextSequenceRecvData) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *NextSequenceAckData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSolomachine
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NextSequenceAckData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NextSequenceAckData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Path", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Path = append(m.Path[:0], dAtA[iNdEx:postIndex]...)
+			if m.Path == nil {
+				m.Path = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSeqAck", wireType)
+			}
+			m.NextSeqAck = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSolomachine
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSeqAck |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSolomachine(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSolomachine
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipSolomachine(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
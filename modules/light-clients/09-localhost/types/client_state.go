@@ -306,6 +306,39 @@ func (cs ClientState) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (cs ClientState) VerifyPacketReceipt(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	path := host.PacketReceiptKey(portID, channelID, sequence)
+
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedPacketReceiptVerification, "not found for path %s", path)
+	}
+
+	if !bytes.Equal(data, receipt) {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedPacketReceiptVerification,
+			"packet receipt ≠ expected receipt: \n%X\n≠\n%X", data, receipt,
+		)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (cs ClientState) VerifyNextSequenceRecv(
@@ -338,3 +371,36 @@ func (cs ClientState) VerifyNextSequenceRecv(
 
 	return nil
 }
+
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (cs ClientState) VerifyNextSequenceAck(
+	ctx sdk.Context,
+	store sdk.KVStore,
+	_ codec.BinaryCodec,
+	_ exported.Height,
+	_ uint64,
+	_ uint64,
+	_ exported.Prefix,
+	_ []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	path := host.NextSequenceAckKey(portID, channelID)
+
+	data := store.Get(path)
+	if len(data) == 0 {
+		return sdkerrors.Wrapf(clienttypes.ErrFailedNextSeqAckVerification, "not found for path %s", path)
+	}
+
+	prevSequenceAck := binary.BigEndian.Uint64(data)
+	if prevSequenceAck != nextSequenceAck {
+		return sdkerrors.Wrapf(
+			clienttypes.ErrFailedNextSeqAckVerification,
+			"next sequence acknowledgement ≠ previous stored sequence (%d ≠ %d)", nextSequenceAck, prevSequenceAck,
+		)
+	}
+
+	return nil
+}
@@ -465,6 +465,65 @@ func (suite *LocalhostTestSuite) TestVerifyPacketReceiptAbsence() {
 	suite.Require().Error(err, "receipt exists in store")
 }
 
+func (suite *LocalhostTestSuite) TestVerifyPacketReceipt() {
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		malleate    func()
+		receipt     []byte
+		expPass     bool
+	}{
+		{
+			name:        "proof verification success",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.PacketReceiptKey(testPortID, testChannelID, testSequence), []byte{byte(2)},
+				)
+			},
+			receipt: []byte{byte(2)},
+			expPass: true,
+		},
+		{
+			name:        "proof verification failed: different receipt stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.PacketReceiptKey(testPortID, testChannelID, testSequence), []byte{byte(1)},
+				)
+			},
+			receipt: []byte{byte(2)},
+			expPass: false,
+		},
+		{
+			name:        "proof verification failed: no receipt stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate:    func() {},
+			receipt:     []byte{byte(2)},
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := tc.clientState.VerifyPacketReceipt(
+				suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, []byte{}, testPortID, testChannelID, testSequence, tc.receipt,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *LocalhostTestSuite) TestVerifyNextSeqRecv() {
 	nextSeqRecv := uint64(5)
 
@@ -527,3 +586,66 @@ func (suite *LocalhostTestSuite) TestVerifyNextSeqRecv() {
 		})
 	}
 }
+
+func (suite *LocalhostTestSuite) TestVerifyNextSeqAck() {
+	nextSeqAck := uint64(5)
+
+	testCases := []struct {
+		name        string
+		clientState *types.ClientState
+		malleate    func()
+		nextSeqAck  uint64
+		expPass     bool
+	}{
+		{
+			name:        "proof verification success",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.NextSequenceAckKey(testPortID, testChannelID),
+					sdk.Uint64ToBigEndian(nextSeqAck),
+				)
+			},
+			nextSeqAck: nextSeqAck,
+			expPass:    true,
+		},
+		{
+			name:        "proof verification failed: different nextSeqAck stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate: func() {
+				suite.store.Set(
+					host.NextSequenceAckKey(testPortID, testChannelID),
+					sdk.Uint64ToBigEndian(3),
+				)
+			},
+			nextSeqAck: nextSeqAck,
+			expPass:    false,
+		},
+		{
+			name:        "proof verification failed: no nextSeqAck stored",
+			clientState: types.NewClientState("chainID", clientHeight),
+			malleate:    func() {},
+			nextSeqAck:  nextSeqAck,
+			expPass:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			err := tc.clientState.VerifyNextSequenceAck(
+				suite.ctx, suite.store, suite.cdc, clientHeight, 0, 0, nil, []byte{}, testPortID, testChannelID, nextSeqAck,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
@@ -163,6 +163,34 @@ func (coord *Coordinator) CreateChannels(path *Path) {
 	require.NoError(coord.T, err)
 }
 
+// SetupMultihop creates clients and connections for every single hop path of the
+// multi-hop path and opens a channel over the connection hops. The function expects
+// the setup to succeed otherwise testing will fail.
+func (coord *Coordinator) SetupMultihop(path *MultihopPath) {
+	for _, hopPath := range path.Paths {
+		coord.SetupConnections(hopPath)
+	}
+
+	coord.CreateMultihopChannels(path)
+}
+
+// CreateMultihopChannels constructs and executes channel handshake messages in order
+// to create OPEN channels over the connection hops of the multi-hop path. The function
+// expects the channels to be successfully opened otherwise testing will fail.
+func (coord *Coordinator) CreateMultihopChannels(path *MultihopPath) {
+	err := path.EndpointA.ChanOpenInit()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointB.ChanOpenTry()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointA.ChanOpenAck()
+	require.NoError(coord.T, err)
+
+	err = path.EndpointB.ChanOpenConfirm()
+	require.NoError(coord.T, err)
+}
+
 // GetChain returns the TestChain using the given chainID and returns an error if it does
 // not exist.
 func (coord *Coordinator) GetChain(chainID string) *TestChain {
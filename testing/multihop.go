@@ -0,0 +1,434 @@
+package ibctesting
+
+import (
+	"bytes"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v3/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v3/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v3/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v3/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v3/modules/core/exported"
+)
+
+// MultihopPath contains two endpoints of a channel opened over multiple connection
+// hops. Paths contains a single hop path between every consecutive pair of chains
+// on the multi-hop path, EndpointA of every single hop path is located on the chain
+// closer to EndpointA of the multi-hop path.
+type MultihopPath struct {
+	EndpointA *MultihopEndpoint
+	EndpointB *MultihopEndpoint
+
+	Paths []*Path
+}
+
+// MultihopEndpoint represents a channel endpoint of a multi-hop channel. The
+// connection hops of the channel are given by the connections of the single hop
+// endpoints leading from the chain of the endpoint to the counterparty chain.
+type MultihopEndpoint struct {
+	Chain        *TestChain
+	Counterparty *MultihopEndpoint
+	ChannelID    string
+
+	ChannelConfig *ChannelConfig
+
+	// hops contains the single hop endpoint on every chain from the chain of the
+	// endpoint up to the chain preceding the counterparty chain.
+	hops []*Endpoint
+}
+
+// NewMultihopPath constructs a single hop path between every consecutive pair of
+// the given chains and a multi-hop path over the single hop paths. The channel
+// endpoints of the multi-hop path are located on the first and the last chain.
+func NewMultihopPath(chains ...*TestChain) *MultihopPath {
+	if len(chains) < 3 {
+		panic(fmt.Sprintf("a multi-hop path requires at least 3 chains, got %d", len(chains)))
+	}
+
+	paths := make([]*Path, len(chains)-1)
+	for i := range paths {
+		paths[i] = NewPath(chains[i], chains[i+1])
+	}
+
+	hopsA := make([]*Endpoint, len(paths))
+	hopsB := make([]*Endpoint, len(paths))
+	for i, path := range paths {
+		hopsA[i] = path.EndpointA
+		hopsB[len(paths)-1-i] = path.EndpointB
+	}
+
+	endpointA := &MultihopEndpoint{
+		Chain:         chains[0],
+		ChannelConfig: NewChannelConfig(),
+		hops:          hopsA,
+	}
+	endpointB := &MultihopEndpoint{
+		Chain:         chains[len(chains)-1],
+		ChannelConfig: NewChannelConfig(),
+		hops:          hopsB,
+	}
+
+	endpointA.Counterparty = endpointB
+	endpointB.Counterparty = endpointA
+
+	return &MultihopPath{
+		EndpointA: endpointA,
+		EndpointB: endpointB,
+		Paths:     paths,
+	}
+}
+
+// SetChannelOrdered sets the channel order for both endpoints to ORDERED.
+func (path *MultihopPath) SetChannelOrdered() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+}
+
+// SetChannelOrderedAllowTimeout sets the channel order for both endpoints to ORDERED_ALLOW_TIMEOUT.
+func (path *MultihopPath) SetChannelOrderedAllowTimeout() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+}
+
+// RelayPacket relays a packet sent on EndpointA to EndpointB and relays the
+// acknowledgement written by EndpointB back to EndpointA. An error is returned
+// if a relay step fails or the packet commitment does not exist on EndpointA.
+func (path *MultihopPath) RelayPacket(packet channeltypes.Packet) error {
+	pc := path.EndpointA.Chain.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(path.EndpointA.Chain.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if !bytes.Equal(pc, channeltypes.CommitPacket(path.EndpointA.Chain.App.AppCodec(), packet)) {
+		return fmt.Errorf("packet commitment does not exist on EndpointA for provided packet")
+	}
+
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	if err != nil {
+		return err
+	}
+
+	ack, err := ParseAckFromEvents(res.GetEvents())
+	if err != nil {
+		return err
+	}
+
+	return path.EndpointA.AcknowledgePacket(packet, ack)
+}
+
+// ConnectionHops returns the connection hops of the channel on the endpoint.
+func (endpoint *MultihopEndpoint) ConnectionHops() []string {
+	connectionHops := make([]string, len(endpoint.hops))
+	for i, hop := range endpoint.hops {
+		connectionHops[i] = hop.ConnectionID
+	}
+
+	return connectionHops
+}
+
+// QueryMultihopProof returns a multi-hop proof of the value stored at the key on
+// the counterparty chain together with the proof height, which is the height of
+// the chain of the first hop on the client of the endpoint. The clients along the
+// path are updated in order to construct the proof.
+func (endpoint *MultihopEndpoint) QueryMultihopProof(key []byte) ([]byte, clienttypes.Height) {
+	proofs, proofHeight := endpoint.queryMultihopProofs(key)
+	return proofs[0], proofHeight
+}
+
+// queryMultihopProofs returns a multi-hop proof for each of the keys, all
+// constructed against the same consensus states of the chains along the path.
+func (endpoint *MultihopEndpoint) queryMultihopProofs(keys ...[]byte) ([][]byte, clienttypes.Height) {
+	var (
+		keyProofs        = make([]commitmenttypes.MultihopProof, len(keys))
+		connectionProofs = make([]commitmenttypes.MultihopProof, len(endpoint.hops)-1)
+		consensusProofs  = make([]commitmenttypes.MultihopProof, len(endpoint.hops)-1)
+		proofHeight      clienttypes.Height
+	)
+
+	// construct the proofs starting at the counterparty chain, the client of every hop
+	// is updated so that it contains the consensus state used to verify the proofs of
+	// the next chain on the path
+	for i := len(endpoint.hops) - 1; i >= 0; i-- {
+		hop := endpoint.hops[i]
+		require.NoError(endpoint.Chain.T, hop.UpdateClient())
+
+		var height clienttypes.Height
+		if i == len(endpoint.hops)-1 {
+			for j, key := range keys {
+				keyProofs[j], height = queryMultihopProof(hop.Counterparty.Chain, key)
+			}
+		} else {
+			next := endpoint.hops[i+1]
+			connectionProofs[i], height = queryMultihopProof(hop.Counterparty.Chain, host.ConnectionKey(next.ConnectionID))
+			consensusProofs[i], _ = queryMultihopProof(hop.Counterparty.Chain, host.FullConsensusStateKey(next.ClientID, proofHeight))
+		}
+
+		proofHeight = height
+	}
+
+	proofs := make([][]byte, len(keys))
+	for i := range keyProofs {
+		multihopProofs := commitmenttypes.MultihopProofs{
+			KeyProof:         &keyProofs[i],
+			ConnectionProofs: connectionProofs,
+			ConsensusProofs:  consensusProofs,
+		}
+
+		bz, err := endpoint.Chain.App.AppCodec().Marshal(&multihopProofs)
+		require.NoError(endpoint.Chain.T, err)
+
+		proofs[i] = bz
+	}
+
+	return proofs, proofHeight
+}
+
+// queryMultihopProof performs an abci query with the given key on the latest
+// committed state of the chain and returns the proof and the value of the key
+// together with the height at which the proof will succeed on a tendermint verifier.
+func queryMultihopProof(chain *TestChain, key []byte) (commitmenttypes.MultihopProof, clienttypes.Height) {
+	res := chain.App.Query(abci.RequestQuery{
+		Path:   fmt.Sprintf("store/%s/key", host.StoreKey),
+		Height: chain.App.LastBlockHeight() - 1,
+		Data:   key,
+		Prove:  true,
+	})
+
+	merkleProof, err := commitmenttypes.ConvertProofs(res.ProofOps)
+	require.NoError(chain.T, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&merkleProof)
+	require.NoError(chain.T, err)
+
+	prefixedKey, err := commitmenttypes.ApplyPrefix(chain.GetPrefix(), commitmenttypes.NewMerklePath(string(key)))
+	require.NoError(chain.T, err)
+
+	revision := clienttypes.ParseChainID(chain.ChainID)
+
+	// proof height + 1 is returned as the proof created corresponds to the height the proof
+	// was created in the IAVL tree. Tendermint and subsequently the clients that rely on it
+	// have heights 1 above the IAVL tree. Thus we return proof height + 1
+	return commitmenttypes.MultihopProof{
+		Proof:       proof,
+		Value:       res.Value,
+		PrefixedKey: &prefixedKey,
+	}, clienttypes.NewHeight(revision, uint64(res.Height)+1)
+}
+
+// ChanOpenInit will construct and execute a MsgChannelOpenInit on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenInit() error {
+	msg := channeltypes.NewMsgChannelOpenInit(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID, err = ParseChannelIDFromEvents(res.GetEvents())
+	require.NoError(endpoint.Chain.T, err)
+
+	return nil
+}
+
+// ChanOpenTry will construct and execute a MsgChannelOpenTry on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenTry() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenTry(
+		endpoint.ChannelConfig.PortID, "", // does not support handshake continuation
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	if endpoint.ChannelID == "" {
+		endpoint.ChannelID, err = ParseChannelIDFromEvents(res.GetEvents())
+		require.NoError(endpoint.Chain.T, err)
+	}
+
+	// update version to selected app version
+	// NOTE: this update must be performed after the endpoint channelID is set
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenAck will construct and execute a MsgChannelOpenAck on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenAck() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenAck(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version, // testing doesn't use flexible selection
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanOpenConfirm will construct and execute a MsgChannelOpenConfirm on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenConfirm() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanCloseInit will construct and execute a MsgChannelCloseInit on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanCloseInit() error {
+	msg := channeltypes.NewMsgChannelCloseInit(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// ChanCloseConfirm will construct and execute a MsgChannelCloseConfirm on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanCloseConfirm() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelCloseConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// SendPacket sends a packet through the channel keeper using the associated endpoint.
+func (endpoint *MultihopEndpoint) SendPacket(packet exported.PacketI) error {
+	channelCap := endpoint.Chain.GetChannelCapability(packet.GetSourcePort(), packet.GetSourceChannel())
+
+	// no need to send message, acting as a module
+	err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), channelCap, packet)
+	if err != nil {
+		return err
+	}
+
+	// commit changes since no message was sent
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return nil
+}
+
+// RecvPacket receives a packet on the associated endpoint.
+func (endpoint *MultihopEndpoint) RecvPacket(packet channeltypes.Packet) error {
+	_, err := endpoint.RecvPacketWithResult(packet)
+	return err
+}
+
+// RecvPacketWithResult receives a packet on the associated endpoint and the result
+// of the transaction is returned.
+func (endpoint *MultihopEndpoint) RecvPacketWithResult(packet channeltypes.Packet) (*sdk.Result, error) {
+	// get proof of packet commitment on source
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.SendMsgs(recvMsg)
+}
+
+// AcknowledgePacket sends a MsgAcknowledgement to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) AcknowledgePacket(packet channeltypes.Packet, ack []byte) error {
+	// get proof of acknowledgement on counterparty
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+
+	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
+// TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) TimeoutPacket(packet channeltypes.Packet) error {
+	packetKey, err := endpoint.timeoutPacketKey(packet)
+	if err != nil {
+		return err
+	}
+
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(
+		packet, endpoint.Counterparty.getNextSequenceRecv(),
+		proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(timeoutMsg)
+}
+
+// TimeoutOnClose sends a MsgTimeoutOnClose to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) TimeoutOnClose(packet channeltypes.Packet) error {
+	packetKey, err := endpoint.timeoutPacketKey(packet)
+	if err != nil {
+		return err
+	}
+
+	channelKey := host.ChannelKey(packet.GetDestPort(), packet.GetDestChannel())
+	proofs, proofHeight := endpoint.queryMultihopProofs(packetKey, channelKey)
+
+	timeoutOnCloseMsg := channeltypes.NewMsgTimeoutOnClose(
+		packet, endpoint.Counterparty.getNextSequenceRecv(),
+		proofs[0], proofs[1], proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(timeoutOnCloseMsg)
+}
+
+// timeoutPacketKey returns the key on the counterparty chain proving that the
+// packet has not been received, depending on the channel order. The timeout
+// receipt is proven for packets skipped on ORDERED_ALLOW_TIMEOUT channels.
+func (endpoint *MultihopEndpoint) timeoutPacketKey(packet channeltypes.Packet) ([]byte, error) {
+	switch endpoint.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		return host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()), nil
+	case channeltypes.UNORDERED:
+		return host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), nil
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		if endpoint.Counterparty.getNextSequenceRecv() > packet.GetSequence() {
+			return host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()), nil
+		}
+		return host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel()), nil
+	default:
+		return nil, fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+	}
+}
+
+// getNextSequenceRecv returns the next sequence to be received by the channel of the endpoint.
+func (endpoint *MultihopEndpoint) getNextSequenceRecv() uint64 {
+	nextSeqRecv, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.T, found)
+
+	return nextSeqRecv
+}
+
+// GetChannel retrieves an IBC Channel for the endpoint. The channel
+// is expected to exist otherwise testing will fail.
+func (endpoint *MultihopEndpoint) GetChannel() channeltypes.Channel {
+	channel, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.T, found)
+
+	return channel
+}
+
+// SetChannel sets the channel for this endpoint.
+func (endpoint *MultihopEndpoint) SetChannel(channel channeltypes.Channel) {
+	endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, channel)
+}
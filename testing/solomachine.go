@@ -317,3 +317,12 @@ func (solo *Solomachine) GetNextSequenceRecvPath(portID, channelID string) commi
 
 	return path
 }
+
+// GetNextSequenceAckPath returns the commitment path for the next sequence ack counter.
+func (solo *Solomachine) GetNextSequenceAckPath(portID, channelID string) commitmenttypes.MerklePath {
+	nextSequenceAckPath := commitmenttypes.NewMerklePath(host.NextSequenceAckPath(portID, channelID))
+	path, err := commitmenttypes.ApplyPrefix(prefix, nextSequenceAckPath)
+	require.NoError(solo.t, err)
+
+	return path
+}